@@ -0,0 +1,203 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var assetsSkipLoudness bool
+
+var assetsCmd = &cobra.Command{
+	Use:   "assets",
+	Short: "Catalogue audio and background videos in the batch database",
+}
+
+var assetsScanCmd = &cobra.Command{
+	Use:   "scan <dir|file>...",
+	Short: "Probe media files and record their metadata",
+	Long: `Probe each media file with ffprobe and record it in the batch database: files
+with a video stream as background videos, the rest as audio. Durations,
+codecs, resolution, frame rate, tags and, for audio, integrated loudness are
+recorded, and batch uses them to reject inputs that can't fit the clip window
+before rendering anything.
+
+Scanning again updates what was recorded. Audio is matched by its contents, so
+moved files keep their clips; videos are matched by path.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		var paths []string
+		for _, arg := range args {
+			if !helper.IsDirectory(arg) {
+				paths = append(paths, arg)
+				continue
+			}
+			files, err := helper.GetFilesInDirectory(arg)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, files...)
+		}
+
+		assetService, closeDB, err := newAssetService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Kind\tDuration\tCodec\tDetails\tPath"); err != nil {
+			return err
+		}
+
+		for _, path := range paths {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			info, err := helper.ProbeMedia(ctx, path)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s: %s\n", path, err)
+				continue
+			}
+
+			switch {
+			case info.HasVideo():
+				video, err := assetService.SaveBackgroundVideo(ctx, path, info)
+				if err != nil {
+					return err
+				}
+				if err := fprintBackgroundVideoRow(w, video); err != nil {
+					return err
+				}
+			case info.HasAudio():
+				var loudness *float64
+				if !assetsSkipLoudness {
+					measured, err := helper.MeasureLoudness(ctx, path)
+					if err != nil {
+						fmt.Fprintf(os.Stderr, "Not recording loudness of %s: %s\n", path, err)
+					} else {
+						loudness = &measured
+					}
+				}
+
+				audio, err := assetService.SaveAudio(ctx, path, info, loudness)
+				if err != nil {
+					return err
+				}
+				if err := fprintAudioRow(w, audio); err != nil {
+					return err
+				}
+			default:
+				fmt.Fprintf(os.Stderr, "Skipping %s: no audio or video stream\n", path)
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+var assetsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List catalogued audio and background videos",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		assetService, closeDB, err := newAssetService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		audios, err := assetService.GetAudios(ctx)
+		if err != nil {
+			return err
+		}
+
+		videos, err := assetService.GetBackgroundVideos(ctx)
+		if err != nil {
+			return err
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Kind\tDuration\tCodec\tDetails\tPath"); err != nil {
+			return err
+		}
+		for _, audio := range audios {
+			if err := fprintAudioRow(w, audio); err != nil {
+				return err
+			}
+		}
+		for _, video := range videos {
+			if err := fprintBackgroundVideoRow(w, video); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	},
+}
+
+func fprintAudioRow(w io.Writer, audio *model.AudioDTO) error {
+	details := fmt.Sprintf("%dHz %dch", audio.SampleRate, audio.Channels)
+	if audio.Loudness != nil {
+		details += fmt.Sprintf(" %.1f LUFS", *audio.Loudness)
+	}
+	_, err := fmt.Fprintf(w, "audio\t%.1fs\t%s\t%s\t%s\n", audio.Duration, audio.Codec, details, audio.Path)
+	return err
+}
+
+func fprintBackgroundVideoRow(w io.Writer, video *model.BackgroundVideoDTO) error {
+	// Videos batch found but that were never scanned have no media details
+	if video.ScannedAt == nil {
+		_, err := fmt.Fprintf(w, "video\t-\t-\tnot scanned\t%s\n", video.Path)
+		return err
+	}
+	_, err := fmt.Fprintf(
+		w,
+		"video\t%.1fs\t%s\t%dx%d %gfps\t%s\n",
+		*video.Duration,
+		*video.Codec,
+		*video.Width,
+		*video.Height,
+		*video.FPS,
+		video.Path,
+	)
+	return err
+}
+
+func newAssetService() (*service.AssetServiceImpl, func(), error) {
+	client, err := helper.GetDB()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed opening connection to sqlite: %w", err)
+	}
+
+	assetService := service.NewAssetServiceImpl(
+		repository.NewAudioRepository(client),
+		repository.NewBackgroundVideoRepository(client),
+		service.NewClipServiceImpl(repository.NewClipRepository(client)),
+	)
+	return assetService, func() { _ = client.Close() }, nil
+}
+
+func init() {
+	assetsScanCmd.Flags().BoolVar(&assetsSkipLoudness, "skip-loudness", false, "Don't measure the loudness of audio, which decodes every file")
+
+	assetsCmd.AddCommand(assetsScanCmd)
+	assetsCmd.AddCommand(assetsListCmd)
+	rootCmd.AddCommand(assetsCmd)
+}
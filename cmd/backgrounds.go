@@ -0,0 +1,117 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var backgroundsCmd = &cobra.Command{
+	Use:   "backgrounds",
+	Short: "Inspect background videos tracked by the batch database",
+}
+
+var backgroundsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List background videos and how often they have been used",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		backgroundService, closeDB, err := newBackgroundService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		videos, err := backgroundService.GetAll(context.Background())
+		if err != nil {
+			return err
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tWeight\tUses\tLastUsed\tPath"); err != nil {
+			return err
+		}
+
+		for _, video := range videos {
+			lastUsed := "never"
+			if video.LastUsedAt != nil {
+				lastUsed = video.LastUsedAt.Format(time.DateTime)
+			}
+
+			if _, err := fmt.Fprintf(
+				w,
+				"%d\t%g\t%d\t%s\t%s\n",
+				video.ID,
+				video.Weight,
+				video.UseCount,
+				lastUsed,
+				video.Path,
+			); err != nil {
+				return err
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+var backgroundsWeightCmd = &cobra.Command{
+	Use:   "weight <video> <weight>",
+	Short: "Set how often --background-strategy weighted picks a video",
+	Long: `Set the weight of a background video for --background-strategy weighted. Videos
+start with a weight of 1; a video weighted 2 is picked twice as often as one
+weighted 1 with the same number of uses, and one weighted 0 is never picked. The
+path must match the one batch was given, e.g. tmp/bg/city.mp4.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		weight, err := strconv.ParseFloat(args[1], 64)
+		if err != nil || weight < 0 {
+			return fmt.Errorf("invalid weight %s, expected a number of at least 0", args[1])
+		}
+
+		backgroundService, closeDB, err := newBackgroundService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		video, err := backgroundService.SetWeight(context.Background(), args[0], weight)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Set weight of %s to %g\n", video.Path, video.Weight)
+		return nil
+	},
+}
+
+func newBackgroundService() (*service.BackgroundServiceImpl, func(), error) {
+	client, err := helper.GetDB()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed opening connection to sqlite: %w", err)
+	}
+
+	clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+	backgroundService := service.NewBackgroundServiceImpl(
+		repository.NewBackgroundVideoRepository(client),
+		clipService,
+	)
+	return backgroundService, func() { _ = client.Close() }, nil
+}
+
+func init() {
+	backgroundsCmd.AddCommand(backgroundsListCmd)
+	backgroundsCmd.AddCommand(backgroundsWeightCmd)
+	rootCmd.AddCommand(backgroundsCmd)
+}
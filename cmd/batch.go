@@ -4,15 +4,16 @@ Copyright © 2025 Sam Laister <laister.sam@gmail.com>
 package cmd
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log"
-	"math/rand"
+	"path/filepath"
+	"slices"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/pipeline"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
 	"github.com/spf13/cobra"
@@ -23,8 +24,14 @@ var batchOptions = model.NewBatchOptions()
 var batchCmd = &cobra.Command{
 	Use:   "batch",
 	Short: "Batch generate captions using an SQLite database to track progress.",
-	Long:  `Batch generate captions using an SQLite database to track progress.`,
+	Long: `Batch generate captions using an SQLite database to track progress.
+
+Transcription, burn and trim-and-fade run as separate stages. Use --transcribe-workers
+and --workers to process several clips at once.`,
+	PreRunE: applyConfig,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
 		client, err := helper.GetDB()
 		if err != nil {
 			log.Fatalf("failed opening connection to sqlite: %v", err)
@@ -33,10 +40,56 @@ var batchCmd = &cobra.Command{
 
 		clipRepository := repository.NewClipRepository(client)
 
-		whisperService := service.NewScriptServiceImpl()
 		clipService := service.NewClipServiceImpl(clipRepository)
+		backgroundService := service.NewBackgroundServiceImpl(
+			repository.NewBackgroundVideoRepository(client),
+			clipService,
+		)
+		assetService := service.NewAssetServiceImpl(
+			repository.NewAudioRepository(client),
+			repository.NewBackgroundVideoRepository(client),
+			clipService,
+		)
+
+		hashtagService := service.NewHashtagServiceImpl(clipService)
+
+		strategy, err := model.ParseBackgroundStrategy(batchOptions.BackgroundStrategy)
+		if err != nil {
+			return err
+		}
+		rules, err := model.ParseBackgroundRules(batchOptions.BackgroundExclude)
+		if err != nil {
+			return err
+		}
 
-		fmt.Println("Verbose: ", batchOptions.Verbose)
+		p, err := newPipeline(cmd, &batchOptions.CommonOptions, func(p *pipeline.Pipeline) {
+			p.Store = clipService
+			p.RetryFailed = batchOptions.RetryFailed
+
+			for i := range p.Stages {
+				if p.Stages[i].Stage.Name() == model.ClipStageCaptions {
+					p.Stages[i].Workers = batchOptions.TranscribeWorkers
+					p.Stages[i].Skip = batchOptions.SkipCaptionsGen
+					continue
+				}
+				p.Stages[i].Workers = batchOptions.Workers
+				// A bio is text rather than video, so it is written even when
+				// video generation is skipped
+				p.Stages[i].Skip = batchOptions.SkipVideoGen && p.Stages[i].Stage.Name() != model.ClipStageBio
+
+				if burn, ok := p.Stages[i].Stage.(pipeline.BurnStage); ok && slices.Contains(rules, model.BackgroundRuleArtistSegment) {
+					burn.Scripts.Backgrounds = backgroundService
+					p.Stages[i].Stage = burn
+				}
+				if bio, ok := p.Stages[i].Stage.(pipeline.BioStage); ok {
+					bio.Scripts.Hashtags = hashtagService
+					p.Stages[i].Stage = bio
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
 
 		if !helper.IsDirectory(batchOptions.AudioPath) || !helper.IsDirectory(batchOptions.VideoPath) {
 			return errors.New(fmt.Sprintf(
@@ -51,140 +104,117 @@ var batchCmd = &cobra.Command{
 			return err
 		}
 
-		videos, err := helper.GetFilesInDirectory(batchOptions.VideoPath)
+		videoPaths, err := helper.GetFilesInDirectory(batchOptions.VideoPath)
 		if err != nil {
 			return err
 		}
 
-		for _, audioPath := range audios {
-			audioHash, err := helper.GetFilehash(audioPath)
-			if err != nil {
-				fmt.Println(fmt.Sprintf("Failed calculating hash for file %s %s", audioPath, err.Error()))
-				continue
-			}
-
-			// Database entry
-			clipDTO, err := clipService.GetOrCreateWithHash(
-				context.Background(),
-				audioHash,
-				audioPath,
-				videos[rand.Intn(len(videos))],
-			)
-			if err != nil {
-				fmt.Println(fmt.Sprintf("Failed creating clip for file %s %s", audioPath, err.Error()))
-				continue
-			}
+		videos, err := backgroundService.Sync(ctx, videoPaths)
+		if err != nil {
+			return err
+		}
 
-			// Captions Gen
-			if !batchOptions.SkipCaptionsGen &&
-				(clipDTO.SRTCaptionPath == nil ||
-					!helper.Exists(*clipDTO.SRTCaptionPath)) {
-				if err := whisperService.RunGenerateSRTCaptionsOnClip(
-					batchOptions.OutputDir,
-					clipDTO,
-					batchOptions.WhisperModel,
-					batchOptions.StartTime,
-					batchOptions.EndTime,
-					batchOptions.Verbose,
-				); err != nil {
-					fmt.Println(fmt.Sprintf("Failed generating captions for file %s %s", audioPath, err.Error()))
-					continue
-				}
-				if err = clipService.Update(context.Background(), clipDTO); err != nil {
-					fmt.Println(fmt.Sprintf("Failed updating clip for file %s %s", audioPath, err.Error()))
-					continue
-				}
-			} else {
-				fmt.Println(fmt.Sprintf("Skipping caption generation for file %s...", audioPath))
-			}
+		// Backgrounds too short for the window are never picked, unless the
+		// background is cut on the beat from all of them
+		if !batchOptions.BeatSync {
+			videos = slices.DeleteFunc(videos, func(video *model.BackgroundVideoDTO) bool {
+				return helper.ValidateBackground(video, batchOptions.StartTime, batchOptions.EndTime) != nil
+			})
+		}
 
-			if err := clipDTO.PrintTable(); err != nil {
-				return err
-			}
+		// Clips are looked up as they are hashed so the first can be
+		// transcribed while the rest are still being read.
+		jobs := make(chan *pipeline.Job)
+		go func() {
+			defer close(jobs)
 
-			if !batchOptions.NoInteract {
-				if _, err := helper.WaitForOptionalEdits(); err != nil {
-					return err
+			for index, audioPath := range audios {
+				if ctx.Err() != nil {
+					return
 				}
-			}
 
-			// Raw Video Gen
-			if !batchOptions.SkipVideoGen &&
-				(clipDTO.CaptionsVideoOutputPath == nil ||
-					!helper.Exists(*clipDTO.CaptionsVideoOutputPath)) {
-				fmt.Println("Starting burn...")
-				if err := whisperService.RunBurnCaptionsOnClip(
-					batchOptions.OutputDir,
-					clipDTO,
-					&batchOptions.Width,
-					&batchOptions.Height,
-					batchOptions.StartTime,
-					batchOptions.EndTime,
-					batchOptions.Verbose,
-				); err != nil {
-					fmt.Println(fmt.Sprintf("Failed burning captions for file %s %s", audioPath, err.Error()))
+				prefix := fmt.Sprintf("[%d/%d %s]", index+1, len(audios), filepath.Base(audioPath))
+
+				audioHash, err := helper.GetFilehash(audioPath)
+				if err != nil {
+					p.Printer.Printf(prefix, "Failed calculating hash for file %s %s", audioPath, err.Error())
 					continue
 				}
 
-				if err = clipService.Update(context.Background(), clipDTO); err != nil {
-					fmt.Println(fmt.Sprintf("Failed generating video clip for file %s %s", audioPath, err.Error()))
+				// Database entry
+				seed := helper.ClipSeed(batchOptions.Seed, audioHash)
+				clipDTO, err := clipService.GetByHash(ctx, audioHash)
+				if err != nil {
+					p.Printer.Printf(prefix, "Failed finding clip for file %s %s", audioPath, err.Error())
 					continue
 				}
-			} else {
-				fmt.Println("Burn already exists, skipping...")
-			}
 
-			// Final Video gen
-			if !batchOptions.SkipVideoGen &&
-				(clipDTO.TrimmedVideoOutputPath == nil ||
-					!helper.Exists(*clipDTO.TrimmedVideoOutputPath)) {
-				fmt.Println("Starting trim and fade...")
-				duration, err := helper.DurationFromStartAndEnd(
-					batchOptions.StartTime,
-					batchOptions.EndTime,
-				)
-				if err != nil {
-					return err
+				track := helper.ReadTrackInfo(audioPath)
+
+				// Only new clips pick a background, existing ones keep theirs
+				if clipDTO == nil {
+					video, err := backgroundService.Pick(
+						ctx,
+						videos,
+						track.Artist,
+						strategy,
+						rules,
+						helper.SeededRand(seed, helper.RandVideo),
+					)
+					if err != nil {
+						p.Printer.Printf(prefix, "Failed picking background for file %s %s", audioPath, err.Error())
+						continue
+					}
+
+					clipDTO, err = clipService.GetOrCreateWithHash(ctx, audioHash, audioPath, video.Path)
+					if err != nil {
+						p.Printer.Printf(prefix, "Failed creating clip for file %s %s", audioPath, err.Error())
+						continue
+					}
+
+					if err := backgroundService.RecordUse(ctx, video); err != nil {
+						p.Printer.Printf(prefix, "Failed recording background use %s", err.Error())
+					}
 				}
-				if err := whisperService.RunTrimAndFadeOnClip(
-					batchOptions.OutputDir,
-					clipDTO,
-					duration,
-					&batchOptions.FadeDuration,
-					batchOptions.Verbose,
-				); err != nil {
-					return err
+				// Clips keep the seed they were first rendered with
+				if clipDTO.Seed == nil {
+					clipDTO.Seed = &seed
 				}
+				// Tags may have been fixed since the clip was first seen
+				clipDTO.SetTrackInfo(track)
 
-				if err = clipService.Update(context.Background(), clipDTO); err != nil {
-					fmt.Println(fmt.Sprintf("Failed trimming video clip for file %s %s", audioPath, err.Error()))
+				audio, video, err := assetService.Link(ctx, clipDTO)
+				if err != nil {
+					p.Printer.Printf(prefix, "Failed linking assets for file %s %s", audioPath, err.Error())
 					continue
 				}
-			} else {
-				fmt.Println("Trimmed output already exists, skipping...")
-			}
+				if clipDTO.Status != model.ClipStatusCompleted {
+					if err := helper.ValidateClipAssets(clipDTO, audio, video, batchOptions.CommonOptions); err != nil {
+						p.Printer.Printf(prefix, "Skipping file %s: %s", audioPath, err.Error())
+						continue
+					}
+				}
 
-			if err := clipDTO.PrintTable(); err != nil {
-				return err
+				select {
+				case jobs <- &pipeline.Job{Prefix: prefix, Clip: clipDTO}:
+				case <-ctx.Done():
+					return
+				}
 			}
+		}()
 
-		}
-
-		return nil
+		return p.Run(ctx, jobs)
 	},
 }
 
 func init() {
-	batchCmd.PersistentFlags().StringVarP(&batchOptions.AudioPath, "audioPath", "a", "", "Path to audio")
-	batchCmd.PersistentFlags().StringVarP(&batchOptions.VideoPath, "videoPath", "v", "", "Path to video")
-	batchCmd.PersistentFlags().StringVarP(&batchOptions.OutputDir, "output", "o", "output", "Output directory")
-	batchCmd.PersistentFlags().StringVarP(&batchOptions.WhisperModel, "model", "m", "base", "Transcription model (small,base,large)")
-	batchCmd.PersistentFlags().BoolVar(&batchOptions.Verbose, "verbose", false, "Verbose output")
-	batchCmd.PersistentFlags().StringVarP(&batchOptions.StartTime, "startTime", "s", "0", "Start time")
-	batchCmd.PersistentFlags().StringVarP(&batchOptions.EndTime, "endTime", "e", "30", "End time")
-	batchCmd.PersistentFlags().BoolVarP(&batchOptions.NoInteract, "no-interact", "n", false, "Disable interactive mode")
+	addCommonFlags(batchCmd.PersistentFlags(), &batchOptions.CommonOptions)
 	batchCmd.PersistentFlags().BoolVar(&batchOptions.SkipVideoGen, "skip-video-gen", false, "Skip Video Generation")
 	batchCmd.PersistentFlags().BoolVar(&batchOptions.SkipCaptionsGen, "skip-captions-gen", false, "Skip Captions Generation")
+	batchCmd.PersistentFlags().BoolVar(&batchOptions.RetryFailed, "retry-failed", false, "Retry clips that failed on a previous run")
+	batchCmd.PersistentFlags().IntVarP(&batchOptions.Workers, "workers", "w", batchOptions.Workers, "Number of concurrent burn and trim-and-fade workers")
+	batchCmd.PersistentFlags().IntVar(&batchOptions.TranscribeWorkers, "transcribe-workers", batchOptions.TranscribeWorkers, "Number of concurrent transcription workers")
+	addBackgroundFlags(batchCmd.PersistentFlags(), batchOptions)
 
 	batchCmd.MarkFlagRequired("audioPath")
 	batchCmd.MarkFlagRequired("videoPath")
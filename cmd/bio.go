@@ -0,0 +1,108 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var bioOptions = model.NewBioOptions()
+
+var bioCmd = &cobra.Command{
+	Use:   "bio <clip-id>",
+	Short: "Render a clip's TikTok bio from its artist's template",
+	Long: `Render the TikTok bio of a clip from the template in --bios named after its
+artist, e.g. bios/juice-wrld.md, falling back to bios/default.md. Templates
+are Go text/template files that can use {{track}}, {{artist}}, {{album}},
+{{duration}}, {{start}} and {{end}}, the window in seconds, and {{hashtags}}. A
+leading markdown heading is left out.
+
+Hashtags come from the pool in --hashtags named after the artist, falling back
+to the default pool, and are kept on the clip so its bio keeps them when
+rendered again. Use --new-hashtags to pick them again.
+
+The bio is written to a .txt next to the clip's final video and stored on the
+clip. Batch does the same for every clip as its last stage. Bios longer than
+--bio-max-length, TikTok's caption limit by default, are refused.`,
+	Args:    cobra.ExactArgs(1),
+	PreRunE: applyConfig,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		scripts := service.NewScriptServiceImpl(func(s *service.ScriptServiceImpl) {
+			s.Overwrite = bioOptions.Overwrite
+			s.Hashtags = service.NewHashtagServiceImpl(clipService)
+		})
+		if err := applyHashtagOptions(scripts, bioOptions.Hashtags); err != nil {
+			return err
+		}
+		defaults := model.NewCommonOptions()
+
+		if bioOptions.NewHashtags {
+			clip.Hashtags = nil
+		}
+
+		if bioOptions.DryRun {
+			bio, _, err := scripts.RenderBio(ctx, clip, bioOptions.BiosDir, bioOptions.BioMaxLength, defaults.StartTime, defaults.EndTime)
+			if err != nil {
+				return err
+			}
+			fmt.Println(bio)
+			return nil
+		}
+
+		if !clip.IsValidTrimmedVideoOutputPath() || !helper.Exists(*clip.TrimmedVideoOutputPath) {
+			return fmt.Errorf("clip %d has no final video, run batch to render it first", id)
+		}
+		if err := scripts.RunWriteBioOnClip(ctx, clip, bioOptions.BiosDir, bioOptions.BioMaxLength, defaults.StartTime, defaults.EndTime); err != nil {
+			return err
+		}
+
+		// A clip waiting on its bio is done once it has one
+		if clip.Stage == model.ClipStageBio {
+			clip.CompleteStage(model.ClipStageBio)
+		}
+		if err := clipService.Update(ctx, clip); err != nil {
+			return err
+		}
+
+		fmt.Println(*clip.Bio)
+		return nil
+	},
+}
+
+func init() {
+	addBioFlags(bioCmd.Flags(), &bioOptions.BiosDir, &bioOptions.BioMaxLength)
+	addHashtagFlags(bioCmd.Flags(), &bioOptions.Hashtags)
+	bioCmd.Flags().BoolVar(&bioOptions.NewHashtags, "new-hashtags", bioOptions.NewHashtags, "Pick the clip's hashtags again instead of keeping those it has")
+	bioCmd.Flags().BoolVar(&bioOptions.DryRun, "dry-run", bioOptions.DryRun, "Print the bio without writing it or storing it on the clip")
+	bioCmd.Flags().BoolVar(&bioOptions.Overwrite, "overwrite", bioOptions.Overwrite, "Overwrite a bio the clip didn't write")
+
+	rootCmd.AddCommand(bioCmd)
+}
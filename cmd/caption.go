@@ -6,24 +6,26 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"math/rand"
+	"path/filepath"
 
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
-	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/pipeline"
 	"github.com/spf13/cobra"
 )
 
 var captionsOptions = model.NewCaptionOptions()
 
 var captionCmd = &cobra.Command{
-	Use:   "caption",
-	Short: "Generates on demand captions for an audio file/directory",
-	Long:  `Captions doesn't use an external database and instead acts as a purely I/O caption generator. Files are generated using timestamp and not metadata.`,
+	Use:     "caption",
+	Short:   "Generates on demand captions for an audio file/directory",
+	Long:    `Captions doesn't use an external database and instead acts as a purely I/O caption generator. Files are generated using timestamp and not metadata.`,
+	PreRunE: applyConfig,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		whisperService := service.NewScriptServiceImpl()
-
-		fmt.Println("Verbose: ", captionsOptions.Verbose)
+		p, err := newPipeline(cmd, &captionsOptions.CommonOptions)
+		if err != nil {
+			return err
+		}
 
 		var clipQueue []*model.ClipDTO
 
@@ -48,19 +50,32 @@ var captionCmd = &cobra.Command{
 
 			// Random video with each audio
 			for _, audio := range audios {
-				clipQueue = append(clipQueue, model.NewClipDTO(
+				seed, err := audioClipSeed(captionsOptions.Seed, audio)
+				if err != nil {
+					return err
+				}
+
+				clip := model.NewClipDTO(
 					audio,
-					videos[rand.Intn(len(videos))],
+					videos[helper.SeededRand(seed, helper.RandVideo).Intn(len(videos))],
 					nil,
 					nil,
 					nil,
 					nil,
 					nil,
-				))
+				)
+				clip.Seed = &seed
+				clip.SetTrackInfo(helper.ReadTrackInfo(audio))
+				clipQueue = append(clipQueue, clip)
 			}
 
 		} else {
-			clipQueue = append(clipQueue, model.NewClipDTO(
+			seed, err := audioClipSeed(captionsOptions.Seed, captionsOptions.AudioPath)
+			if err != nil {
+				return err
+			}
+
+			clip := model.NewClipDTO(
 				captionsOptions.AudioPath,
 				captionsOptions.VideoPath,
 				nil,
@@ -68,103 +83,35 @@ var captionCmd = &cobra.Command{
 				nil,
 				nil,
 				nil,
-			))
+			)
+			clip.Seed = &seed
+			clip.SetTrackInfo(helper.ReadTrackInfo(captionsOptions.AudioPath))
+			clipQueue = append(clipQueue, clip)
 		}
 
 		if !helper.IsValidClipQueue(clipQueue) {
 			return errors.New("found no files to analyze")
 		}
 
-		if err := helper.CreateDirectoryIfNotExists(captionsOptions.OutputDir); err != nil {
-			return errors.New(fmt.Sprintf(
-				"couldn't create output directory: %s",
-				captionsOptions.OutputDir,
-			))
-		}
-
+		jobs := make([]*pipeline.Job, 0, len(clipQueue))
 		for index, clip := range clipQueue {
-			fmt.Println(fmt.Sprintf("Processing batch %d/%d", index, len(clipQueue)))
-
 			if !clip.IsValidAudioInputPath() {
 				return errors.New(fmt.Sprintf("%s is not a valid input path", clip.AudioInputPath))
 			}
 
-			fmt.Println("Starting SRT generation...")
-			if err := whisperService.RunGenerateSRTCaptionsOnClip(
-				captionsOptions.OutputDir,
-				clip,
-				captionsOptions.WhisperModel,
-				captionsOptions.StartTime,
-				captionsOptions.EndTime,
-				captionsOptions.Verbose,
-			); err != nil {
-				return err
-			}
-
-			if !captionsOptions.NoInteract {
-				if err := clip.PrintTable(); err != nil {
-					return err
-				}
-
-				if _, err := helper.WaitForOptionalEdits(); err != nil {
-					return err
-				}
-			}
-
-			fmt.Println("Starting burn...")
-			if err := whisperService.RunBurnCaptionsOnClip(
-				captionsOptions.OutputDir,
-				clip,
-				&captionsOptions.Width,
-				&captionsOptions.Height,
-				captionsOptions.StartTime,
-				captionsOptions.EndTime,
-				captionsOptions.Verbose,
-			); err != nil {
-				return err
-			}
-
-			fmt.Println("Starting trim and fade...")
-
-			duration, err := helper.DurationFromStartAndEnd(
-				captionsOptions.StartTime,
-				captionsOptions.EndTime,
-			)
-			if err != nil {
-				return err
-			}
-
-			if err := whisperService.RunTrimAndFadeOnClip(
-				captionsOptions.OutputDir,
-				clip,
-				duration,
-				&captionsOptions.FadeDuration,
-				captionsOptions.Verbose,
-			); err != nil {
-				return err
-			}
-		}
-
-		for _, clip := range clipQueue {
-			if err := clip.PrintTable(); err != nil {
-				return err
-			}
+			jobs = append(jobs, &pipeline.Job{
+				Prefix: fmt.Sprintf("[%d/%d %s]", index+1, len(clipQueue), filepath.Base(clip.AudioInputPath)),
+				Clip:   clip,
+			})
 		}
 
-		return nil
+		return p.Run(cmd.Context(), pipeline.Jobs(jobs...))
 	},
 }
 
 func init() {
-	captionCmd.PersistentFlags().StringVarP(&captionsOptions.AudioPath, "audioPath", "a", "", "Path to audio")
-	captionCmd.PersistentFlags().StringVarP(&captionsOptions.VideoPath, "videoPath", "v", "", "Path to video")
-	captionCmd.PersistentFlags().StringVarP(&captionsOptions.OutputDir, "output", "o", "output", "Output directory")
-	captionCmd.PersistentFlags().StringVarP(&captionsOptions.WhisperModel, "model", "m", "base", "Transcription model (small,base,large)")
-	captionCmd.PersistentFlags().BoolVar(&captionsOptions.Verbose, "verbose", false, "Verbose output")
-	captionCmd.PersistentFlags().StringVarP(&captionsOptions.StartTime, "startTime", "s", "0", "Start time")
-	captionCmd.PersistentFlags().StringVarP(&captionsOptions.EndTime, "endTime", "e", "30", "End time")
+	addCommonFlags(captionCmd.PersistentFlags(), &captionsOptions.CommonOptions)
 	captionCmd.PersistentFlags().BoolVarP(&captionsOptions.IsDirectory, "directoryMode", "D", false, "Enabl directory mode. Both audio path and video path must be directories when using this mode")
-	captionCmd.PersistentFlags().BoolVarP(&captionsOptions.NoInteract, "no-interact", "n", false, "Disable interactive mode")
 
 	captionCmd.MarkFlagRequired("path")
 
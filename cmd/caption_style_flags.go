@@ -0,0 +1,78 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/spf13/pflag"
+)
+
+// captionStyleFlags are the flags that override single fields of a preset.
+var captionStyleFlags = []string{
+	"font",
+	"font-size",
+	"max-chars",
+	"margin-x",
+	"margin-y",
+	"caption-mode",
+	"highlight-colour",
+}
+
+func addCaptionStyleFlags(flags *pflag.FlagSet, name *string, style *model.CaptionStyle) {
+	flags.StringVar(name, "style", *name, "Caption style preset from ./styles, or a path to a style YAML file")
+	flags.StringVar(&style.Font, "font", style.Font, "Caption font, overriding the style")
+	flags.IntVar(&style.FontSize, "font-size", style.FontSize, "Caption font size, overriding the style")
+	flags.IntVar(&style.MaxChars, "max-chars", style.MaxChars, "Maximum characters on a caption line, overriding the style")
+	flags.IntVar(&style.MarginX, "margin-x", style.MarginX, "Horizontal caption margin, overriding the style")
+	flags.IntVar(&style.MarginY, "margin-y", style.MarginY, "Vertical caption margin, overriding the style")
+	flags.Var(
+		(*captionModeValue)(&style.Mode),
+		"caption-mode",
+		fmt.Sprintf("Caption animation (%s), overriding the style", strings.Join(model.CaptionModeNames(), "|")),
+	)
+	flags.StringVar(&style.HighlightColour, "highlight-colour", style.HighlightColour, "Colour of the word being sung in karaoke and pop modes, overriding the style")
+}
+
+// applyCaptionStyle replaces style with base, then reapplies any style flag set
+// explicitly on the command line so it takes precedence over the preset.
+func applyCaptionStyle(flags *pflag.FlagSet, base *model.CaptionStyle, style *model.CaptionStyle) error {
+	explicit := map[string]string{}
+	flags.Visit(func(f *pflag.Flag) {
+		if slices.Contains(captionStyleFlags, f.Name) {
+			explicit[f.Name] = f.Value.String()
+		}
+	})
+
+	*style = *base
+	for name, value := range explicit {
+		if err := flags.Set(name, value); err != nil {
+			return err
+		}
+	}
+
+	return style.Validate()
+}
+
+// captionModeValue lets --caption-mode reject unknown modes while parsing.
+type captionModeValue model.CaptionMode
+
+func (v *captionModeValue) String() string {
+	return string(*v)
+}
+
+func (v *captionModeValue) Set(s string) error {
+	if !slices.Contains(model.CaptionModes, model.CaptionMode(s)) {
+		return fmt.Errorf("expected one of %s", strings.Join(model.CaptionModeNames(), ","))
+	}
+	*v = captionModeValue(s)
+	return nil
+}
+
+func (v *captionModeValue) Type() string {
+	return "mode"
+}
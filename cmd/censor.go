@@ -0,0 +1,119 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/censor"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var censorOptions = model.NewCensorOptions()
+
+var censorCmd = &cobra.Command{
+	Use:   "censor",
+	Short: "Check censor lists and what they censored",
+}
+
+var censorCheckCmd = &cobra.Command{
+	Use:   "check <word>...",
+	Short: "Show how a censor list masks each word",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		lists, err := censor.Load(censorOptions.CensorPath)
+		if err != nil {
+			return err
+		}
+		filter, err := lists.Filter(censorOptions.CensorList)
+		if err != nil {
+			return err
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Word\tCensored\tRule"); err != nil {
+			return err
+		}
+
+		for _, word := range args {
+			censored, rule := filter.Apply(word)
+			if rule == "" {
+				rule = "-"
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", word, censored, rule); err != nil {
+				return err
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+var censorLogCmd = &cobra.Command{
+	Use:   "log <clip-id>",
+	Short: "List every word censored in a clip's captions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Start\tEnd\tWord\tCensored\tRule"); err != nil {
+			return err
+		}
+
+		for _, event := range clip.CensorLog {
+			replacement := event.Replacement
+			if replacement == "" {
+				replacement = "(dropped)"
+			}
+			if _, err := fmt.Fprintf(
+				w,
+				"%.2f\t%.2f\t%s\t%s\t%s\n",
+				event.Start,
+				event.End,
+				event.Word,
+				replacement,
+				event.Rule,
+			); err != nil {
+				return err
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+func init() {
+	censorCheckCmd.Flags().StringVar(&censorOptions.CensorPath, "censor", censorOptions.CensorPath, "YAML file of censor lists, or JSON mapping words to their censored replacement")
+	censorCheckCmd.Flags().StringVar(&censorOptions.CensorList, "censor-list", censorOptions.CensorList, "Censor list to check")
+
+	censorCmd.AddCommand(censorCheckCmd)
+	censorCmd.AddCommand(censorLogCmd)
+	rootCmd.AddCommand(censorCmd)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var clipsStatuses []string
+
+var clipsCmd = &cobra.Command{
+	Use:   "clips",
+	Short: "Inspect clips tracked by the batch database",
+}
+
+var clipsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List clips and where they are in the pipeline",
+	Long: `List clips tracked by the batch database along with their pipeline stage,
+status, attempt count and last error. Filter with --status, e.g. --status failed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var statuses []model.ClipStatus
+		for _, status := range clipsStatuses {
+			if !model.IsValidClipStatus(status) {
+				return fmt.Errorf(
+					"unknown status %s, expected one of %s",
+					status,
+					strings.Join(model.ClipStatus("").Values(), ","),
+				)
+			}
+			statuses = append(statuses, model.ClipStatus(status))
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clips, err := clipService.GetByStatus(context.Background(), statuses...)
+		if err != nil {
+			return err
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "ID\tStatus\tStage\tAttempts\tAudio\tLastError"); err != nil {
+			return err
+		}
+
+		for _, clip := range clips {
+			lastError := ""
+			if clip.LastError != nil {
+				lastError = *clip.LastError
+			}
+
+			if _, err := fmt.Fprintf(
+				w,
+				"%d\t%s\t%s\t%d\t%s\t%s\n",
+				*clip.ID,
+				clip.Status,
+				clip.Stage,
+				clip.Attempts,
+				clip.AudioInputPath,
+				lastError,
+			); err != nil {
+				return err
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+func init() {
+	clipsListCmd.Flags().StringSliceVar(&clipsStatuses, "status", nil, "Only list clips with these statuses (pending,running,failed,completed)")
+
+	clipsCmd.AddCommand(clipsListCmd)
+	rootCmd.AddCommand(clipsCmd)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/spf13/pflag"
+)
+
+// addNamingFlags registers the flags controlling where stage outputs are
+// written.
+func addNamingFlags(flags *pflag.FlagSet, naming *string, overwrite *bool) {
+	flags.StringVar(naming, "naming", *naming, "Template naming outputs within the output directory, see model.OutputName")
+	flags.BoolVar(overwrite, "overwrite", *overwrite, "Replace outputs that already exist instead of failing")
+}
+
+// addBioFlags registers the flags controlling how bios are rendered.
+func addBioFlags(flags *pflag.FlagSet, biosDir *string, maxLength *int) {
+	flags.StringVar(biosDir, "bios", *biosDir, "Directory of bio templates, named after the artist they're for")
+	flags.IntVar(maxLength, "bio-max-length", *maxLength, "Most characters a bio may have")
+}
+
+// addLoudnessFlags registers the flags controlling loudness normalisation.
+func addLoudnessFlags(flags *pflag.FlagSet, opts *model.LoudnessOptions) {
+	flags.BoolVar(&opts.Normalise, "normalise", opts.Normalise, "Normalise the loudness of the trimmed video's audio")
+	flags.Float64Var(&opts.Target.Integrated, "loudness-target", opts.Target.Integrated, "Integrated loudness to normalise to in LUFS")
+	flags.Float64Var(&opts.Target.TruePeak, "true-peak", opts.Target.TruePeak, "Ceiling peaks are limited to when normalising in dBTP")
+	flags.Float64Var(&opts.Target.Range, "loudness-range", opts.Target.Range, "Loudness range allowed when normalising in LU")
+}
+
+// addBackgroundFlags registers the batch flags controlling how new clips pick
+// their background video.
+func addBackgroundFlags(flags *pflag.FlagSet, opts *model.BatchOptions) {
+	flags.StringVar(&opts.BackgroundStrategy, "background-strategy", opts.BackgroundStrategy, "How new clips pick a background video: random, round-robin, least-used or weighted")
+	flags.StringSliceVar(&opts.BackgroundExclude, "background-exclude", opts.BackgroundExclude, "Backgrounds new clips must not use: back-to-back, artist-video and/or artist-segment")
+}
+
+// addCommonFlags registers the flags shared by the caption and batch commands.
+func addCommonFlags(flags *pflag.FlagSet, opts *model.CommonOptions) {
+	flags.StringVarP(&opts.AudioPath, "audioPath", "a", opts.AudioPath, "Path to audio")
+	flags.StringVarP(&opts.VideoPath, "videoPath", "v", opts.VideoPath, "Path to video")
+	flags.StringVarP(&opts.OutputDir, "output", "o", opts.OutputDir, "Output directory")
+	addNamingFlags(flags, &opts.NamingTemplate, &opts.Overwrite)
+	flags.StringVarP(&opts.WhisperModel, "model", "m", opts.WhisperModel, "Transcription model (small,base,large)")
+	addTranscriberFlags(flags, &opts.Transcriber)
+	addCaptionStyleFlags(flags, &opts.StyleName, &opts.CaptionStyle)
+	flags.StringVar(&opts.CensorPath, "censor", opts.CensorPath, "YAML file of censor lists, or JSON mapping words to their censored replacement")
+	flags.StringVar(&opts.CensorList, "censor-list", opts.CensorList, "Censor list to mask captions with, from the --censor file")
+	flags.StringVar(&opts.AudioCensor, "audio-censor", opts.AudioCensor, "How censored words are hidden in the audio (off, mute, bleep or reverse)")
+	flags.Float64Var(&opts.AudioCensorPadding, "audio-censor-padding", opts.AudioCensorPadding, "Seconds hidden either side of each censored word")
+	flags.StringVar(&opts.SubtitlesDir, "subtitles", opts.SubtitlesDir, "Directory of SRT, VTT, LRC or ASS subtitles, named after the audio file or its hash, to caption from instead of transcribing")
+	flags.StringVar(&opts.LyricsDir, "lyrics", opts.LyricsDir, "Directory of LRC or plain text lyrics, named after the audio file or its hash, to take caption words from")
+	flags.Float64Var(&opts.LyricsMinConfidence, "lyrics-min-confidence", opts.LyricsMinConfidence, "Share of lyrics that must align with the transcript for them to be used (0-1)")
+	addBioFlags(flags, &opts.BiosDir, &opts.BioMaxLength)
+	addHashtagFlags(flags, &opts.Hashtags)
+	flags.BoolVar(&opts.Verbose, "verbose", opts.Verbose, "Verbose output")
+	flags.StringVarP(&opts.StartTime, "startTime", "s", opts.StartTime, "Start time")
+	flags.StringVarP(&opts.EndTime, "endTime", "e", opts.EndTime, "End time")
+	flags.BoolVar(&opts.AutoWindow, "auto-window", opts.AutoWindow, "Pick the best window of each track, as long as --startTime to --endTime, by analysing its audio")
+	flags.IntVar(&opts.Width, "width", opts.Width, "Output video width")
+	flags.IntVar(&opts.Height, "height", opts.Height, "Output video height")
+	flags.IntVar(&opts.FadeDuration, "fade-duration", opts.FadeDuration, "Fade out duration in seconds")
+	flags.Float64Var(&opts.FadeInDuration, "fade-in", opts.FadeInDuration, "Fade in duration in seconds (0 disables)")
+	addLoudnessFlags(flags, &opts.Loudness)
+	flags.BoolVar(&opts.BeatSync, "beat-sync", opts.BeatSync, "Cut the background between videos in --videoPath on the beat")
+	flags.IntVar(&opts.BeatsPerCut, "beats-per-cut", opts.BeatsPerCut, "Beats between background cuts with --beat-sync")
+	flags.Int64Var(&opts.Seed, "seed", opts.Seed, "Seed for the random choices made for new clips (0 picks a random seed per clip)")
+	flags.BoolVarP(&opts.NoInteract, "no-interact", "n", opts.NoInteract, "Disable interactive mode")
+	flags.DurationVar(&opts.TranscribeTimeout, "transcribe-timeout", opts.TranscribeTimeout, "Abort transcription of a clip after this long (0 disables)")
+	flags.DurationVar(&opts.BurnTimeout, "burn-timeout", opts.BurnTimeout, "Abort burning captions into a clip after this long (0 disables)")
+	flags.DurationVar(&opts.TrimTimeout, "trim-timeout", opts.TrimTimeout, "Abort trim-and-fade of a clip after this long (0 disables)")
+}
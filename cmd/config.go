@@ -0,0 +1,156 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const configEnvPrefix = "TIKTOK_CREATOR_"
+
+var configProfile string
+
+// configSetting is the value a profile setting resolved to and where it came
+// from.
+type configSetting struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect tiktok-creator.yaml configuration",
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the configuration caption and batch would run with",
+	Long: `Print each configurable setting along with where its value came from. Flags
+take precedence over TIKTOK_CREATOR_* environment variables, which take
+precedence over the selected profile and then the config defaults.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := model.NewBatchOptions()
+		flags := pflag.NewFlagSet("config", pflag.ContinueOnError)
+		addCommonFlags(flags, &opts.CommonOptions)
+		// Batch only settings are shown too, and checked as batch would
+		addBackgroundFlags(flags, opts)
+
+		settings, loaded, err := resolveConfig(flags)
+		if err != nil {
+			return err
+		}
+		if _, err := model.ParseBackgroundStrategy(opts.BackgroundStrategy); err != nil {
+			return err
+		}
+		if _, err := model.ParseBackgroundRules(opts.BackgroundExclude); err != nil {
+			return err
+		}
+
+		if len(loaded) == 0 {
+			fmt.Println("No config files found, looked for:", strings.Join(helper.ConfigPaths(), ", "))
+		} else {
+			fmt.Println("Config files:", strings.Join(loaded, ", "))
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Key\tValue\tSource"); err != nil {
+			return err
+		}
+		for _, setting := range settings {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", setting.Key, setting.Value, setting.Source); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	},
+}
+
+// applyConfig fills in the flags of cmd that weren't given on the command line
+// from the environment and the selected config profile.
+func applyConfig(cmd *cobra.Command, args []string) error {
+	_, _, err := resolveConfig(cmd.Flags())
+	return err
+}
+
+// resolveConfig sets each unchanged flag that a profile setting maps to, from
+// its environment variable or else the config profile, returning where every
+// setting came from and the config files read.
+func resolveConfig(flags *pflag.FlagSet) ([]configSetting, []string, error) {
+	config, loaded, err := helper.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	name := configProfile
+	if name == "" {
+		name = os.Getenv(configEnvPrefix + "PROFILE")
+	}
+	if name == "" {
+		name = config.DefaultProfile
+	}
+
+	profile, err := config.Profile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fields line up with the profile's own, which tell profile values apart
+	// from those inherited from the config defaults
+	own := config.Profiles[name]
+	ownFields := own.Fields()
+
+	var settings []configSetting
+	for i, field := range profile.Fields() {
+		flag := flags.Lookup(field.Flag)
+		if flag == nil {
+			continue
+		}
+
+		env := configEnvPrefix + strings.ToUpper(field.Key)
+		source := "default"
+
+		switch envValue, ok := os.LookupEnv(env); {
+		case flag.Changed:
+			source = "flag --" + flag.Name
+		case ok:
+			if err := flags.Set(flag.Name, envValue); err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", env, err)
+			}
+			source = "env " + env
+		case *field.Value != "":
+			source = "config defaults"
+			if *ownFields[i].Value != "" {
+				source = "profile " + name
+			}
+			if err := flags.Set(flag.Name, *field.Value); err != nil {
+				return nil, nil, fmt.Errorf("%s %s: %w", source, field.Key, err)
+			}
+		}
+
+		settings = append(settings, configSetting{
+			Key:    field.Key,
+			Value:  flag.Value.String(),
+			Source: source,
+		})
+	}
+
+	return settings, loaded, nil
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configProfile, "profile", "", "Config profile from tiktok-creator.yaml (default $TIKTOK_CREATOR_PROFILE)")
+
+	configCmd.AddCommand(configShowCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/pflag"
+)
+
+func addHashtagFlags(flags *pflag.FlagSet, opts *model.HashtagOptions) {
+	flags.StringVar(&opts.PoolsPath, "hashtags", opts.PoolsPath, "YAML file of hashtag pools, named after the artist or genre they're for")
+	flags.StringVar(&opts.Strategy, "hashtag-strategy", opts.Strategy, "How bios pick hashtags from their pool: random, round-robin or least-used")
+	flags.IntVar(&opts.Max, "hashtag-max", opts.Max, "Most hashtags a bio may have, for pools without a max of their own (0 for no limit)")
+}
+
+// applyHashtagOptions loads the hashtag pools in opts into scripts along with
+// how they are picked from.
+func applyHashtagOptions(scripts *service.ScriptServiceImpl, opts model.HashtagOptions) error {
+	strategy, err := model.ParseHashtagStrategy(opts.Strategy)
+	if err != nil {
+		return err
+	}
+	pools, err := helper.LoadHashtagPools(opts.PoolsPath)
+	if err != nil {
+		return err
+	}
+
+	scripts.HashtagPools = pools
+	scripts.HashtagStrategy = strategy
+	scripts.HashtagMax = opts.Max
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var hashtagsCmd = &cobra.Command{
+	Use:   "hashtags",
+	Short: "Inspect the hashtags bios have used",
+}
+
+var hashtagsReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "List every hashtag bios have used and the clips that used it",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		hashtagService := service.NewHashtagServiceImpl(service.NewClipServiceImpl(repository.NewClipRepository(client)))
+
+		usage, err := hashtagService.Usage(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Hashtag\tUses\tClips"); err != nil {
+			return err
+		}
+
+		for _, u := range usage {
+			ids := make([]string, 0, len(u.ClipIDs))
+			for _, id := range u.ClipIDs {
+				ids = append(ids, strconv.Itoa(id))
+			}
+
+			if _, err := fmt.Fprintf(w, "%s\t%d\t%s\n", u.Hashtag, len(u.ClipIDs), strings.Join(ids, ",")); err != nil {
+				return err
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+func init() {
+	hashtagsCmd.AddCommand(hashtagsReportCmd)
+	rootCmd.AddCommand(hashtagsCmd)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var lyricsCmd = &cobra.Command{
+	Use:   "lyrics",
+	Short: "Inspect how lyrics were aligned onto clips' captions",
+}
+
+var lyricsShowCmd = &cobra.Command{
+	Use:   "show <clip-id>",
+	Short: "List each line of a clip's lyrics with when it is sung and how well it aligned",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		if clip.LyricsPath == nil || clip.LyricsAlignment == nil {
+			return fmt.Errorf("clip %d wasn't captioned from lyrics", id)
+		}
+
+		alignment := clip.LyricsAlignment
+		fmt.Printf("Lyrics %s\n", *clip.LyricsPath)
+
+		const tablePadding = 2
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Start\tEnd\tConfidence\tLine"); err != nil {
+			return err
+		}
+
+		for _, line := range alignment.Lines {
+			if _, err := fmt.Fprintf(w, "%.2f\t%.2f\t%.0f%%\t%s\n", line.Start, line.End, line.Confidence*100, line.Text); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Printf("%.0f%% confidence, %d transcribed word(s) dropped\n", alignment.Confidence()*100, alignment.Dropped)
+		return nil
+	},
+}
+
+func init() {
+	lyricsCmd.AddCommand(lyricsShowCmd)
+	rootCmd.AddCommand(lyricsCmd)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/pipeline"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/transcriber"
+	"github.com/spf13/cobra"
+)
+
+// audioClipSeed returns the seed for a new clip of audioPath, derived from
+// seed as helper.ClipSeed describes.
+func audioClipSeed(seed int64, audioPath string) (int64, error) {
+	if seed == 0 {
+		return helper.ClipSeed(0, ""), nil
+	}
+
+	hash, err := helper.GetFilehash(audioPath)
+	if err != nil {
+		return 0, err
+	}
+	return helper.ClipSeed(seed, hash), nil
+}
+
+// newPipeline resolves the options shared by caption and batch into a pipeline
+// running the default stages. Captions can be reviewed in the user's editor
+// once rendered, and every finished clip is printed.
+func newPipeline(
+	cmd *cobra.Command,
+	opts *model.CommonOptions,
+	configure ...func(*pipeline.Pipeline),
+) (*pipeline.Pipeline, error) {
+	transcriptionBackend, err := transcriber.New(opts.Transcriber)
+	if err != nil {
+		return nil, err
+	}
+
+	style, err := helper.LoadCaptionStyle(opts.StyleName)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyCaptionStyle(cmd.Flags(), style, &opts.CaptionStyle); err != nil {
+		return nil, err
+	}
+
+	naming, err := helper.ParseNamingTemplate(opts.NamingTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := helper.SecondsFromStartAndEnd(opts.StartTime, opts.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if length <= 0 {
+		return nil, fmt.Errorf("endTime %s must be after startTime %s", opts.EndTime, opts.StartTime)
+	}
+
+	if _, err := model.ParseAudioCensorMode(opts.AudioCensor); err != nil {
+		return nil, err
+	}
+
+	scripts := service.NewScriptServiceImpl(func(s *service.ScriptServiceImpl) {
+		s.Transcriber = transcriptionBackend
+		s.Captions.CaptionStyle = opts.CaptionStyle
+		s.CensorPath = opts.CensorPath
+		s.CensorList = opts.CensorList
+		s.SubtitlesDir = opts.SubtitlesDir
+		s.LyricsDir = opts.LyricsDir
+		s.LyricsMinConfidence = opts.LyricsMinConfidence
+		s.Naming = naming
+		s.Overwrite = opts.Overwrite
+	})
+	if err := applyHashtagOptions(scripts, opts.Hashtags); err != nil {
+		return nil, err
+	}
+
+	fmt.Println("Verbose: ", opts.Verbose)
+
+	p := pipeline.New(func(p *pipeline.Pipeline) {
+		p.Stages = pipeline.DefaultStages(*scripts, *opts)
+		p.OutputDir = opts.OutputDir
+	})
+	editor := helper.Editor()
+	p.Hooks.AfterStage = p.Review(model.ClipStageCaptions, opts.NoInteract, func(ctx context.Context, job *pipeline.Job, w io.Writer) error {
+		reviewer := *scripts
+		reviewer.Output = w
+		_, err := reviewer.RunReviewCaptionsOnClip(ctx, opts.OutputDir, job.Clip, editor)
+		return err
+	})
+	p.Hooks.OnDone = func(job *pipeline.Job) error {
+		return p.Printer.Exclusive(job.Clip.FprintTable)
+	}
+
+	for _, opt := range configure {
+		opt(p)
+	}
+	return p, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var recaptionOptions = model.NewRecaptionOptions()
+
+var recaptionCmd = &cobra.Command{
+	Use:   "recaption <clip-id>",
+	Short: "Re-render a clip's captions from its cached transcript",
+	Long: `Re-render the ASS captions of a clip from the transcript cached when it was
+first captioned, so style changes such as --style or --font-size don't need
+another transcription. Without --style the clip keeps the style it was last
+captioned with. The burned and trimmed videos are marked stale and the clip
+is returned to the burn stage, so the next batch run regenerates them.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !clip.IsValidTranscriptPath() || !helper.Exists(*clip.TranscriptPath) {
+			return fmt.Errorf("clip %d has no cached transcript, run batch to caption it first", id)
+		}
+
+		// Without --style the clip keeps the style it was captioned with, so
+		// only the flags given change.
+		style := clip.CaptionStyle
+		if style == nil || cmd.Flags().Changed("style") {
+			style, err = helper.LoadCaptionStyle(recaptionOptions.StyleName)
+			if err != nil {
+				return err
+			}
+		}
+		if err := applyCaptionStyle(cmd.Flags(), style, &recaptionOptions.CaptionStyle); err != nil {
+			return err
+		}
+
+		naming, err := helper.ParseNamingTemplate(recaptionOptions.NamingTemplate)
+		if err != nil {
+			return err
+		}
+
+		scripts := service.NewScriptServiceImpl(func(s *service.ScriptServiceImpl) {
+			s.Captions.CaptionStyle = recaptionOptions.CaptionStyle
+			s.CensorPath = recaptionOptions.CensorPath
+			s.CensorList = recaptionOptions.CensorList
+			s.Naming = naming
+			s.Overwrite = recaptionOptions.Overwrite
+		})
+
+		if err := scripts.RunRenderCaptionsOnClip(recaptionOptions.OutputDir, clip); err != nil {
+			return err
+		}
+
+		clip.CompleteStage(model.ClipStageCaptions)
+		clip.InvalidateFrom(model.ClipStageBurn)
+		if err := clipService.Update(ctx, clip); err != nil {
+			return err
+		}
+
+		return clip.PrintTable()
+	},
+}
+
+func init() {
+	recaptionCmd.Flags().StringVarP(&recaptionOptions.OutputDir, "output", "o", "output", "Output directory")
+	addNamingFlags(recaptionCmd.Flags(), &recaptionOptions.NamingTemplate, &recaptionOptions.Overwrite)
+	recaptionCmd.Flags().StringVar(&recaptionOptions.CensorPath, "censor", recaptionOptions.CensorPath, "YAML file of censor lists, or JSON mapping words to their censored replacement")
+	recaptionCmd.Flags().StringVar(&recaptionOptions.CensorList, "censor-list", recaptionOptions.CensorList, "Censor list to mask captions with, from the --censor file")
+	addCaptionStyleFlags(recaptionCmd.Flags(), &recaptionOptions.StyleName, &recaptionOptions.CaptionStyle)
+
+	rootCmd.AddCommand(recaptionCmd)
+}
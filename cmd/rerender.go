@@ -0,0 +1,131 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/pipeline"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var rerenderOptions = model.NewRerenderOptions()
+
+var rerenderCmd = &cobra.Command{
+	Use:   "rerender <clip-id>",
+	Short: "Render a clip's video again exactly as it was first rendered",
+	Long: `Burn and trim a clip again from its current captions using everything recorded
+when it was first rendered: its background video and start offset or beat
+synced segment plan, window, size and fade. The framing comes out identical,
+so a clip that performed well can be recreated, e.g. after its video was
+deleted or after recaption. Its bio is written again alongside.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !clip.IsValidSRTCaptionPath() || !helper.Exists(*clip.SRTCaptionPath) {
+			return fmt.Errorf("clip %d has no captions, run batch to caption it first", id)
+		}
+		if clip.BackgroundStart == nil && clip.SegmentPlan == nil {
+			return fmt.Errorf("clip %d has no recorded background, run batch to render it first", id)
+		}
+
+		naming, err := helper.ParseNamingTemplate(rerenderOptions.NamingTemplate)
+		if err != nil {
+			return err
+		}
+
+		opts := model.NewCommonOptions(func(o *model.CommonOptions) {
+			o.OutputDir = rerenderOptions.OutputDir
+			o.Verbose = rerenderOptions.Verbose
+			o.BiosDir = rerenderOptions.BiosDir
+			o.BioMaxLength = rerenderOptions.BioMaxLength
+			o.StartTime, o.EndTime = clip.Window(o.StartTime, o.EndTime)
+			if clip.Width != nil {
+				o.Width = *clip.Width
+			}
+			if clip.Height != nil {
+				o.Height = *clip.Height
+			}
+			if clip.FadeDuration != nil {
+				o.FadeDuration = *clip.FadeDuration
+			}
+			if clip.FadeInDuration != nil {
+				o.FadeInDuration = *clip.FadeInDuration
+			}
+			if clip.Loudness != nil {
+				o.Loudness.Normalise = true
+				o.Loudness.Target = clip.Loudness.Target
+			}
+			if clip.AudioCensor != nil {
+				o.AudioCensor = string(clip.AudioCensor.Mode)
+				o.AudioCensorPadding = clip.AudioCensor.Padding
+			}
+		})
+
+		scripts := service.NewScriptServiceImpl(func(s *service.ScriptServiceImpl) {
+			s.Naming = naming
+			s.Overwrite = rerenderOptions.Overwrite
+			s.Hashtags = service.NewHashtagServiceImpl(clipService)
+		})
+		if err := applyHashtagOptions(scripts, rerenderOptions.Hashtags); err != nil {
+			return err
+		}
+
+		clip.InvalidateFrom(model.ClipStageBurn)
+
+		p := pipeline.New(func(p *pipeline.Pipeline) {
+			p.Stages = []pipeline.StageConfig{
+				{Stage: pipeline.BurnStage{Scripts: *scripts, Options: *opts}, Workers: 1},
+				{Stage: pipeline.TrimStage{Scripts: *scripts, Options: *opts}, Workers: 1},
+				{Stage: pipeline.BioStage{Scripts: *scripts, Options: *opts}, Workers: 1},
+			}
+			p.Store = clipService
+			p.OutputDir = opts.OutputDir
+			// Asking for a rerender is reason enough to try a failed clip again
+			p.RetryFailed = true
+		})
+		p.Hooks.OnDone = func(job *pipeline.Job) error {
+			return p.Printer.Exclusive(job.Clip.FprintTable)
+		}
+
+		return p.Run(ctx, pipeline.Jobs(&pipeline.Job{
+			Prefix: fmt.Sprintf("[clip %d]", id),
+			Clip:   clip,
+		}))
+	},
+}
+
+func init() {
+	rerenderCmd.Flags().StringVarP(&rerenderOptions.OutputDir, "output", "o", rerenderOptions.OutputDir, "Output directory")
+	addNamingFlags(rerenderCmd.Flags(), &rerenderOptions.NamingTemplate, &rerenderOptions.Overwrite)
+	addBioFlags(rerenderCmd.Flags(), &rerenderOptions.BiosDir, &rerenderOptions.BioMaxLength)
+	addHashtagFlags(rerenderCmd.Flags(), &rerenderOptions.Hashtags)
+	rerenderCmd.Flags().BoolVar(&rerenderOptions.Verbose, "verbose", rerenderOptions.Verbose, "Verbose output")
+
+	rootCmd.AddCommand(rerenderCmd)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/spf13/cobra"
+)
+
+var reviewOptions = model.NewReviewOptions()
+
+var reviewCmd = &cobra.Command{
+	Use:   "review <clip-id>",
+	Short: "Review a clip's captions word by word in $EDITOR",
+	Long: `Open the words of a clip's captions in $VISUAL or $EDITOR, one per line with
+its start and end time in seconds from the start of the window. Fix words and
+times, split, join or delete lines, then save and close the editor. The edits
+are checked, and any problems are listed at the top of the file before it is
+opened again; delete every word to give up.
+
+Edited captions are rendered again in the style the clip was captioned with
+and the clip is marked as reviewed. The burned and trimmed videos are marked
+stale and the clip is returned to the burn stage, so the next batch run
+regenerates them. Caption and batch offer the same review as each clip's
+captions are rendered, unless run with --no-interact.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !clip.IsValidTranscriptPath() || !helper.Exists(*clip.TranscriptPath) {
+			return fmt.Errorf("clip %d has no cached transcript, run batch to caption it first", id)
+		}
+
+		naming, err := helper.ParseNamingTemplate(reviewOptions.NamingTemplate)
+		if err != nil {
+			return err
+		}
+
+		scripts := service.NewScriptServiceImpl(func(s *service.ScriptServiceImpl) {
+			if clip.CaptionStyle != nil {
+				s.Captions.CaptionStyle = *clip.CaptionStyle
+			}
+			s.CensorPath = reviewOptions.CensorPath
+			s.CensorList = reviewOptions.CensorList
+			s.Naming = naming
+			s.Overwrite = reviewOptions.Overwrite
+		})
+
+		changed, err := scripts.RunReviewCaptionsOnClip(ctx, reviewOptions.OutputDir, clip, helper.Editor())
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			clip.CompleteStage(model.ClipStageCaptions)
+			clip.InvalidateFrom(model.ClipStageBurn)
+		}
+		if err := clipService.Update(ctx, clip); err != nil {
+			return err
+		}
+
+		return clip.PrintTable()
+	},
+}
+
+func init() {
+	reviewCmd.Flags().StringVarP(&reviewOptions.OutputDir, "output", "o", reviewOptions.OutputDir, "Output directory")
+	addNamingFlags(reviewCmd.Flags(), &reviewOptions.NamingTemplate, &reviewOptions.Overwrite)
+	reviewCmd.Flags().StringVar(&reviewOptions.CensorPath, "censor", reviewOptions.CensorPath, "YAML file of censor lists, or JSON mapping words to their censored replacement")
+	reviewCmd.Flags().StringVar(&reviewOptions.CensorList, "censor-list", reviewOptions.CensorList, "Censor list to mask captions with, from the --censor file")
+
+	rootCmd.AddCommand(reviewCmd)
+}
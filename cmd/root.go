@@ -4,7 +4,10 @@ Copyright © 2025 Sam Laister <laister.sam@gmail.com>
 package cmd
 
 import (
+	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 )
@@ -18,7 +21,11 @@ Kart Uzi videos.`,
 }
 
 func Execute() {
-	err := rootCmd.Execute()
+	// Ctrl-C or SIGTERM cancels the command context, which stops every running
+	// script and leaves in-flight clips retryable.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
 	if err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,136 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/subtitles"
+	"github.com/spf13/cobra"
+)
+
+var subsOptions = model.NewSubsOptions()
+
+var subsCmd = &cobra.Command{
+	Use:   "subs",
+	Short: "Convert between SRT, VTT, LRC and ASS subtitles",
+}
+
+var subsConvertCmd = &cobra.Command{
+	Use:   "convert <input> <output>",
+	Short: "Convert subtitles from one format to another",
+	Long: `Convert subtitles between SRT, WebVTT, LRC and ASS, telling the formats
+apart by the files' extensions unless --from or --to is given. Word timings
+are kept between the formats that carry them: WebVTT karaoke timestamps,
+enhanced LRC and ASS karaoke tags. Styling and positioning are dropped.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, err := subsFormat(subsOptions.From, args[0])
+		if err != nil {
+			return err
+		}
+		to, err := subsFormat(subsOptions.To, args[1])
+		if err != nil {
+			return err
+		}
+
+		input, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer input.Close()
+
+		subs, err := subtitles.Parse(input, from)
+		if err != nil {
+			return fmt.Errorf("reading subtitles %s: %w", args[0], err)
+		}
+
+		output, err := os.Create(args[1])
+		if err != nil {
+			return err
+		}
+		if err := subtitles.Write(output, subs, to); err != nil {
+			_ = output.Close()
+			return err
+		}
+		if err := output.Close(); err != nil {
+			return err
+		}
+
+		fmt.Printf("Converted %d cues from %s to %s\n", len(subs.Cues), from, to)
+		return nil
+	},
+}
+
+var subsExportCmd = &cobra.Command{
+	Use:   "export <clip-id> <output>",
+	Short: "Export a clip's caption words as subtitles timed to its video",
+	Long: `Write the words a clip was captioned with as subtitles in the format of the
+output's extension, grouped into lines of at most --max-chars characters and
+timed from the start of the clip's window, as its video is.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if subsOptions.MaxChars < 1 {
+			return fmt.Errorf("--max-chars must be at least 1, got %d", subsOptions.MaxChars)
+		}
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid clip id %s: %w", args[0], err)
+		}
+
+		client, err := helper.GetDB()
+		if err != nil {
+			return fmt.Errorf("failed opening connection to sqlite: %w", err)
+		}
+		defer client.Close()
+
+		clipService := service.NewClipServiceImpl(repository.NewClipRepository(client))
+
+		clip, err := clipService.GetByID(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		if !clip.IsValidTranscriptPath() || !helper.Exists(*clip.TranscriptPath) {
+			return fmt.Errorf("clip %d has no cached transcript, run batch to caption it first", id)
+		}
+
+		transcript, err := helper.ReadTranscript(*clip.TranscriptPath)
+		if err != nil {
+			return fmt.Errorf("reading transcript %s: %w", *clip.TranscriptPath, err)
+		}
+
+		subs := subtitles.FromTranscript(transcript, 0, subsOptions.MaxChars)
+		if err := subtitles.WriteFile(args[1], subs); err != nil {
+			return err
+		}
+
+		fmt.Printf("Exported %d cues of clip %d to %s\n", len(subs.Cues), id, args[1])
+		return nil
+	},
+}
+
+// subsFormat returns the format named by flag, or else that of path.
+func subsFormat(flag, path string) (subtitles.Format, error) {
+	if flag != "" {
+		return subtitles.ParseFormat(flag)
+	}
+	return subtitles.FormatOf(path)
+}
+
+func init() {
+	subsConvertCmd.Flags().StringVar(&subsOptions.From, "from", subsOptions.From, "Format of the input, srt, vtt, lrc or ass, if not its extension")
+	subsConvertCmd.Flags().StringVar(&subsOptions.To, "to", subsOptions.To, "Format of the output, srt, vtt, lrc or ass, if not its extension")
+	subsExportCmd.Flags().IntVar(&subsOptions.MaxChars, "max-chars", subsOptions.MaxChars, "Maximum characters per subtitle line")
+
+	subsCmd.AddCommand(subsConvertCmd)
+	subsCmd.AddCommand(subsExportCmd)
+	rootCmd.AddCommand(subsCmd)
+}
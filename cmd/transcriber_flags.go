@@ -0,0 +1,21 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/transcriber"
+	"github.com/spf13/pflag"
+)
+
+func addTranscriberFlags(flags *pflag.FlagSet, opts *model.TranscriberOptions) {
+	flags.StringVar(&opts.Backend, "transcriber", opts.Backend, fmt.Sprintf("Transcription backend (%s)", strings.Join(transcriber.Backends, ",")))
+	flags.StringVar(&opts.WhisperCppBin, "whisper-cpp-bin", opts.WhisperCppBin, "Path to the whisper.cpp binary")
+	flags.StringVar(&opts.WhisperCppModels, "whisper-cpp-models", opts.WhisperCppModels, "Directory holding ggml-<model>.bin files for whisper.cpp")
+	flags.StringVar(&opts.URL, "transcriber-url", opts.URL, "Base URL of an OpenAI compatible transcription server")
+	flags.StringVar(&opts.APIKey, "transcriber-api-key", opts.APIKey, "API key for the transcription server (default $OPENAI_API_KEY)")
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2025 Sam Laister <laister.sam@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/analysis"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/spf13/cobra"
+)
+
+var windowsOptions = model.NewWindowsOptions()
+
+var windowsCmd = &cobra.Command{
+	Use:   "windows <audio>...",
+	Short: "Score candidate snippet windows of audio files",
+	Long: `Analyse each audio file and list its best windows of --length seconds, scored
+by how much the harmony repeats elsewhere in the track (the chorus), loudness
+and onset density. Pass a window's start and end to caption or batch with
+--startTime and --endTime, or let --auto-window pick the best one.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if windowsOptions.Length <= 0 {
+			return fmt.Errorf("length must be positive, got %d", windowsOptions.Length)
+		}
+		if windowsOptions.Count <= 0 {
+			return fmt.Errorf("count must be positive, got %d", windowsOptions.Count)
+		}
+
+		for _, audioPath := range args {
+			var output io.Writer
+			if windowsOptions.Verbose {
+				output = os.Stdout
+			}
+
+			candidates, err := analysis.FindWindows(
+				cmd.Context(),
+				audioPath,
+				windowsOptions.Length,
+				windowsOptions.Count,
+				output,
+				windowsOptions.Verbose,
+			)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(audioPath)
+
+			const tablePadding = 2
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+			if _, err := fmt.Fprintln(w, "Rank\tStart\tEnd\tScore\tRepetition\tEnergy\tOnset"); err != nil {
+				return err
+			}
+			for rank, candidate := range candidates {
+				if _, err := fmt.Fprintf(
+					w,
+					"%d\t%d\t%d\t%.2f\t%.2f\t%.2f\t%.2f\n",
+					rank+1,
+					candidate.Start,
+					candidate.End,
+					candidate.Score,
+					candidate.Repetition,
+					candidate.Energy,
+					candidate.Onset,
+				); err != nil {
+					return err
+				}
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	windowsCmd.Flags().IntVarP(&windowsOptions.Length, "length", "l", windowsOptions.Length, "Window length in seconds")
+	windowsCmd.Flags().IntVarP(&windowsOptions.Count, "count", "c", windowsOptions.Count, "Number of windows to list")
+	windowsCmd.Flags().BoolVar(&windowsOptions.Verbose, "verbose", windowsOptions.Verbose, "Verbose output")
+
+	rootCmd.AddCommand(windowsCmd)
+}
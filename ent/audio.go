@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+)
+
+// Audio is the model entity for the Audio schema.
+type Audio struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration float64 `json:"duration,omitempty"`
+	// Codec holds the value of the "codec" field.
+	Codec string `json:"codec,omitempty"`
+	// SampleRate holds the value of the "sample_rate" field.
+	SampleRate int `json:"sample_rate,omitempty"`
+	// Channels holds the value of the "channels" field.
+	Channels int `json:"channels,omitempty"`
+	// Loudness holds the value of the "loudness" field.
+	Loudness *float64 `json:"loudness,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags map[string]string `json:"tags,omitempty"`
+	// ScannedAt holds the value of the "scanned_at" field.
+	ScannedAt time.Time `json:"scanned_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AudioQuery when eager-loading is set.
+	Edges        AudioEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AudioEdges holds the relations/edges for other nodes in the graph.
+type AudioEdges struct {
+	// Clips holds the value of the clips edge.
+	Clips []*Clip `json:"clips,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ClipsOrErr returns the Clips value or an error if the edge
+// was not loaded in eager-loading.
+func (e AudioEdges) ClipsOrErr() ([]*Clip, error) {
+	if e.loadedTypes[0] {
+		return e.Clips, nil
+	}
+	return nil, &NotLoadedError{edge: "clips"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Audio) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case audio.FieldTags:
+			values[i] = new([]byte)
+		case audio.FieldDuration, audio.FieldLoudness:
+			values[i] = new(sql.NullFloat64)
+		case audio.FieldID, audio.FieldSampleRate, audio.FieldChannels:
+			values[i] = new(sql.NullInt64)
+		case audio.FieldHash, audio.FieldPath, audio.FieldCodec:
+			values[i] = new(sql.NullString)
+		case audio.FieldScannedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Audio fields.
+func (_m *Audio) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case audio.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case audio.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case audio.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case audio.FieldDuration:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				_m.Duration = value.Float64
+			}
+		case audio.FieldCodec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field codec", values[i])
+			} else if value.Valid {
+				_m.Codec = value.String
+			}
+		case audio.FieldSampleRate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sample_rate", values[i])
+			} else if value.Valid {
+				_m.SampleRate = int(value.Int64)
+			}
+		case audio.FieldChannels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field channels", values[i])
+			} else if value.Valid {
+				_m.Channels = int(value.Int64)
+			}
+		case audio.FieldLoudness:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field loudness", values[i])
+			} else if value.Valid {
+				_m.Loudness = new(float64)
+				*_m.Loudness = value.Float64
+			}
+		case audio.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case audio.FieldScannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_at", values[i])
+			} else if value.Valid {
+				_m.ScannedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Audio.
+// This includes values selected through modifiers, order, etc.
+func (_m *Audio) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryClips queries the "clips" edge of the Audio entity.
+func (_m *Audio) QueryClips() *ClipQuery {
+	return NewAudioClient(_m.config).QueryClips(_m)
+}
+
+// Update returns a builder for updating this Audio.
+// Note that you need to call Audio.Unwrap() before calling this method if this Audio
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Audio) Update() *AudioUpdateOne {
+	return NewAudioClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Audio entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Audio) Unwrap() *Audio {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Audio is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Audio) String() string {
+	var builder strings.Builder
+	builder.WriteString("Audio(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("codec=")
+	builder.WriteString(_m.Codec)
+	builder.WriteString(", ")
+	builder.WriteString("sample_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SampleRate))
+	builder.WriteString(", ")
+	builder.WriteString("channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channels))
+	builder.WriteString(", ")
+	if v := _m.Loudness; v != nil {
+		builder.WriteString("loudness=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("scanned_at=")
+	builder.WriteString(_m.ScannedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Audios is a parsable slice of Audio.
+type Audios []*Audio
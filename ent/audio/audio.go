@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audio
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the audio type in the database.
+	Label = "audio"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldCodec holds the string denoting the codec field in the database.
+	FieldCodec = "codec"
+	// FieldSampleRate holds the string denoting the sample_rate field in the database.
+	FieldSampleRate = "sample_rate"
+	// FieldChannels holds the string denoting the channels field in the database.
+	FieldChannels = "channels"
+	// FieldLoudness holds the string denoting the loudness field in the database.
+	FieldLoudness = "loudness"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldScannedAt holds the string denoting the scanned_at field in the database.
+	FieldScannedAt = "scanned_at"
+	// EdgeClips holds the string denoting the clips edge name in mutations.
+	EdgeClips = "clips"
+	// Table holds the table name of the audio in the database.
+	Table = "audios"
+	// ClipsTable is the table that holds the clips relation/edge.
+	ClipsTable = "clips"
+	// ClipsInverseTable is the table name for the Clip entity.
+	// It exists in this package in order to avoid circular dependency with the "clip" package.
+	ClipsInverseTable = "clips"
+	// ClipsColumn is the table column denoting the clips relation/edge.
+	ClipsColumn = "audio_id"
+)
+
+// Columns holds all SQL columns for audio fields.
+var Columns = []string{
+	FieldID,
+	FieldHash,
+	FieldPath,
+	FieldDuration,
+	FieldCodec,
+	FieldSampleRate,
+	FieldChannels,
+	FieldLoudness,
+	FieldTags,
+	FieldScannedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultScannedAt holds the default value on creation for the "scanned_at" field.
+	DefaultScannedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Audio queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
+
+// ByCodec orders the results by the codec field.
+func ByCodec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodec, opts...).ToFunc()
+}
+
+// BySampleRate orders the results by the sample_rate field.
+func BySampleRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSampleRate, opts...).ToFunc()
+}
+
+// ByChannels orders the results by the channels field.
+func ByChannels(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannels, opts...).ToFunc()
+}
+
+// ByLoudness orders the results by the loudness field.
+func ByLoudness(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoudness, opts...).ToFunc()
+}
+
+// ByScannedAt orders the results by the scanned_at field.
+func ByScannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScannedAt, opts...).ToFunc()
+}
+
+// ByClipsCount orders the results by clips count.
+func ByClipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClipsStep(), opts...)
+	}
+}
+
+// ByClips orders the results by clips terms.
+func ByClips(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newClipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClipsTable, ClipsColumn),
+	)
+}
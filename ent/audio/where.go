@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audio
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/sam-laister/tiktok-creator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldHash, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldPath, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldDuration, v))
+}
+
+// Codec applies equality check predicate on the "codec" field. It's identical to CodecEQ.
+func Codec(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldCodec, v))
+}
+
+// SampleRate applies equality check predicate on the "sample_rate" field. It's identical to SampleRateEQ.
+func SampleRate(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldSampleRate, v))
+}
+
+// Channels applies equality check predicate on the "channels" field. It's identical to ChannelsEQ.
+func Channels(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldChannels, v))
+}
+
+// Loudness applies equality check predicate on the "loudness" field. It's identical to LoudnessEQ.
+func Loudness(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldLoudness, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldContainsFold(FieldHash, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldContainsFold(FieldPath, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...float64) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...float64) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldDuration, v))
+}
+
+// CodecEQ applies the EQ predicate on the "codec" field.
+func CodecEQ(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldCodec, v))
+}
+
+// CodecNEQ applies the NEQ predicate on the "codec" field.
+func CodecNEQ(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldCodec, v))
+}
+
+// CodecIn applies the In predicate on the "codec" field.
+func CodecIn(vs ...string) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldCodec, vs...))
+}
+
+// CodecNotIn applies the NotIn predicate on the "codec" field.
+func CodecNotIn(vs ...string) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldCodec, vs...))
+}
+
+// CodecGT applies the GT predicate on the "codec" field.
+func CodecGT(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldCodec, v))
+}
+
+// CodecGTE applies the GTE predicate on the "codec" field.
+func CodecGTE(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldCodec, v))
+}
+
+// CodecLT applies the LT predicate on the "codec" field.
+func CodecLT(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldCodec, v))
+}
+
+// CodecLTE applies the LTE predicate on the "codec" field.
+func CodecLTE(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldCodec, v))
+}
+
+// CodecContains applies the Contains predicate on the "codec" field.
+func CodecContains(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldContains(FieldCodec, v))
+}
+
+// CodecHasPrefix applies the HasPrefix predicate on the "codec" field.
+func CodecHasPrefix(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldHasPrefix(FieldCodec, v))
+}
+
+// CodecHasSuffix applies the HasSuffix predicate on the "codec" field.
+func CodecHasSuffix(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldHasSuffix(FieldCodec, v))
+}
+
+// CodecEqualFold applies the EqualFold predicate on the "codec" field.
+func CodecEqualFold(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldEqualFold(FieldCodec, v))
+}
+
+// CodecContainsFold applies the ContainsFold predicate on the "codec" field.
+func CodecContainsFold(v string) predicate.Audio {
+	return predicate.Audio(sql.FieldContainsFold(FieldCodec, v))
+}
+
+// SampleRateEQ applies the EQ predicate on the "sample_rate" field.
+func SampleRateEQ(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldSampleRate, v))
+}
+
+// SampleRateNEQ applies the NEQ predicate on the "sample_rate" field.
+func SampleRateNEQ(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldSampleRate, v))
+}
+
+// SampleRateIn applies the In predicate on the "sample_rate" field.
+func SampleRateIn(vs ...int) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldSampleRate, vs...))
+}
+
+// SampleRateNotIn applies the NotIn predicate on the "sample_rate" field.
+func SampleRateNotIn(vs ...int) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldSampleRate, vs...))
+}
+
+// SampleRateGT applies the GT predicate on the "sample_rate" field.
+func SampleRateGT(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldSampleRate, v))
+}
+
+// SampleRateGTE applies the GTE predicate on the "sample_rate" field.
+func SampleRateGTE(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldSampleRate, v))
+}
+
+// SampleRateLT applies the LT predicate on the "sample_rate" field.
+func SampleRateLT(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldSampleRate, v))
+}
+
+// SampleRateLTE applies the LTE predicate on the "sample_rate" field.
+func SampleRateLTE(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldSampleRate, v))
+}
+
+// ChannelsEQ applies the EQ predicate on the "channels" field.
+func ChannelsEQ(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldChannels, v))
+}
+
+// ChannelsNEQ applies the NEQ predicate on the "channels" field.
+func ChannelsNEQ(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldChannels, v))
+}
+
+// ChannelsIn applies the In predicate on the "channels" field.
+func ChannelsIn(vs ...int) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldChannels, vs...))
+}
+
+// ChannelsNotIn applies the NotIn predicate on the "channels" field.
+func ChannelsNotIn(vs ...int) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldChannels, vs...))
+}
+
+// ChannelsGT applies the GT predicate on the "channels" field.
+func ChannelsGT(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldChannels, v))
+}
+
+// ChannelsGTE applies the GTE predicate on the "channels" field.
+func ChannelsGTE(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldChannels, v))
+}
+
+// ChannelsLT applies the LT predicate on the "channels" field.
+func ChannelsLT(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldChannels, v))
+}
+
+// ChannelsLTE applies the LTE predicate on the "channels" field.
+func ChannelsLTE(v int) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldChannels, v))
+}
+
+// LoudnessEQ applies the EQ predicate on the "loudness" field.
+func LoudnessEQ(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldLoudness, v))
+}
+
+// LoudnessNEQ applies the NEQ predicate on the "loudness" field.
+func LoudnessNEQ(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldLoudness, v))
+}
+
+// LoudnessIn applies the In predicate on the "loudness" field.
+func LoudnessIn(vs ...float64) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldLoudness, vs...))
+}
+
+// LoudnessNotIn applies the NotIn predicate on the "loudness" field.
+func LoudnessNotIn(vs ...float64) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldLoudness, vs...))
+}
+
+// LoudnessGT applies the GT predicate on the "loudness" field.
+func LoudnessGT(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldLoudness, v))
+}
+
+// LoudnessGTE applies the GTE predicate on the "loudness" field.
+func LoudnessGTE(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldLoudness, v))
+}
+
+// LoudnessLT applies the LT predicate on the "loudness" field.
+func LoudnessLT(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldLoudness, v))
+}
+
+// LoudnessLTE applies the LTE predicate on the "loudness" field.
+func LoudnessLTE(v float64) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldLoudness, v))
+}
+
+// LoudnessIsNil applies the IsNil predicate on the "loudness" field.
+func LoudnessIsNil() predicate.Audio {
+	return predicate.Audio(sql.FieldIsNull(FieldLoudness))
+}
+
+// LoudnessNotNil applies the NotNil predicate on the "loudness" field.
+func LoudnessNotNil() predicate.Audio {
+	return predicate.Audio(sql.FieldNotNull(FieldLoudness))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Audio {
+	return predicate.Audio(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Audio {
+	return predicate.Audio(sql.FieldNotNull(FieldTags))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.Audio {
+	return predicate.Audio(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// HasClips applies the HasEdge predicate on the "clips" edge.
+func HasClips() predicate.Audio {
+	return predicate.Audio(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClipsTable, ClipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClipsWith applies the HasEdge predicate on the "clips" edge with a given conditions (other predicates).
+func HasClipsWith(preds ...predicate.Clip) predicate.Audio {
+	return predicate.Audio(func(s *sql.Selector) {
+		step := newClipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Audio) predicate.Audio {
+	return predicate.Audio(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Audio) predicate.Audio {
+	return predicate.Audio(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Audio) predicate.Audio {
+	return predicate.Audio(sql.NotPredicates(p))
+}
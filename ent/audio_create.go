@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/clip"
+)
+
+// AudioCreate is the builder for creating a Audio entity.
+type AudioCreate struct {
+	config
+	mutation *AudioMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (_c *AudioCreate) SetHash(v string) *AudioCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *AudioCreate) SetPath(v string) *AudioCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetDuration sets the "duration" field.
+func (_c *AudioCreate) SetDuration(v float64) *AudioCreate {
+	_c.mutation.SetDuration(v)
+	return _c
+}
+
+// SetCodec sets the "codec" field.
+func (_c *AudioCreate) SetCodec(v string) *AudioCreate {
+	_c.mutation.SetCodec(v)
+	return _c
+}
+
+// SetSampleRate sets the "sample_rate" field.
+func (_c *AudioCreate) SetSampleRate(v int) *AudioCreate {
+	_c.mutation.SetSampleRate(v)
+	return _c
+}
+
+// SetChannels sets the "channels" field.
+func (_c *AudioCreate) SetChannels(v int) *AudioCreate {
+	_c.mutation.SetChannels(v)
+	return _c
+}
+
+// SetLoudness sets the "loudness" field.
+func (_c *AudioCreate) SetLoudness(v float64) *AudioCreate {
+	_c.mutation.SetLoudness(v)
+	return _c
+}
+
+// SetNillableLoudness sets the "loudness" field if the given value is not nil.
+func (_c *AudioCreate) SetNillableLoudness(v *float64) *AudioCreate {
+	if v != nil {
+		_c.SetLoudness(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *AudioCreate) SetTags(v map[string]string) *AudioCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_c *AudioCreate) SetScannedAt(v time.Time) *AudioCreate {
+	_c.mutation.SetScannedAt(v)
+	return _c
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_c *AudioCreate) SetNillableScannedAt(v *time.Time) *AudioCreate {
+	if v != nil {
+		_c.SetScannedAt(*v)
+	}
+	return _c
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by IDs.
+func (_c *AudioCreate) AddClipIDs(ids ...int) *AudioCreate {
+	_c.mutation.AddClipIDs(ids...)
+	return _c
+}
+
+// AddClips adds the "clips" edges to the Clip entity.
+func (_c *AudioCreate) AddClips(v ...*Clip) *AudioCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddClipIDs(ids...)
+}
+
+// Mutation returns the AudioMutation object of the builder.
+func (_c *AudioCreate) Mutation() *AudioMutation {
+	return _c.mutation
+}
+
+// Save creates the Audio in the database.
+func (_c *AudioCreate) Save(ctx context.Context) (*Audio, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AudioCreate) SaveX(ctx context.Context) *Audio {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AudioCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AudioCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AudioCreate) defaults() {
+	if _, ok := _c.mutation.ScannedAt(); !ok {
+		v := audio.DefaultScannedAt()
+		_c.mutation.SetScannedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AudioCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "Audio.hash"`)}
+	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Audio.path"`)}
+	}
+	if _, ok := _c.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "Audio.duration"`)}
+	}
+	if _, ok := _c.mutation.Codec(); !ok {
+		return &ValidationError{Name: "codec", err: errors.New(`ent: missing required field "Audio.codec"`)}
+	}
+	if _, ok := _c.mutation.SampleRate(); !ok {
+		return &ValidationError{Name: "sample_rate", err: errors.New(`ent: missing required field "Audio.sample_rate"`)}
+	}
+	if _, ok := _c.mutation.Channels(); !ok {
+		return &ValidationError{Name: "channels", err: errors.New(`ent: missing required field "Audio.channels"`)}
+	}
+	if _, ok := _c.mutation.ScannedAt(); !ok {
+		return &ValidationError{Name: "scanned_at", err: errors.New(`ent: missing required field "Audio.scanned_at"`)}
+	}
+	return nil
+}
+
+func (_c *AudioCreate) sqlSave(ctx context.Context) (*Audio, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AudioCreate) createSpec() (*Audio, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Audio{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(audio.Table, sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(audio.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(audio.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Duration(); ok {
+		_spec.SetField(audio.FieldDuration, field.TypeFloat64, value)
+		_node.Duration = value
+	}
+	if value, ok := _c.mutation.Codec(); ok {
+		_spec.SetField(audio.FieldCodec, field.TypeString, value)
+		_node.Codec = value
+	}
+	if value, ok := _c.mutation.SampleRate(); ok {
+		_spec.SetField(audio.FieldSampleRate, field.TypeInt, value)
+		_node.SampleRate = value
+	}
+	if value, ok := _c.mutation.Channels(); ok {
+		_spec.SetField(audio.FieldChannels, field.TypeInt, value)
+		_node.Channels = value
+	}
+	if value, ok := _c.mutation.Loudness(); ok {
+		_spec.SetField(audio.FieldLoudness, field.TypeFloat64, value)
+		_node.Loudness = &value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(audio.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.ScannedAt(); ok {
+		_spec.SetField(audio.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = value
+	}
+	if nodes := _c.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AudioCreateBulk is the builder for creating many Audio entities in bulk.
+type AudioCreateBulk struct {
+	config
+	err      error
+	builders []*AudioCreate
+}
+
+// Save creates the Audio entities in the database.
+func (_c *AudioCreateBulk) Save(ctx context.Context) ([]*Audio, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Audio, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AudioMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AudioCreateBulk) SaveX(ctx context.Context) []*Audio {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AudioCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AudioCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
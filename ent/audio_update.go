@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/clip"
+	"github.com/sam-laister/tiktok-creator/ent/predicate"
+)
+
+// AudioUpdate is the builder for updating Audio entities.
+type AudioUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AudioMutation
+}
+
+// Where appends a list predicates to the AudioUpdate builder.
+func (_u *AudioUpdate) Where(ps ...predicate.Audio) *AudioUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AudioUpdate) SetHash(v string) *AudioUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableHash(v *string) *AudioUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *AudioUpdate) SetPath(v string) *AudioUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillablePath(v *string) *AudioUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *AudioUpdate) SetDuration(v float64) *AudioUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableDuration(v *float64) *AudioUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *AudioUpdate) AddDuration(v float64) *AudioUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// SetCodec sets the "codec" field.
+func (_u *AudioUpdate) SetCodec(v string) *AudioUpdate {
+	_u.mutation.SetCodec(v)
+	return _u
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableCodec(v *string) *AudioUpdate {
+	if v != nil {
+		_u.SetCodec(*v)
+	}
+	return _u
+}
+
+// SetSampleRate sets the "sample_rate" field.
+func (_u *AudioUpdate) SetSampleRate(v int) *AudioUpdate {
+	_u.mutation.ResetSampleRate()
+	_u.mutation.SetSampleRate(v)
+	return _u
+}
+
+// SetNillableSampleRate sets the "sample_rate" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableSampleRate(v *int) *AudioUpdate {
+	if v != nil {
+		_u.SetSampleRate(*v)
+	}
+	return _u
+}
+
+// AddSampleRate adds value to the "sample_rate" field.
+func (_u *AudioUpdate) AddSampleRate(v int) *AudioUpdate {
+	_u.mutation.AddSampleRate(v)
+	return _u
+}
+
+// SetChannels sets the "channels" field.
+func (_u *AudioUpdate) SetChannels(v int) *AudioUpdate {
+	_u.mutation.ResetChannels()
+	_u.mutation.SetChannels(v)
+	return _u
+}
+
+// SetNillableChannels sets the "channels" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableChannels(v *int) *AudioUpdate {
+	if v != nil {
+		_u.SetChannels(*v)
+	}
+	return _u
+}
+
+// AddChannels adds value to the "channels" field.
+func (_u *AudioUpdate) AddChannels(v int) *AudioUpdate {
+	_u.mutation.AddChannels(v)
+	return _u
+}
+
+// SetLoudness sets the "loudness" field.
+func (_u *AudioUpdate) SetLoudness(v float64) *AudioUpdate {
+	_u.mutation.ResetLoudness()
+	_u.mutation.SetLoudness(v)
+	return _u
+}
+
+// SetNillableLoudness sets the "loudness" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableLoudness(v *float64) *AudioUpdate {
+	if v != nil {
+		_u.SetLoudness(*v)
+	}
+	return _u
+}
+
+// AddLoudness adds value to the "loudness" field.
+func (_u *AudioUpdate) AddLoudness(v float64) *AudioUpdate {
+	_u.mutation.AddLoudness(v)
+	return _u
+}
+
+// ClearLoudness clears the value of the "loudness" field.
+func (_u *AudioUpdate) ClearLoudness() *AudioUpdate {
+	_u.mutation.ClearLoudness()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *AudioUpdate) SetTags(v map[string]string) *AudioUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *AudioUpdate) ClearTags() *AudioUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *AudioUpdate) SetScannedAt(v time.Time) *AudioUpdate {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *AudioUpdate) SetNillableScannedAt(v *time.Time) *AudioUpdate {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by IDs.
+func (_u *AudioUpdate) AddClipIDs(ids ...int) *AudioUpdate {
+	_u.mutation.AddClipIDs(ids...)
+	return _u
+}
+
+// AddClips adds the "clips" edges to the Clip entity.
+func (_u *AudioUpdate) AddClips(v ...*Clip) *AudioUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClipIDs(ids...)
+}
+
+// Mutation returns the AudioMutation object of the builder.
+func (_u *AudioUpdate) Mutation() *AudioMutation {
+	return _u.mutation
+}
+
+// ClearClips clears all "clips" edges to the Clip entity.
+func (_u *AudioUpdate) ClearClips() *AudioUpdate {
+	_u.mutation.ClearClips()
+	return _u
+}
+
+// RemoveClipIDs removes the "clips" edge to Clip entities by IDs.
+func (_u *AudioUpdate) RemoveClipIDs(ids ...int) *AudioUpdate {
+	_u.mutation.RemoveClipIDs(ids...)
+	return _u
+}
+
+// RemoveClips removes "clips" edges to Clip entities.
+func (_u *AudioUpdate) RemoveClips(v ...*Clip) *AudioUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClipIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AudioUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AudioUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AudioUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AudioUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AudioUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(audio.Table, audio.Columns, sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(audio.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(audio.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(audio.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(audio.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Codec(); ok {
+		_spec.SetField(audio.FieldCodec, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SampleRate(); ok {
+		_spec.SetField(audio.FieldSampleRate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSampleRate(); ok {
+		_spec.AddField(audio.FieldSampleRate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Channels(); ok {
+		_spec.SetField(audio.FieldChannels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChannels(); ok {
+		_spec.AddField(audio.FieldChannels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Loudness(); ok {
+		_spec.SetField(audio.FieldLoudness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLoudness(); ok {
+		_spec.AddField(audio.FieldLoudness, field.TypeFloat64, value)
+	}
+	if _u.mutation.LoudnessCleared() {
+		_spec.ClearField(audio.FieldLoudness, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(audio.FieldTags, field.TypeJSON, value)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(audio.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(audio.FieldScannedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClipsIDs(); len(nodes) > 0 && !_u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audio.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AudioUpdateOne is the builder for updating a single Audio entity.
+type AudioUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AudioMutation
+}
+
+// SetHash sets the "hash" field.
+func (_u *AudioUpdateOne) SetHash(v string) *AudioUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableHash(v *string) *AudioUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *AudioUpdateOne) SetPath(v string) *AudioUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillablePath(v *string) *AudioUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *AudioUpdateOne) SetDuration(v float64) *AudioUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableDuration(v *float64) *AudioUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *AudioUpdateOne) AddDuration(v float64) *AudioUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// SetCodec sets the "codec" field.
+func (_u *AudioUpdateOne) SetCodec(v string) *AudioUpdateOne {
+	_u.mutation.SetCodec(v)
+	return _u
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableCodec(v *string) *AudioUpdateOne {
+	if v != nil {
+		_u.SetCodec(*v)
+	}
+	return _u
+}
+
+// SetSampleRate sets the "sample_rate" field.
+func (_u *AudioUpdateOne) SetSampleRate(v int) *AudioUpdateOne {
+	_u.mutation.ResetSampleRate()
+	_u.mutation.SetSampleRate(v)
+	return _u
+}
+
+// SetNillableSampleRate sets the "sample_rate" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableSampleRate(v *int) *AudioUpdateOne {
+	if v != nil {
+		_u.SetSampleRate(*v)
+	}
+	return _u
+}
+
+// AddSampleRate adds value to the "sample_rate" field.
+func (_u *AudioUpdateOne) AddSampleRate(v int) *AudioUpdateOne {
+	_u.mutation.AddSampleRate(v)
+	return _u
+}
+
+// SetChannels sets the "channels" field.
+func (_u *AudioUpdateOne) SetChannels(v int) *AudioUpdateOne {
+	_u.mutation.ResetChannels()
+	_u.mutation.SetChannels(v)
+	return _u
+}
+
+// SetNillableChannels sets the "channels" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableChannels(v *int) *AudioUpdateOne {
+	if v != nil {
+		_u.SetChannels(*v)
+	}
+	return _u
+}
+
+// AddChannels adds value to the "channels" field.
+func (_u *AudioUpdateOne) AddChannels(v int) *AudioUpdateOne {
+	_u.mutation.AddChannels(v)
+	return _u
+}
+
+// SetLoudness sets the "loudness" field.
+func (_u *AudioUpdateOne) SetLoudness(v float64) *AudioUpdateOne {
+	_u.mutation.ResetLoudness()
+	_u.mutation.SetLoudness(v)
+	return _u
+}
+
+// SetNillableLoudness sets the "loudness" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableLoudness(v *float64) *AudioUpdateOne {
+	if v != nil {
+		_u.SetLoudness(*v)
+	}
+	return _u
+}
+
+// AddLoudness adds value to the "loudness" field.
+func (_u *AudioUpdateOne) AddLoudness(v float64) *AudioUpdateOne {
+	_u.mutation.AddLoudness(v)
+	return _u
+}
+
+// ClearLoudness clears the value of the "loudness" field.
+func (_u *AudioUpdateOne) ClearLoudness() *AudioUpdateOne {
+	_u.mutation.ClearLoudness()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *AudioUpdateOne) SetTags(v map[string]string) *AudioUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *AudioUpdateOne) ClearTags() *AudioUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *AudioUpdateOne) SetScannedAt(v time.Time) *AudioUpdateOne {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *AudioUpdateOne) SetNillableScannedAt(v *time.Time) *AudioUpdateOne {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by IDs.
+func (_u *AudioUpdateOne) AddClipIDs(ids ...int) *AudioUpdateOne {
+	_u.mutation.AddClipIDs(ids...)
+	return _u
+}
+
+// AddClips adds the "clips" edges to the Clip entity.
+func (_u *AudioUpdateOne) AddClips(v ...*Clip) *AudioUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClipIDs(ids...)
+}
+
+// Mutation returns the AudioMutation object of the builder.
+func (_u *AudioUpdateOne) Mutation() *AudioMutation {
+	return _u.mutation
+}
+
+// ClearClips clears all "clips" edges to the Clip entity.
+func (_u *AudioUpdateOne) ClearClips() *AudioUpdateOne {
+	_u.mutation.ClearClips()
+	return _u
+}
+
+// RemoveClipIDs removes the "clips" edge to Clip entities by IDs.
+func (_u *AudioUpdateOne) RemoveClipIDs(ids ...int) *AudioUpdateOne {
+	_u.mutation.RemoveClipIDs(ids...)
+	return _u
+}
+
+// RemoveClips removes "clips" edges to Clip entities.
+func (_u *AudioUpdateOne) RemoveClips(v ...*Clip) *AudioUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClipIDs(ids...)
+}
+
+// Where appends a list predicates to the AudioUpdate builder.
+func (_u *AudioUpdateOne) Where(ps ...predicate.Audio) *AudioUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AudioUpdateOne) Select(field string, fields ...string) *AudioUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Audio entity.
+func (_u *AudioUpdateOne) Save(ctx context.Context) (*Audio, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AudioUpdateOne) SaveX(ctx context.Context) *Audio {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AudioUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AudioUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AudioUpdateOne) sqlSave(ctx context.Context) (_node *Audio, err error) {
+	_spec := sqlgraph.NewUpdateSpec(audio.Table, audio.Columns, sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Audio.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audio.FieldID)
+		for _, f := range fields {
+			if !audio.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != audio.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(audio.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(audio.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(audio.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(audio.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Codec(); ok {
+		_spec.SetField(audio.FieldCodec, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SampleRate(); ok {
+		_spec.SetField(audio.FieldSampleRate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSampleRate(); ok {
+		_spec.AddField(audio.FieldSampleRate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Channels(); ok {
+		_spec.SetField(audio.FieldChannels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChannels(); ok {
+		_spec.AddField(audio.FieldChannels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Loudness(); ok {
+		_spec.SetField(audio.FieldLoudness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLoudness(); ok {
+		_spec.AddField(audio.FieldLoudness, field.TypeFloat64, value)
+	}
+	if _u.mutation.LoudnessCleared() {
+		_spec.ClearField(audio.FieldLoudness, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(audio.FieldTags, field.TypeJSON, value)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(audio.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(audio.FieldScannedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClipsIDs(); len(nodes) > 0 && !_u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   audio.ClipsTable,
+			Columns: []string{audio.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Audio{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audio.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
+)
+
+// BackgroundVideo is the model entity for the BackgroundVideo schema.
+type BackgroundVideo struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight float64 `json:"weight,omitempty"`
+	// UseCount holds the value of the "use_count" field.
+	UseCount int `json:"use_count,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash *string `json:"hash,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration *float64 `json:"duration,omitempty"`
+	// Codec holds the value of the "codec" field.
+	Codec *string `json:"codec,omitempty"`
+	// Width holds the value of the "width" field.
+	Width *int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height *int `json:"height,omitempty"`
+	// Fps holds the value of the "fps" field.
+	Fps *float64 `json:"fps,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags map[string]string `json:"tags,omitempty"`
+	// ScannedAt holds the value of the "scanned_at" field.
+	ScannedAt *time.Time `json:"scanned_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackgroundVideoQuery when eager-loading is set.
+	Edges        BackgroundVideoEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackgroundVideoEdges holds the relations/edges for other nodes in the graph.
+type BackgroundVideoEdges struct {
+	// Clips holds the value of the clips edge.
+	Clips []*Clip `json:"clips,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ClipsOrErr returns the Clips value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackgroundVideoEdges) ClipsOrErr() ([]*Clip, error) {
+	if e.loadedTypes[0] {
+		return e.Clips, nil
+	}
+	return nil, &NotLoadedError{edge: "clips"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackgroundVideo) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backgroundvideo.FieldTags:
+			values[i] = new([]byte)
+		case backgroundvideo.FieldWeight, backgroundvideo.FieldDuration, backgroundvideo.FieldFps:
+			values[i] = new(sql.NullFloat64)
+		case backgroundvideo.FieldID, backgroundvideo.FieldUseCount, backgroundvideo.FieldWidth, backgroundvideo.FieldHeight:
+			values[i] = new(sql.NullInt64)
+		case backgroundvideo.FieldPath, backgroundvideo.FieldHash, backgroundvideo.FieldCodec:
+			values[i] = new(sql.NullString)
+		case backgroundvideo.FieldLastUsedAt, backgroundvideo.FieldScannedAt, backgroundvideo.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackgroundVideo fields.
+func (_m *BackgroundVideo) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backgroundvideo.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case backgroundvideo.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case backgroundvideo.FieldWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = value.Float64
+			}
+		case backgroundvideo.FieldUseCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field use_count", values[i])
+			} else if value.Valid {
+				_m.UseCount = int(value.Int64)
+			}
+		case backgroundvideo.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		case backgroundvideo.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = new(string)
+				*_m.Hash = value.String
+			}
+		case backgroundvideo.FieldDuration:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				_m.Duration = new(float64)
+				*_m.Duration = value.Float64
+			}
+		case backgroundvideo.FieldCodec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field codec", values[i])
+			} else if value.Valid {
+				_m.Codec = new(string)
+				*_m.Codec = value.String
+			}
+		case backgroundvideo.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				_m.Width = new(int)
+				*_m.Width = int(value.Int64)
+			}
+		case backgroundvideo.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				_m.Height = new(int)
+				*_m.Height = int(value.Int64)
+			}
+		case backgroundvideo.FieldFps:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fps", values[i])
+			} else if value.Valid {
+				_m.Fps = new(float64)
+				*_m.Fps = value.Float64
+			}
+		case backgroundvideo.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case backgroundvideo.FieldScannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_at", values[i])
+			} else if value.Valid {
+				_m.ScannedAt = new(time.Time)
+				*_m.ScannedAt = value.Time
+			}
+		case backgroundvideo.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackgroundVideo.
+// This includes values selected through modifiers, order, etc.
+func (_m *BackgroundVideo) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryClips queries the "clips" edge of the BackgroundVideo entity.
+func (_m *BackgroundVideo) QueryClips() *ClipQuery {
+	return NewBackgroundVideoClient(_m.config).QueryClips(_m)
+}
+
+// Update returns a builder for updating this BackgroundVideo.
+// Note that you need to call BackgroundVideo.Unwrap() before calling this method if this BackgroundVideo
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BackgroundVideo) Update() *BackgroundVideoUpdateOne {
+	return NewBackgroundVideoClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BackgroundVideo entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BackgroundVideo) Unwrap() *BackgroundVideo {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackgroundVideo is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BackgroundVideo) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackgroundVideo(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("use_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UseCount))
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Hash; v != nil {
+		builder.WriteString("hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Duration; v != nil {
+		builder.WriteString("duration=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Codec; v != nil {
+		builder.WriteString("codec=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Width; v != nil {
+		builder.WriteString("width=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Height; v != nil {
+		builder.WriteString("height=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Fps; v != nil {
+		builder.WriteString("fps=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	if v := _m.ScannedAt; v != nil {
+		builder.WriteString("scanned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackgroundVideos is a parsable slice of BackgroundVideo.
+type BackgroundVideos []*BackgroundVideo
@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backgroundvideo
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/sam-laister/tiktok-creator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldID, id))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldPath, v))
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldWeight, v))
+}
+
+// UseCount applies equality check predicate on the "use_count" field. It's identical to UseCountEQ.
+func UseCount(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldUseCount, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldHash, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldDuration, v))
+}
+
+// Codec applies equality check predicate on the "codec" field. It's identical to CodecEQ.
+func Codec(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldCodec, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldHeight, v))
+}
+
+// Fps applies equality check predicate on the "fps" field. It's identical to FpsEQ.
+func Fps(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldFps, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldContainsFold(FieldPath, v))
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldWeight, v))
+}
+
+// UseCountEQ applies the EQ predicate on the "use_count" field.
+func UseCountEQ(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldUseCount, v))
+}
+
+// UseCountNEQ applies the NEQ predicate on the "use_count" field.
+func UseCountNEQ(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldUseCount, v))
+}
+
+// UseCountIn applies the In predicate on the "use_count" field.
+func UseCountIn(vs ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldUseCount, vs...))
+}
+
+// UseCountNotIn applies the NotIn predicate on the "use_count" field.
+func UseCountNotIn(vs ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldUseCount, vs...))
+}
+
+// UseCountGT applies the GT predicate on the "use_count" field.
+func UseCountGT(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldUseCount, v))
+}
+
+// UseCountGTE applies the GTE predicate on the "use_count" field.
+func UseCountGTE(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldUseCount, v))
+}
+
+// UseCountLT applies the LT predicate on the "use_count" field.
+func UseCountLT(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldUseCount, v))
+}
+
+// UseCountLTE applies the LTE predicate on the "use_count" field.
+func UseCountLTE(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldUseCount, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashIsNil applies the IsNil predicate on the "hash" field.
+func HashIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldHash))
+}
+
+// HashNotNil applies the NotNil predicate on the "hash" field.
+func HashNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldHash))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldContainsFold(FieldHash, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldDuration, v))
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldDuration))
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldDuration))
+}
+
+// CodecEQ applies the EQ predicate on the "codec" field.
+func CodecEQ(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldCodec, v))
+}
+
+// CodecNEQ applies the NEQ predicate on the "codec" field.
+func CodecNEQ(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldCodec, v))
+}
+
+// CodecIn applies the In predicate on the "codec" field.
+func CodecIn(vs ...string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldCodec, vs...))
+}
+
+// CodecNotIn applies the NotIn predicate on the "codec" field.
+func CodecNotIn(vs ...string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldCodec, vs...))
+}
+
+// CodecGT applies the GT predicate on the "codec" field.
+func CodecGT(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldCodec, v))
+}
+
+// CodecGTE applies the GTE predicate on the "codec" field.
+func CodecGTE(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldCodec, v))
+}
+
+// CodecLT applies the LT predicate on the "codec" field.
+func CodecLT(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldCodec, v))
+}
+
+// CodecLTE applies the LTE predicate on the "codec" field.
+func CodecLTE(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldCodec, v))
+}
+
+// CodecContains applies the Contains predicate on the "codec" field.
+func CodecContains(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldContains(FieldCodec, v))
+}
+
+// CodecHasPrefix applies the HasPrefix predicate on the "codec" field.
+func CodecHasPrefix(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldHasPrefix(FieldCodec, v))
+}
+
+// CodecHasSuffix applies the HasSuffix predicate on the "codec" field.
+func CodecHasSuffix(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldHasSuffix(FieldCodec, v))
+}
+
+// CodecIsNil applies the IsNil predicate on the "codec" field.
+func CodecIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldCodec))
+}
+
+// CodecNotNil applies the NotNil predicate on the "codec" field.
+func CodecNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldCodec))
+}
+
+// CodecEqualFold applies the EqualFold predicate on the "codec" field.
+func CodecEqualFold(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEqualFold(FieldCodec, v))
+}
+
+// CodecContainsFold applies the ContainsFold predicate on the "codec" field.
+func CodecContainsFold(v string) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldContainsFold(FieldCodec, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldHeight))
+}
+
+// FpsEQ applies the EQ predicate on the "fps" field.
+func FpsEQ(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldFps, v))
+}
+
+// FpsNEQ applies the NEQ predicate on the "fps" field.
+func FpsNEQ(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldFps, v))
+}
+
+// FpsIn applies the In predicate on the "fps" field.
+func FpsIn(vs ...float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldFps, vs...))
+}
+
+// FpsNotIn applies the NotIn predicate on the "fps" field.
+func FpsNotIn(vs ...float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldFps, vs...))
+}
+
+// FpsGT applies the GT predicate on the "fps" field.
+func FpsGT(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldFps, v))
+}
+
+// FpsGTE applies the GTE predicate on the "fps" field.
+func FpsGTE(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldFps, v))
+}
+
+// FpsLT applies the LT predicate on the "fps" field.
+func FpsLT(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldFps, v))
+}
+
+// FpsLTE applies the LTE predicate on the "fps" field.
+func FpsLTE(v float64) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldFps, v))
+}
+
+// FpsIsNil applies the IsNil predicate on the "fps" field.
+func FpsIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldFps))
+}
+
+// FpsNotNil applies the NotNil predicate on the "fps" field.
+func FpsNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldFps))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldTags))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// ScannedAtIsNil applies the IsNil predicate on the "scanned_at" field.
+func ScannedAtIsNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIsNull(FieldScannedAt))
+}
+
+// ScannedAtNotNil applies the NotNil predicate on the "scanned_at" field.
+func ScannedAtNotNil() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotNull(FieldScannedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasClips applies the HasEdge predicate on the "clips" edge.
+func HasClips() predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClipsTable, ClipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClipsWith applies the HasEdge predicate on the "clips" edge with a given conditions (other predicates).
+func HasClipsWith(preds ...predicate.Clip) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(func(s *sql.Selector) {
+		step := newClipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackgroundVideo) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackgroundVideo) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackgroundVideo) predicate.BackgroundVideo {
+	return predicate.BackgroundVideo(sql.NotPredicates(p))
+}
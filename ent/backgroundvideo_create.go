@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
+	"github.com/sam-laister/tiktok-creator/ent/clip"
+)
+
+// BackgroundVideoCreate is the builder for creating a BackgroundVideo entity.
+type BackgroundVideoCreate struct {
+	config
+	mutation *BackgroundVideoMutation
+	hooks    []Hook
+}
+
+// SetPath sets the "path" field.
+func (_c *BackgroundVideoCreate) SetPath(v string) *BackgroundVideoCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *BackgroundVideoCreate) SetWeight(v float64) *BackgroundVideoCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableWeight(v *float64) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetUseCount sets the "use_count" field.
+func (_c *BackgroundVideoCreate) SetUseCount(v int) *BackgroundVideoCreate {
+	_c.mutation.SetUseCount(v)
+	return _c
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableUseCount(v *int) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetUseCount(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *BackgroundVideoCreate) SetLastUsedAt(v time.Time) *BackgroundVideoCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableLastUsedAt(v *time.Time) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *BackgroundVideoCreate) SetHash(v string) *BackgroundVideoCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableHash(v *string) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetHash(*v)
+	}
+	return _c
+}
+
+// SetDuration sets the "duration" field.
+func (_c *BackgroundVideoCreate) SetDuration(v float64) *BackgroundVideoCreate {
+	_c.mutation.SetDuration(v)
+	return _c
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableDuration(v *float64) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetDuration(*v)
+	}
+	return _c
+}
+
+// SetCodec sets the "codec" field.
+func (_c *BackgroundVideoCreate) SetCodec(v string) *BackgroundVideoCreate {
+	_c.mutation.SetCodec(v)
+	return _c
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableCodec(v *string) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetCodec(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *BackgroundVideoCreate) SetWidth(v int) *BackgroundVideoCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableWidth(v *int) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *BackgroundVideoCreate) SetHeight(v int) *BackgroundVideoCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableHeight(v *int) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetFps sets the "fps" field.
+func (_c *BackgroundVideoCreate) SetFps(v float64) *BackgroundVideoCreate {
+	_c.mutation.SetFps(v)
+	return _c
+}
+
+// SetNillableFps sets the "fps" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableFps(v *float64) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetFps(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *BackgroundVideoCreate) SetTags(v map[string]string) *BackgroundVideoCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_c *BackgroundVideoCreate) SetScannedAt(v time.Time) *BackgroundVideoCreate {
+	_c.mutation.SetScannedAt(v)
+	return _c
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableScannedAt(v *time.Time) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetScannedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BackgroundVideoCreate) SetCreatedAt(v time.Time) *BackgroundVideoCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BackgroundVideoCreate) SetNillableCreatedAt(v *time.Time) *BackgroundVideoCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by IDs.
+func (_c *BackgroundVideoCreate) AddClipIDs(ids ...int) *BackgroundVideoCreate {
+	_c.mutation.AddClipIDs(ids...)
+	return _c
+}
+
+// AddClips adds the "clips" edges to the Clip entity.
+func (_c *BackgroundVideoCreate) AddClips(v ...*Clip) *BackgroundVideoCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddClipIDs(ids...)
+}
+
+// Mutation returns the BackgroundVideoMutation object of the builder.
+func (_c *BackgroundVideoCreate) Mutation() *BackgroundVideoMutation {
+	return _c.mutation
+}
+
+// Save creates the BackgroundVideo in the database.
+func (_c *BackgroundVideoCreate) Save(ctx context.Context) (*BackgroundVideo, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BackgroundVideoCreate) SaveX(ctx context.Context) *BackgroundVideo {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackgroundVideoCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackgroundVideoCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BackgroundVideoCreate) defaults() {
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := backgroundvideo.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.UseCount(); !ok {
+		v := backgroundvideo.DefaultUseCount
+		_c.mutation.SetUseCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := backgroundvideo.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BackgroundVideoCreate) check() error {
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "BackgroundVideo.path"`)}
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "BackgroundVideo.weight"`)}
+	}
+	if _, ok := _c.mutation.UseCount(); !ok {
+		return &ValidationError{Name: "use_count", err: errors.New(`ent: missing required field "BackgroundVideo.use_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackgroundVideo.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BackgroundVideoCreate) sqlSave(ctx context.Context) (*BackgroundVideo, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BackgroundVideoCreate) createSpec() (*BackgroundVideo, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackgroundVideo{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(backgroundvideo.Table, sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(backgroundvideo.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(backgroundvideo.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.UseCount(); ok {
+		_spec.SetField(backgroundvideo.FieldUseCount, field.TypeInt, value)
+		_node.UseCount = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(backgroundvideo.FieldHash, field.TypeString, value)
+		_node.Hash = &value
+	}
+	if value, ok := _c.mutation.Duration(); ok {
+		_spec.SetField(backgroundvideo.FieldDuration, field.TypeFloat64, value)
+		_node.Duration = &value
+	}
+	if value, ok := _c.mutation.Codec(); ok {
+		_spec.SetField(backgroundvideo.FieldCodec, field.TypeString, value)
+		_node.Codec = &value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(backgroundvideo.FieldWidth, field.TypeInt, value)
+		_node.Width = &value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(backgroundvideo.FieldHeight, field.TypeInt, value)
+		_node.Height = &value
+	}
+	if value, ok := _c.mutation.Fps(); ok {
+		_spec.SetField(backgroundvideo.FieldFps, field.TypeFloat64, value)
+		_node.Fps = &value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(backgroundvideo.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.ScannedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BackgroundVideoCreateBulk is the builder for creating many BackgroundVideo entities in bulk.
+type BackgroundVideoCreateBulk struct {
+	config
+	err      error
+	builders []*BackgroundVideoCreate
+}
+
+// Save creates the BackgroundVideo entities in the database.
+func (_c *BackgroundVideoCreateBulk) Save(ctx context.Context) ([]*BackgroundVideo, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BackgroundVideo, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackgroundVideoMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BackgroundVideoCreateBulk) SaveX(ctx context.Context) []*BackgroundVideo {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackgroundVideoCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackgroundVideoCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
+	"github.com/sam-laister/tiktok-creator/ent/predicate"
+)
+
+// BackgroundVideoDelete is the builder for deleting a BackgroundVideo entity.
+type BackgroundVideoDelete struct {
+	config
+	hooks    []Hook
+	mutation *BackgroundVideoMutation
+}
+
+// Where appends a list predicates to the BackgroundVideoDelete builder.
+func (_d *BackgroundVideoDelete) Where(ps ...predicate.BackgroundVideo) *BackgroundVideoDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BackgroundVideoDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BackgroundVideoDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BackgroundVideoDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(backgroundvideo.Table, sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BackgroundVideoDeleteOne is the builder for deleting a single BackgroundVideo entity.
+type BackgroundVideoDeleteOne struct {
+	_d *BackgroundVideoDelete
+}
+
+// Where appends a list predicates to the BackgroundVideoDelete builder.
+func (_d *BackgroundVideoDeleteOne) Where(ps ...predicate.BackgroundVideo) *BackgroundVideoDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BackgroundVideoDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{backgroundvideo.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BackgroundVideoDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
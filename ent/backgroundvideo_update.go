@@ -0,0 +1,1047 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
+	"github.com/sam-laister/tiktok-creator/ent/clip"
+	"github.com/sam-laister/tiktok-creator/ent/predicate"
+)
+
+// BackgroundVideoUpdate is the builder for updating BackgroundVideo entities.
+type BackgroundVideoUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackgroundVideoMutation
+}
+
+// Where appends a list predicates to the BackgroundVideoUpdate builder.
+func (_u *BackgroundVideoUpdate) Where(ps ...predicate.BackgroundVideo) *BackgroundVideoUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BackgroundVideoUpdate) SetPath(v string) *BackgroundVideoUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillablePath(v *string) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *BackgroundVideoUpdate) SetWeight(v float64) *BackgroundVideoUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableWeight(v *float64) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *BackgroundVideoUpdate) AddWeight(v float64) *BackgroundVideoUpdate {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// SetUseCount sets the "use_count" field.
+func (_u *BackgroundVideoUpdate) SetUseCount(v int) *BackgroundVideoUpdate {
+	_u.mutation.ResetUseCount()
+	_u.mutation.SetUseCount(v)
+	return _u
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableUseCount(v *int) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetUseCount(*v)
+	}
+	return _u
+}
+
+// AddUseCount adds value to the "use_count" field.
+func (_u *BackgroundVideoUpdate) AddUseCount(v int) *BackgroundVideoUpdate {
+	_u.mutation.AddUseCount(v)
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *BackgroundVideoUpdate) SetLastUsedAt(v time.Time) *BackgroundVideoUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableLastUsedAt(v *time.Time) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *BackgroundVideoUpdate) ClearLastUsedAt() *BackgroundVideoUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BackgroundVideoUpdate) SetHash(v string) *BackgroundVideoUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableHash(v *string) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *BackgroundVideoUpdate) ClearHash() *BackgroundVideoUpdate {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *BackgroundVideoUpdate) SetDuration(v float64) *BackgroundVideoUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableDuration(v *float64) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *BackgroundVideoUpdate) AddDuration(v float64) *BackgroundVideoUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *BackgroundVideoUpdate) ClearDuration() *BackgroundVideoUpdate {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
+// SetCodec sets the "codec" field.
+func (_u *BackgroundVideoUpdate) SetCodec(v string) *BackgroundVideoUpdate {
+	_u.mutation.SetCodec(v)
+	return _u
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableCodec(v *string) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetCodec(*v)
+	}
+	return _u
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (_u *BackgroundVideoUpdate) ClearCodec() *BackgroundVideoUpdate {
+	_u.mutation.ClearCodec()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *BackgroundVideoUpdate) SetWidth(v int) *BackgroundVideoUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableWidth(v *int) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *BackgroundVideoUpdate) AddWidth(v int) *BackgroundVideoUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *BackgroundVideoUpdate) ClearWidth() *BackgroundVideoUpdate {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *BackgroundVideoUpdate) SetHeight(v int) *BackgroundVideoUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableHeight(v *int) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *BackgroundVideoUpdate) AddHeight(v int) *BackgroundVideoUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *BackgroundVideoUpdate) ClearHeight() *BackgroundVideoUpdate {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetFps sets the "fps" field.
+func (_u *BackgroundVideoUpdate) SetFps(v float64) *BackgroundVideoUpdate {
+	_u.mutation.ResetFps()
+	_u.mutation.SetFps(v)
+	return _u
+}
+
+// SetNillableFps sets the "fps" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableFps(v *float64) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetFps(*v)
+	}
+	return _u
+}
+
+// AddFps adds value to the "fps" field.
+func (_u *BackgroundVideoUpdate) AddFps(v float64) *BackgroundVideoUpdate {
+	_u.mutation.AddFps(v)
+	return _u
+}
+
+// ClearFps clears the value of the "fps" field.
+func (_u *BackgroundVideoUpdate) ClearFps() *BackgroundVideoUpdate {
+	_u.mutation.ClearFps()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *BackgroundVideoUpdate) SetTags(v map[string]string) *BackgroundVideoUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *BackgroundVideoUpdate) ClearTags() *BackgroundVideoUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *BackgroundVideoUpdate) SetScannedAt(v time.Time) *BackgroundVideoUpdate {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableScannedAt(v *time.Time) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (_u *BackgroundVideoUpdate) ClearScannedAt() *BackgroundVideoUpdate {
+	_u.mutation.ClearScannedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BackgroundVideoUpdate) SetCreatedAt(v time.Time) *BackgroundVideoUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BackgroundVideoUpdate) SetNillableCreatedAt(v *time.Time) *BackgroundVideoUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by IDs.
+func (_u *BackgroundVideoUpdate) AddClipIDs(ids ...int) *BackgroundVideoUpdate {
+	_u.mutation.AddClipIDs(ids...)
+	return _u
+}
+
+// AddClips adds the "clips" edges to the Clip entity.
+func (_u *BackgroundVideoUpdate) AddClips(v ...*Clip) *BackgroundVideoUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClipIDs(ids...)
+}
+
+// Mutation returns the BackgroundVideoMutation object of the builder.
+func (_u *BackgroundVideoUpdate) Mutation() *BackgroundVideoMutation {
+	return _u.mutation
+}
+
+// ClearClips clears all "clips" edges to the Clip entity.
+func (_u *BackgroundVideoUpdate) ClearClips() *BackgroundVideoUpdate {
+	_u.mutation.ClearClips()
+	return _u
+}
+
+// RemoveClipIDs removes the "clips" edge to Clip entities by IDs.
+func (_u *BackgroundVideoUpdate) RemoveClipIDs(ids ...int) *BackgroundVideoUpdate {
+	_u.mutation.RemoveClipIDs(ids...)
+	return _u
+}
+
+// RemoveClips removes "clips" edges to Clip entities.
+func (_u *BackgroundVideoUpdate) RemoveClips(v ...*Clip) *BackgroundVideoUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClipIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BackgroundVideoUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackgroundVideoUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BackgroundVideoUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackgroundVideoUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BackgroundVideoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backgroundvideo.Table, backgroundvideo.Columns, sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(backgroundvideo.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(backgroundvideo.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(backgroundvideo.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UseCount(); ok {
+		_spec.SetField(backgroundvideo.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUseCount(); ok {
+		_spec.AddField(backgroundvideo.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(backgroundvideo.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(backgroundvideo.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(backgroundvideo.FieldHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(backgroundvideo.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(backgroundvideo.FieldDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(backgroundvideo.FieldDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Codec(); ok {
+		_spec.SetField(backgroundvideo.FieldCodec, field.TypeString, value)
+	}
+	if _u.mutation.CodecCleared() {
+		_spec.ClearField(backgroundvideo.FieldCodec, field.TypeString)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(backgroundvideo.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(backgroundvideo.FieldWidth, field.TypeInt, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(backgroundvideo.FieldWidth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(backgroundvideo.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(backgroundvideo.FieldHeight, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(backgroundvideo.FieldHeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Fps(); ok {
+		_spec.SetField(backgroundvideo.FieldFps, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFps(); ok {
+		_spec.AddField(backgroundvideo.FieldFps, field.TypeFloat64, value)
+	}
+	if _u.mutation.FpsCleared() {
+		_spec.ClearField(backgroundvideo.FieldFps, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(backgroundvideo.FieldTags, field.TypeJSON, value)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(backgroundvideo.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldScannedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScannedAtCleared() {
+		_spec.ClearField(backgroundvideo.FieldScannedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClipsIDs(); len(nodes) > 0 && !_u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backgroundvideo.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BackgroundVideoUpdateOne is the builder for updating a single BackgroundVideo entity.
+type BackgroundVideoUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackgroundVideoMutation
+}
+
+// SetPath sets the "path" field.
+func (_u *BackgroundVideoUpdateOne) SetPath(v string) *BackgroundVideoUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillablePath(v *string) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *BackgroundVideoUpdateOne) SetWeight(v float64) *BackgroundVideoUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableWeight(v *float64) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *BackgroundVideoUpdateOne) AddWeight(v float64) *BackgroundVideoUpdateOne {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// SetUseCount sets the "use_count" field.
+func (_u *BackgroundVideoUpdateOne) SetUseCount(v int) *BackgroundVideoUpdateOne {
+	_u.mutation.ResetUseCount()
+	_u.mutation.SetUseCount(v)
+	return _u
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableUseCount(v *int) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetUseCount(*v)
+	}
+	return _u
+}
+
+// AddUseCount adds value to the "use_count" field.
+func (_u *BackgroundVideoUpdateOne) AddUseCount(v int) *BackgroundVideoUpdateOne {
+	_u.mutation.AddUseCount(v)
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *BackgroundVideoUpdateOne) SetLastUsedAt(v time.Time) *BackgroundVideoUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableLastUsedAt(v *time.Time) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *BackgroundVideoUpdateOne) ClearLastUsedAt() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BackgroundVideoUpdateOne) SetHash(v string) *BackgroundVideoUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableHash(v *string) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *BackgroundVideoUpdateOne) ClearHash() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *BackgroundVideoUpdateOne) SetDuration(v float64) *BackgroundVideoUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableDuration(v *float64) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *BackgroundVideoUpdateOne) AddDuration(v float64) *BackgroundVideoUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *BackgroundVideoUpdateOne) ClearDuration() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
+// SetCodec sets the "codec" field.
+func (_u *BackgroundVideoUpdateOne) SetCodec(v string) *BackgroundVideoUpdateOne {
+	_u.mutation.SetCodec(v)
+	return _u
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableCodec(v *string) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetCodec(*v)
+	}
+	return _u
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (_u *BackgroundVideoUpdateOne) ClearCodec() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearCodec()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *BackgroundVideoUpdateOne) SetWidth(v int) *BackgroundVideoUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableWidth(v *int) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *BackgroundVideoUpdateOne) AddWidth(v int) *BackgroundVideoUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *BackgroundVideoUpdateOne) ClearWidth() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *BackgroundVideoUpdateOne) SetHeight(v int) *BackgroundVideoUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableHeight(v *int) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *BackgroundVideoUpdateOne) AddHeight(v int) *BackgroundVideoUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *BackgroundVideoUpdateOne) ClearHeight() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetFps sets the "fps" field.
+func (_u *BackgroundVideoUpdateOne) SetFps(v float64) *BackgroundVideoUpdateOne {
+	_u.mutation.ResetFps()
+	_u.mutation.SetFps(v)
+	return _u
+}
+
+// SetNillableFps sets the "fps" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableFps(v *float64) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetFps(*v)
+	}
+	return _u
+}
+
+// AddFps adds value to the "fps" field.
+func (_u *BackgroundVideoUpdateOne) AddFps(v float64) *BackgroundVideoUpdateOne {
+	_u.mutation.AddFps(v)
+	return _u
+}
+
+// ClearFps clears the value of the "fps" field.
+func (_u *BackgroundVideoUpdateOne) ClearFps() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearFps()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *BackgroundVideoUpdateOne) SetTags(v map[string]string) *BackgroundVideoUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *BackgroundVideoUpdateOne) ClearTags() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *BackgroundVideoUpdateOne) SetScannedAt(v time.Time) *BackgroundVideoUpdateOne {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableScannedAt(v *time.Time) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (_u *BackgroundVideoUpdateOne) ClearScannedAt() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearScannedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BackgroundVideoUpdateOne) SetCreatedAt(v time.Time) *BackgroundVideoUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BackgroundVideoUpdateOne) SetNillableCreatedAt(v *time.Time) *BackgroundVideoUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by IDs.
+func (_u *BackgroundVideoUpdateOne) AddClipIDs(ids ...int) *BackgroundVideoUpdateOne {
+	_u.mutation.AddClipIDs(ids...)
+	return _u
+}
+
+// AddClips adds the "clips" edges to the Clip entity.
+func (_u *BackgroundVideoUpdateOne) AddClips(v ...*Clip) *BackgroundVideoUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClipIDs(ids...)
+}
+
+// Mutation returns the BackgroundVideoMutation object of the builder.
+func (_u *BackgroundVideoUpdateOne) Mutation() *BackgroundVideoMutation {
+	return _u.mutation
+}
+
+// ClearClips clears all "clips" edges to the Clip entity.
+func (_u *BackgroundVideoUpdateOne) ClearClips() *BackgroundVideoUpdateOne {
+	_u.mutation.ClearClips()
+	return _u
+}
+
+// RemoveClipIDs removes the "clips" edge to Clip entities by IDs.
+func (_u *BackgroundVideoUpdateOne) RemoveClipIDs(ids ...int) *BackgroundVideoUpdateOne {
+	_u.mutation.RemoveClipIDs(ids...)
+	return _u
+}
+
+// RemoveClips removes "clips" edges to Clip entities.
+func (_u *BackgroundVideoUpdateOne) RemoveClips(v ...*Clip) *BackgroundVideoUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClipIDs(ids...)
+}
+
+// Where appends a list predicates to the BackgroundVideoUpdate builder.
+func (_u *BackgroundVideoUpdateOne) Where(ps ...predicate.BackgroundVideo) *BackgroundVideoUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BackgroundVideoUpdateOne) Select(field string, fields ...string) *BackgroundVideoUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BackgroundVideo entity.
+func (_u *BackgroundVideoUpdateOne) Save(ctx context.Context) (*BackgroundVideo, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackgroundVideoUpdateOne) SaveX(ctx context.Context) *BackgroundVideo {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BackgroundVideoUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackgroundVideoUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BackgroundVideoUpdateOne) sqlSave(ctx context.Context) (_node *BackgroundVideo, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backgroundvideo.Table, backgroundvideo.Columns, sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackgroundVideo.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backgroundvideo.FieldID)
+		for _, f := range fields {
+			if !backgroundvideo.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backgroundvideo.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(backgroundvideo.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(backgroundvideo.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(backgroundvideo.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UseCount(); ok {
+		_spec.SetField(backgroundvideo.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUseCount(); ok {
+		_spec.AddField(backgroundvideo.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(backgroundvideo.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(backgroundvideo.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(backgroundvideo.FieldHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(backgroundvideo.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(backgroundvideo.FieldDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(backgroundvideo.FieldDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Codec(); ok {
+		_spec.SetField(backgroundvideo.FieldCodec, field.TypeString, value)
+	}
+	if _u.mutation.CodecCleared() {
+		_spec.ClearField(backgroundvideo.FieldCodec, field.TypeString)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(backgroundvideo.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(backgroundvideo.FieldWidth, field.TypeInt, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(backgroundvideo.FieldWidth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(backgroundvideo.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(backgroundvideo.FieldHeight, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(backgroundvideo.FieldHeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Fps(); ok {
+		_spec.SetField(backgroundvideo.FieldFps, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFps(); ok {
+		_spec.AddField(backgroundvideo.FieldFps, field.TypeFloat64, value)
+	}
+	if _u.mutation.FpsCleared() {
+		_spec.ClearField(backgroundvideo.FieldFps, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(backgroundvideo.FieldTags, field.TypeJSON, value)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(backgroundvideo.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldScannedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScannedAtCleared() {
+		_spec.ClearField(backgroundvideo.FieldScannedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(backgroundvideo.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClipsIDs(); len(nodes) > 0 && !_u.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backgroundvideo.ClipsTable,
+			Columns: []string{backgroundvideo.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackgroundVideo{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backgroundvideo.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
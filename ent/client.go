@@ -14,6 +14,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
 )
 
@@ -22,6 +25,10 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Audio is the client for interacting with the Audio builders.
+	Audio *AudioClient
+	// BackgroundVideo is the client for interacting with the BackgroundVideo builders.
+	BackgroundVideo *BackgroundVideoClient
 	// Clip is the client for interacting with the Clip builders.
 	Clip *ClipClient
 }
@@ -35,6 +42,8 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Audio = NewAudioClient(c.config)
+	c.BackgroundVideo = NewBackgroundVideoClient(c.config)
 	c.Clip = NewClipClient(c.config)
 }
 
@@ -126,9 +135,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Clip:   NewClipClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Audio:           NewAudioClient(cfg),
+		BackgroundVideo: NewBackgroundVideoClient(cfg),
+		Clip:            NewClipClient(cfg),
 	}, nil
 }
 
@@ -146,16 +157,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Clip:   NewClipClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Audio:           NewAudioClient(cfg),
+		BackgroundVideo: NewBackgroundVideoClient(cfg),
+		Clip:            NewClipClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Clip.
+//		Audio.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,18 +190,26 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Audio.Use(hooks...)
+	c.BackgroundVideo.Use(hooks...)
 	c.Clip.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Audio.Intercept(interceptors...)
+	c.BackgroundVideo.Intercept(interceptors...)
 	c.Clip.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AudioMutation:
+		return c.Audio.mutate(ctx, m)
+	case *BackgroundVideoMutation:
+		return c.BackgroundVideo.mutate(ctx, m)
 	case *ClipMutation:
 		return c.Clip.mutate(ctx, m)
 	default:
@@ -196,6 +217,304 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AudioClient is a client for the Audio schema.
+type AudioClient struct {
+	config
+}
+
+// NewAudioClient returns a client for the Audio from the given config.
+func NewAudioClient(c config) *AudioClient {
+	return &AudioClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `audio.Hooks(f(g(h())))`.
+func (c *AudioClient) Use(hooks ...Hook) {
+	c.hooks.Audio = append(c.hooks.Audio, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `audio.Intercept(f(g(h())))`.
+func (c *AudioClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Audio = append(c.inters.Audio, interceptors...)
+}
+
+// Create returns a builder for creating a Audio entity.
+func (c *AudioClient) Create() *AudioCreate {
+	mutation := newAudioMutation(c.config, OpCreate)
+	return &AudioCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Audio entities.
+func (c *AudioClient) CreateBulk(builders ...*AudioCreate) *AudioCreateBulk {
+	return &AudioCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AudioClient) MapCreateBulk(slice any, setFunc func(*AudioCreate, int)) *AudioCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AudioCreateBulk{err: fmt.Errorf("calling to AudioClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AudioCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AudioCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Audio.
+func (c *AudioClient) Update() *AudioUpdate {
+	mutation := newAudioMutation(c.config, OpUpdate)
+	return &AudioUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AudioClient) UpdateOne(_m *Audio) *AudioUpdateOne {
+	mutation := newAudioMutation(c.config, OpUpdateOne, withAudio(_m))
+	return &AudioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AudioClient) UpdateOneID(id int) *AudioUpdateOne {
+	mutation := newAudioMutation(c.config, OpUpdateOne, withAudioID(id))
+	return &AudioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Audio.
+func (c *AudioClient) Delete() *AudioDelete {
+	mutation := newAudioMutation(c.config, OpDelete)
+	return &AudioDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AudioClient) DeleteOne(_m *Audio) *AudioDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AudioClient) DeleteOneID(id int) *AudioDeleteOne {
+	builder := c.Delete().Where(audio.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AudioDeleteOne{builder}
+}
+
+// Query returns a query builder for Audio.
+func (c *AudioClient) Query() *AudioQuery {
+	return &AudioQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAudio},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Audio entity by its id.
+func (c *AudioClient) Get(ctx context.Context, id int) (*Audio, error) {
+	return c.Query().Where(audio.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AudioClient) GetX(ctx context.Context, id int) *Audio {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClips queries the clips edge of a Audio.
+func (c *AudioClient) QueryClips(_m *Audio) *ClipQuery {
+	query := (&ClipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(audio.Table, audio.FieldID, id),
+			sqlgraph.To(clip.Table, clip.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, audio.ClipsTable, audio.ClipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AudioClient) Hooks() []Hook {
+	return c.hooks.Audio
+}
+
+// Interceptors returns the client interceptors.
+func (c *AudioClient) Interceptors() []Interceptor {
+	return c.inters.Audio
+}
+
+func (c *AudioClient) mutate(ctx context.Context, m *AudioMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AudioCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AudioUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AudioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AudioDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Audio mutation op: %q", m.Op())
+	}
+}
+
+// BackgroundVideoClient is a client for the BackgroundVideo schema.
+type BackgroundVideoClient struct {
+	config
+}
+
+// NewBackgroundVideoClient returns a client for the BackgroundVideo from the given config.
+func NewBackgroundVideoClient(c config) *BackgroundVideoClient {
+	return &BackgroundVideoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backgroundvideo.Hooks(f(g(h())))`.
+func (c *BackgroundVideoClient) Use(hooks ...Hook) {
+	c.hooks.BackgroundVideo = append(c.hooks.BackgroundVideo, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backgroundvideo.Intercept(f(g(h())))`.
+func (c *BackgroundVideoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackgroundVideo = append(c.inters.BackgroundVideo, interceptors...)
+}
+
+// Create returns a builder for creating a BackgroundVideo entity.
+func (c *BackgroundVideoClient) Create() *BackgroundVideoCreate {
+	mutation := newBackgroundVideoMutation(c.config, OpCreate)
+	return &BackgroundVideoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackgroundVideo entities.
+func (c *BackgroundVideoClient) CreateBulk(builders ...*BackgroundVideoCreate) *BackgroundVideoCreateBulk {
+	return &BackgroundVideoCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackgroundVideoClient) MapCreateBulk(slice any, setFunc func(*BackgroundVideoCreate, int)) *BackgroundVideoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackgroundVideoCreateBulk{err: fmt.Errorf("calling to BackgroundVideoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackgroundVideoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackgroundVideoCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackgroundVideo.
+func (c *BackgroundVideoClient) Update() *BackgroundVideoUpdate {
+	mutation := newBackgroundVideoMutation(c.config, OpUpdate)
+	return &BackgroundVideoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackgroundVideoClient) UpdateOne(_m *BackgroundVideo) *BackgroundVideoUpdateOne {
+	mutation := newBackgroundVideoMutation(c.config, OpUpdateOne, withBackgroundVideo(_m))
+	return &BackgroundVideoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackgroundVideoClient) UpdateOneID(id int) *BackgroundVideoUpdateOne {
+	mutation := newBackgroundVideoMutation(c.config, OpUpdateOne, withBackgroundVideoID(id))
+	return &BackgroundVideoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackgroundVideo.
+func (c *BackgroundVideoClient) Delete() *BackgroundVideoDelete {
+	mutation := newBackgroundVideoMutation(c.config, OpDelete)
+	return &BackgroundVideoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackgroundVideoClient) DeleteOne(_m *BackgroundVideo) *BackgroundVideoDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackgroundVideoClient) DeleteOneID(id int) *BackgroundVideoDeleteOne {
+	builder := c.Delete().Where(backgroundvideo.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackgroundVideoDeleteOne{builder}
+}
+
+// Query returns a query builder for BackgroundVideo.
+func (c *BackgroundVideoClient) Query() *BackgroundVideoQuery {
+	return &BackgroundVideoQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackgroundVideo},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackgroundVideo entity by its id.
+func (c *BackgroundVideoClient) Get(ctx context.Context, id int) (*BackgroundVideo, error) {
+	return c.Query().Where(backgroundvideo.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackgroundVideoClient) GetX(ctx context.Context, id int) *BackgroundVideo {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClips queries the clips edge of a BackgroundVideo.
+func (c *BackgroundVideoClient) QueryClips(_m *BackgroundVideo) *ClipQuery {
+	query := (&ClipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backgroundvideo.Table, backgroundvideo.FieldID, id),
+			sqlgraph.To(clip.Table, clip.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backgroundvideo.ClipsTable, backgroundvideo.ClipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackgroundVideoClient) Hooks() []Hook {
+	return c.hooks.BackgroundVideo
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackgroundVideoClient) Interceptors() []Interceptor {
+	return c.inters.BackgroundVideo
+}
+
+func (c *BackgroundVideoClient) mutate(ctx context.Context, m *BackgroundVideoMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackgroundVideoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackgroundVideoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackgroundVideoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackgroundVideoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackgroundVideo mutation op: %q", m.Op())
+	}
+}
+
 // ClipClient is a client for the Clip schema.
 type ClipClient struct {
 	config
@@ -304,6 +623,38 @@ func (c *ClipClient) GetX(ctx context.Context, id int) *Clip {
 	return obj
 }
 
+// QueryAudio queries the audio edge of a Clip.
+func (c *ClipClient) QueryAudio(_m *Clip) *AudioQuery {
+	query := (&AudioClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clip.Table, clip.FieldID, id),
+			sqlgraph.To(audio.Table, audio.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, clip.AudioTable, clip.AudioColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBackgroundVideo queries the background_video edge of a Clip.
+func (c *ClipClient) QueryBackgroundVideo(_m *Clip) *BackgroundVideoQuery {
+	query := (&BackgroundVideoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clip.Table, clip.FieldID, id),
+			sqlgraph.To(backgroundvideo.Table, backgroundvideo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, clip.BackgroundVideoTable, clip.BackgroundVideoColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ClipClient) Hooks() []Hook {
 	return c.hooks.Clip
@@ -332,9 +683,9 @@ func (c *ClipClient) mutate(ctx context.Context, m *ClipMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Clip []ent.Hook
+		Audio, BackgroundVideo, Clip []ent.Hook
 	}
 	inters struct {
-		Clip []ent.Interceptor
+		Audio, BackgroundVideo, Clip []ent.Interceptor
 	}
 )
@@ -3,13 +3,17 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 // Clip is the model entity for the Clip schema.
@@ -23,31 +27,133 @@ type Clip struct {
 	AudioPath string `json:"audio_path,omitempty"`
 	// VideoPath holds the value of the "video_path" field.
 	VideoPath string `json:"video_path,omitempty"`
+	// Artist holds the value of the "artist" field.
+	Artist string `json:"artist,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Album holds the value of the "album" field.
+	Album string `json:"album,omitempty"`
+	// Bio holds the value of the "bio" field.
+	Bio *string `json:"bio,omitempty"`
+	// BioPath holds the value of the "bio_path" field.
+	BioPath *string `json:"bio_path,omitempty"`
+	// Hashtags holds the value of the "hashtags" field.
+	Hashtags []string `json:"hashtags,omitempty"`
+	// AudioID holds the value of the "audio_id" field.
+	AudioID *int `json:"audio_id,omitempty"`
+	// BackgroundVideoID holds the value of the "background_video_id" field.
+	BackgroundVideoID *int `json:"background_video_id,omitempty"`
 	// GenCaptionsPath holds the value of the "gen_captions_path" field.
 	GenCaptionsPath *string `json:"gen_captions_path,omitempty"`
 	// GenRawVideoPath holds the value of the "gen_raw_video_path" field.
 	GenRawVideoPath *string `json:"gen_raw_video_path,omitempty"`
 	// GenTrimmedVideoPath holds the value of the "gen_trimmed_video_path" field.
 	GenTrimmedVideoPath *string `json:"gen_trimmed_video_path,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime string `json:"start_time,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime string `json:"end_time,omitempty"`
+	// TranscriptPath holds the value of the "transcript_path" field.
+	TranscriptPath *string `json:"transcript_path,omitempty"`
+	// SubtitlesPath holds the value of the "subtitles_path" field.
+	SubtitlesPath *string `json:"subtitles_path,omitempty"`
+	// LyricsPath holds the value of the "lyrics_path" field.
+	LyricsPath *string `json:"lyrics_path,omitempty"`
+	// LyricsAlignment holds the value of the "lyrics_alignment" field.
+	LyricsAlignment *model.LyricsAlignment `json:"lyrics_alignment,omitempty"`
+	// CensorLog holds the value of the "censor_log" field.
+	CensorLog []model.CensorEvent `json:"censor_log,omitempty"`
+	// CaptionsReviewedAt holds the value of the "captions_reviewed_at" field.
+	CaptionsReviewedAt *time.Time `json:"captions_reviewed_at,omitempty"`
+	// CaptionStyle holds the value of the "caption_style" field.
+	CaptionStyle *model.CaptionStyle `json:"caption_style,omitempty"`
+	// SegmentPlan holds the value of the "segment_plan" field.
+	SegmentPlan *model.SegmentPlan `json:"segment_plan,omitempty"`
+	// Seed holds the value of the "seed" field.
+	Seed *int64 `json:"seed,omitempty"`
+	// BackgroundStart holds the value of the "background_start" field.
+	BackgroundStart *float64 `json:"background_start,omitempty"`
+	// Width holds the value of the "width" field.
+	Width *int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height *int `json:"height,omitempty"`
+	// FadeDuration holds the value of the "fade_duration" field.
+	FadeDuration *int `json:"fade_duration,omitempty"`
+	// FadeInDuration holds the value of the "fade_in_duration" field.
+	FadeInDuration *float64 `json:"fade_in_duration,omitempty"`
+	// AudioCensor holds the value of the "audio_censor" field.
+	AudioCensor *model.AudioCensor `json:"audio_censor,omitempty"`
+	// Loudness holds the value of the "loudness" field.
+	Loudness *model.ClipLoudness `json:"loudness,omitempty"`
+	// Status holds the value of the "status" field.
+	Status model.ClipStatus `json:"status,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage model.ClipStage `json:"stage,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// StageTimestamps holds the value of the "stage_timestamps" field.
+	StageTimestamps map[model.ClipStage]*model.StageTimestamps `json:"stage_timestamps,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
-	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClipQuery when eager-loading is set.
+	Edges        ClipEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// ClipEdges holds the relations/edges for other nodes in the graph.
+type ClipEdges struct {
+	// Audio holds the value of the audio edge.
+	Audio *Audio `json:"audio,omitempty"`
+	// BackgroundVideo holds the value of the background_video edge.
+	BackgroundVideo *BackgroundVideo `json:"background_video,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AudioOrErr returns the Audio value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClipEdges) AudioOrErr() (*Audio, error) {
+	if e.Audio != nil {
+		return e.Audio, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: audio.Label}
+	}
+	return nil, &NotLoadedError{edge: "audio"}
+}
+
+// BackgroundVideoOrErr returns the BackgroundVideo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClipEdges) BackgroundVideoOrErr() (*BackgroundVideo, error) {
+	if e.BackgroundVideo != nil {
+		return e.BackgroundVideo, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: backgroundvideo.Label}
+	}
+	return nil, &NotLoadedError{edge: "background_video"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Clip) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case clip.FieldID:
+		case clip.FieldHashtags, clip.FieldLyricsAlignment, clip.FieldCensorLog, clip.FieldCaptionStyle, clip.FieldSegmentPlan, clip.FieldAudioCensor, clip.FieldLoudness, clip.FieldStageTimestamps:
+			values[i] = new([]byte)
+		case clip.FieldBackgroundStart, clip.FieldFadeInDuration:
+			values[i] = new(sql.NullFloat64)
+		case clip.FieldID, clip.FieldAudioID, clip.FieldBackgroundVideoID, clip.FieldSeed, clip.FieldWidth, clip.FieldHeight, clip.FieldFadeDuration, clip.FieldAttempts:
 			values[i] = new(sql.NullInt64)
-		case clip.FieldHash, clip.FieldAudioPath, clip.FieldVideoPath, clip.FieldGenCaptionsPath, clip.FieldGenRawVideoPath, clip.FieldGenTrimmedVideoPath:
+		case clip.FieldHash, clip.FieldAudioPath, clip.FieldVideoPath, clip.FieldArtist, clip.FieldTitle, clip.FieldAlbum, clip.FieldBio, clip.FieldBioPath, clip.FieldGenCaptionsPath, clip.FieldGenRawVideoPath, clip.FieldGenTrimmedVideoPath, clip.FieldStartTime, clip.FieldEndTime, clip.FieldTranscriptPath, clip.FieldSubtitlesPath, clip.FieldLyricsPath, clip.FieldStatus, clip.FieldStage, clip.FieldLastError:
 			values[i] = new(sql.NullString)
-		case clip.FieldCreatedAt, clip.FieldUpdatedAt, clip.FieldDeletedAt:
+		case clip.FieldCaptionsReviewedAt, clip.FieldCreatedAt, clip.FieldUpdatedAt, clip.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -88,6 +194,60 @@ func (_m *Clip) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.VideoPath = value.String
 			}
+		case clip.FieldArtist:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field artist", values[i])
+			} else if value.Valid {
+				_m.Artist = value.String
+			}
+		case clip.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case clip.FieldAlbum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field album", values[i])
+			} else if value.Valid {
+				_m.Album = value.String
+			}
+		case clip.FieldBio:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio", values[i])
+			} else if value.Valid {
+				_m.Bio = new(string)
+				*_m.Bio = value.String
+			}
+		case clip.FieldBioPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio_path", values[i])
+			} else if value.Valid {
+				_m.BioPath = new(string)
+				*_m.BioPath = value.String
+			}
+		case clip.FieldHashtags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hashtags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Hashtags); err != nil {
+					return fmt.Errorf("unmarshal field hashtags: %w", err)
+				}
+			}
+		case clip.FieldAudioID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_id", values[i])
+			} else if value.Valid {
+				_m.AudioID = new(int)
+				*_m.AudioID = int(value.Int64)
+			}
+		case clip.FieldBackgroundVideoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field background_video_id", values[i])
+			} else if value.Valid {
+				_m.BackgroundVideoID = new(int)
+				*_m.BackgroundVideoID = int(value.Int64)
+			}
 		case clip.FieldGenCaptionsPath:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field gen_captions_path", values[i])
@@ -109,6 +269,169 @@ func (_m *Clip) assignValues(columns []string, values []any) error {
 				_m.GenTrimmedVideoPath = new(string)
 				*_m.GenTrimmedVideoPath = value.String
 			}
+		case clip.FieldStartTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.String
+			}
+		case clip.FieldEndTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.String
+			}
+		case clip.FieldTranscriptPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transcript_path", values[i])
+			} else if value.Valid {
+				_m.TranscriptPath = new(string)
+				*_m.TranscriptPath = value.String
+			}
+		case clip.FieldSubtitlesPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subtitles_path", values[i])
+			} else if value.Valid {
+				_m.SubtitlesPath = new(string)
+				*_m.SubtitlesPath = value.String
+			}
+		case clip.FieldLyricsPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lyrics_path", values[i])
+			} else if value.Valid {
+				_m.LyricsPath = new(string)
+				*_m.LyricsPath = value.String
+			}
+		case clip.FieldLyricsAlignment:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field lyrics_alignment", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LyricsAlignment); err != nil {
+					return fmt.Errorf("unmarshal field lyrics_alignment: %w", err)
+				}
+			}
+		case clip.FieldCensorLog:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field censor_log", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CensorLog); err != nil {
+					return fmt.Errorf("unmarshal field censor_log: %w", err)
+				}
+			}
+		case clip.FieldCaptionsReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field captions_reviewed_at", values[i])
+			} else if value.Valid {
+				_m.CaptionsReviewedAt = new(time.Time)
+				*_m.CaptionsReviewedAt = value.Time
+			}
+		case clip.FieldCaptionStyle:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field caption_style", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CaptionStyle); err != nil {
+					return fmt.Errorf("unmarshal field caption_style: %w", err)
+				}
+			}
+		case clip.FieldSegmentPlan:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field segment_plan", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SegmentPlan); err != nil {
+					return fmt.Errorf("unmarshal field segment_plan: %w", err)
+				}
+			}
+		case clip.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				_m.Seed = new(int64)
+				*_m.Seed = value.Int64
+			}
+		case clip.FieldBackgroundStart:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field background_start", values[i])
+			} else if value.Valid {
+				_m.BackgroundStart = new(float64)
+				*_m.BackgroundStart = value.Float64
+			}
+		case clip.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				_m.Width = new(int)
+				*_m.Width = int(value.Int64)
+			}
+		case clip.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				_m.Height = new(int)
+				*_m.Height = int(value.Int64)
+			}
+		case clip.FieldFadeDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fade_duration", values[i])
+			} else if value.Valid {
+				_m.FadeDuration = new(int)
+				*_m.FadeDuration = int(value.Int64)
+			}
+		case clip.FieldFadeInDuration:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fade_in_duration", values[i])
+			} else if value.Valid {
+				_m.FadeInDuration = new(float64)
+				*_m.FadeInDuration = value.Float64
+			}
+		case clip.FieldAudioCensor:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_censor", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AudioCensor); err != nil {
+					return fmt.Errorf("unmarshal field audio_censor: %w", err)
+				}
+			}
+		case clip.FieldLoudness:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field loudness", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Loudness); err != nil {
+					return fmt.Errorf("unmarshal field loudness: %w", err)
+				}
+			}
+		case clip.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = model.ClipStatus(value.String)
+			}
+		case clip.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				_m.Stage = model.ClipStage(value.String)
+			}
+		case clip.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = new(string)
+				*_m.LastError = value.String
+			}
+		case clip.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case clip.FieldStageTimestamps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stage_timestamps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.StageTimestamps); err != nil {
+					return fmt.Errorf("unmarshal field stage_timestamps: %w", err)
+				}
+			}
 		case clip.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -141,6 +464,16 @@ func (_m *Clip) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryAudio queries the "audio" edge of the Clip entity.
+func (_m *Clip) QueryAudio() *AudioQuery {
+	return NewClipClient(_m.config).QueryAudio(_m)
+}
+
+// QueryBackgroundVideo queries the "background_video" edge of the Clip entity.
+func (_m *Clip) QueryBackgroundVideo() *BackgroundVideoQuery {
+	return NewClipClient(_m.config).QueryBackgroundVideo(_m)
+}
+
 // Update returns a builder for updating this Clip.
 // Note that you need to call Clip.Unwrap() before calling this method if this Clip
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -173,6 +506,38 @@ func (_m *Clip) String() string {
 	builder.WriteString("video_path=")
 	builder.WriteString(_m.VideoPath)
 	builder.WriteString(", ")
+	builder.WriteString("artist=")
+	builder.WriteString(_m.Artist)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("album=")
+	builder.WriteString(_m.Album)
+	builder.WriteString(", ")
+	if v := _m.Bio; v != nil {
+		builder.WriteString("bio=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BioPath; v != nil {
+		builder.WriteString("bio_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hashtags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hashtags))
+	builder.WriteString(", ")
+	if v := _m.AudioID; v != nil {
+		builder.WriteString("audio_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BackgroundVideoID; v != nil {
+		builder.WriteString("background_video_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.GenCaptionsPath; v != nil {
 		builder.WriteString("gen_captions_path=")
 		builder.WriteString(*v)
@@ -188,6 +553,97 @@ func (_m *Clip) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime)
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime)
+	builder.WriteString(", ")
+	if v := _m.TranscriptPath; v != nil {
+		builder.WriteString("transcript_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SubtitlesPath; v != nil {
+		builder.WriteString("subtitles_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LyricsPath; v != nil {
+		builder.WriteString("lyrics_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("lyrics_alignment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LyricsAlignment))
+	builder.WriteString(", ")
+	builder.WriteString("censor_log=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CensorLog))
+	builder.WriteString(", ")
+	if v := _m.CaptionsReviewedAt; v != nil {
+		builder.WriteString("captions_reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("caption_style=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CaptionStyle))
+	builder.WriteString(", ")
+	builder.WriteString("segment_plan=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SegmentPlan))
+	builder.WriteString(", ")
+	if v := _m.Seed; v != nil {
+		builder.WriteString("seed=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BackgroundStart; v != nil {
+		builder.WriteString("background_start=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Width; v != nil {
+		builder.WriteString("width=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Height; v != nil {
+		builder.WriteString("height=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FadeDuration; v != nil {
+		builder.WriteString("fade_duration=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FadeInDuration; v != nil {
+		builder.WriteString("fade_in_duration=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("audio_censor=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AudioCensor))
+	builder.WriteString(", ")
+	builder.WriteString("loudness=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Loudness))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Stage))
+	builder.WriteString(", ")
+	if v := _m.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("stage_timestamps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StageTimestamps))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
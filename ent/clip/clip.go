@@ -3,9 +3,12 @@
 package clip
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 const (
@@ -19,20 +22,100 @@ const (
 	FieldAudioPath = "audio_path"
 	// FieldVideoPath holds the string denoting the video_path field in the database.
 	FieldVideoPath = "video_path"
+	// FieldArtist holds the string denoting the artist field in the database.
+	FieldArtist = "artist"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldAlbum holds the string denoting the album field in the database.
+	FieldAlbum = "album"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldBioPath holds the string denoting the bio_path field in the database.
+	FieldBioPath = "bio_path"
+	// FieldHashtags holds the string denoting the hashtags field in the database.
+	FieldHashtags = "hashtags"
+	// FieldAudioID holds the string denoting the audio_id field in the database.
+	FieldAudioID = "audio_id"
+	// FieldBackgroundVideoID holds the string denoting the background_video_id field in the database.
+	FieldBackgroundVideoID = "background_video_id"
 	// FieldGenCaptionsPath holds the string denoting the gen_captions_path field in the database.
 	FieldGenCaptionsPath = "gen_captions_path"
 	// FieldGenRawVideoPath holds the string denoting the gen_raw_video_path field in the database.
 	FieldGenRawVideoPath = "gen_raw_video_path"
 	// FieldGenTrimmedVideoPath holds the string denoting the gen_trimmed_video_path field in the database.
 	FieldGenTrimmedVideoPath = "gen_trimmed_video_path"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldTranscriptPath holds the string denoting the transcript_path field in the database.
+	FieldTranscriptPath = "transcript_path"
+	// FieldSubtitlesPath holds the string denoting the subtitles_path field in the database.
+	FieldSubtitlesPath = "subtitles_path"
+	// FieldLyricsPath holds the string denoting the lyrics_path field in the database.
+	FieldLyricsPath = "lyrics_path"
+	// FieldLyricsAlignment holds the string denoting the lyrics_alignment field in the database.
+	FieldLyricsAlignment = "lyrics_alignment"
+	// FieldCensorLog holds the string denoting the censor_log field in the database.
+	FieldCensorLog = "censor_log"
+	// FieldCaptionsReviewedAt holds the string denoting the captions_reviewed_at field in the database.
+	FieldCaptionsReviewedAt = "captions_reviewed_at"
+	// FieldCaptionStyle holds the string denoting the caption_style field in the database.
+	FieldCaptionStyle = "caption_style"
+	// FieldSegmentPlan holds the string denoting the segment_plan field in the database.
+	FieldSegmentPlan = "segment_plan"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldBackgroundStart holds the string denoting the background_start field in the database.
+	FieldBackgroundStart = "background_start"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldFadeDuration holds the string denoting the fade_duration field in the database.
+	FieldFadeDuration = "fade_duration"
+	// FieldFadeInDuration holds the string denoting the fade_in_duration field in the database.
+	FieldFadeInDuration = "fade_in_duration"
+	// FieldAudioCensor holds the string denoting the audio_censor field in the database.
+	FieldAudioCensor = "audio_censor"
+	// FieldLoudness holds the string denoting the loudness field in the database.
+	FieldLoudness = "loudness"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldStageTimestamps holds the string denoting the stage_timestamps field in the database.
+	FieldStageTimestamps = "stage_timestamps"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// EdgeAudio holds the string denoting the audio edge name in mutations.
+	EdgeAudio = "audio"
+	// EdgeBackgroundVideo holds the string denoting the background_video edge name in mutations.
+	EdgeBackgroundVideo = "background_video"
 	// Table holds the table name of the clip in the database.
 	Table = "clips"
+	// AudioTable is the table that holds the audio relation/edge.
+	AudioTable = "clips"
+	// AudioInverseTable is the table name for the Audio entity.
+	// It exists in this package in order to avoid circular dependency with the "audio" package.
+	AudioInverseTable = "audios"
+	// AudioColumn is the table column denoting the audio relation/edge.
+	AudioColumn = "audio_id"
+	// BackgroundVideoTable is the table that holds the background_video relation/edge.
+	BackgroundVideoTable = "clips"
+	// BackgroundVideoInverseTable is the table name for the BackgroundVideo entity.
+	// It exists in this package in order to avoid circular dependency with the "backgroundvideo" package.
+	BackgroundVideoInverseTable = "background_videos"
+	// BackgroundVideoColumn is the table column denoting the background_video relation/edge.
+	BackgroundVideoColumn = "background_video_id"
 )
 
 // Columns holds all SQL columns for clip fields.
@@ -41,9 +124,40 @@ var Columns = []string{
 	FieldHash,
 	FieldAudioPath,
 	FieldVideoPath,
+	FieldArtist,
+	FieldTitle,
+	FieldAlbum,
+	FieldBio,
+	FieldBioPath,
+	FieldHashtags,
+	FieldAudioID,
+	FieldBackgroundVideoID,
 	FieldGenCaptionsPath,
 	FieldGenRawVideoPath,
 	FieldGenTrimmedVideoPath,
+	FieldStartTime,
+	FieldEndTime,
+	FieldTranscriptPath,
+	FieldSubtitlesPath,
+	FieldLyricsPath,
+	FieldLyricsAlignment,
+	FieldCensorLog,
+	FieldCaptionsReviewedAt,
+	FieldCaptionStyle,
+	FieldSegmentPlan,
+	FieldSeed,
+	FieldBackgroundStart,
+	FieldWidth,
+	FieldHeight,
+	FieldFadeDuration,
+	FieldFadeInDuration,
+	FieldAudioCensor,
+	FieldLoudness,
+	FieldStatus,
+	FieldStage,
+	FieldLastError,
+	FieldAttempts,
+	FieldStageTimestamps,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -60,12 +174,38 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 )
 
+const DefaultStatus model.ClipStatus = "pending"
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s model.ClipStatus) error {
+	switch s {
+	case "pending", "running", "failed", "completed":
+		return nil
+	default:
+		return fmt.Errorf("clip: invalid enum value for status field: %q", s)
+	}
+}
+
+const DefaultStage model.ClipStage = "captions"
+
+// StageValidator is a validator for the "stage" field enum values. It is called by the builders before save.
+func StageValidator(s model.ClipStage) error {
+	switch s {
+	case "captions", "burn", "trim", "bio":
+		return nil
+	default:
+		return fmt.Errorf("clip: invalid enum value for stage field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Clip queries.
 type OrderOption func(*sql.Selector)
 
@@ -89,6 +229,41 @@ func ByVideoPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVideoPath, opts...).ToFunc()
 }
 
+// ByArtist orders the results by the artist field.
+func ByArtist(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArtist, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByAlbum orders the results by the album field.
+func ByAlbum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlbum, opts...).ToFunc()
+}
+
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
+// ByBioPath orders the results by the bio_path field.
+func ByBioPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBioPath, opts...).ToFunc()
+}
+
+// ByAudioID orders the results by the audio_id field.
+func ByAudioID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioID, opts...).ToFunc()
+}
+
+// ByBackgroundVideoID orders the results by the background_video_id field.
+func ByBackgroundVideoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackgroundVideoID, opts...).ToFunc()
+}
+
 // ByGenCaptionsPath orders the results by the gen_captions_path field.
 func ByGenCaptionsPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGenCaptionsPath, opts...).ToFunc()
@@ -104,6 +279,86 @@ func ByGenTrimmedVideoPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGenTrimmedVideoPath, opts...).ToFunc()
 }
 
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByTranscriptPath orders the results by the transcript_path field.
+func ByTranscriptPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTranscriptPath, opts...).ToFunc()
+}
+
+// BySubtitlesPath orders the results by the subtitles_path field.
+func BySubtitlesPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtitlesPath, opts...).ToFunc()
+}
+
+// ByLyricsPath orders the results by the lyrics_path field.
+func ByLyricsPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLyricsPath, opts...).ToFunc()
+}
+
+// ByCaptionsReviewedAt orders the results by the captions_reviewed_at field.
+func ByCaptionsReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaptionsReviewedAt, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByBackgroundStart orders the results by the background_start field.
+func ByBackgroundStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackgroundStart, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByFadeDuration orders the results by the fade_duration field.
+func ByFadeDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFadeDuration, opts...).ToFunc()
+}
+
+// ByFadeInDuration orders the results by the fade_in_duration field.
+func ByFadeInDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFadeInDuration, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -118,3 +373,31 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
+
+// ByAudioField orders the results by audio field.
+func ByAudioField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAudioStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBackgroundVideoField orders the results by background_video field.
+func ByBackgroundVideoField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBackgroundVideoStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAudioStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AudioInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AudioTable, AudioColumn),
+	)
+}
+func newBackgroundVideoStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BackgroundVideoInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BackgroundVideoTable, BackgroundVideoColumn),
+	)
+}
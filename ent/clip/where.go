@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/sam-laister/tiktok-creator/ent/predicate"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 // ID filters vertices based on their ID field.
@@ -69,6 +71,41 @@ func VideoPath(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldVideoPath, v))
 }
 
+// Artist applies equality check predicate on the "artist" field. It's identical to ArtistEQ.
+func Artist(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldArtist, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldTitle, v))
+}
+
+// Album applies equality check predicate on the "album" field. It's identical to AlbumEQ.
+func Album(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldAlbum, v))
+}
+
+// Bio applies equality check predicate on the "bio" field. It's identical to BioEQ.
+func Bio(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBio, v))
+}
+
+// BioPath applies equality check predicate on the "bio_path" field. It's identical to BioPathEQ.
+func BioPath(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBioPath, v))
+}
+
+// AudioID applies equality check predicate on the "audio_id" field. It's identical to AudioIDEQ.
+func AudioID(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldAudioID, v))
+}
+
+// BackgroundVideoID applies equality check predicate on the "background_video_id" field. It's identical to BackgroundVideoIDEQ.
+func BackgroundVideoID(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBackgroundVideoID, v))
+}
+
 // GenCaptionsPath applies equality check predicate on the "gen_captions_path" field. It's identical to GenCaptionsPathEQ.
 func GenCaptionsPath(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldGenCaptionsPath, v))
@@ -84,6 +121,76 @@ func GenTrimmedVideoPath(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldGenTrimmedVideoPath, v))
 }
 
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldEndTime, v))
+}
+
+// TranscriptPath applies equality check predicate on the "transcript_path" field. It's identical to TranscriptPathEQ.
+func TranscriptPath(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldTranscriptPath, v))
+}
+
+// SubtitlesPath applies equality check predicate on the "subtitles_path" field. It's identical to SubtitlesPathEQ.
+func SubtitlesPath(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldSubtitlesPath, v))
+}
+
+// LyricsPath applies equality check predicate on the "lyrics_path" field. It's identical to LyricsPathEQ.
+func LyricsPath(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldLyricsPath, v))
+}
+
+// CaptionsReviewedAt applies equality check predicate on the "captions_reviewed_at" field. It's identical to CaptionsReviewedAtEQ.
+func CaptionsReviewedAt(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldCaptionsReviewedAt, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldSeed, v))
+}
+
+// BackgroundStart applies equality check predicate on the "background_start" field. It's identical to BackgroundStartEQ.
+func BackgroundStart(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBackgroundStart, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldHeight, v))
+}
+
+// FadeDuration applies equality check predicate on the "fade_duration" field. It's identical to FadeDurationEQ.
+func FadeDuration(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldFadeDuration, v))
+}
+
+// FadeInDuration applies equality check predicate on the "fade_in_duration" field. It's identical to FadeInDurationEQ.
+func FadeInDuration(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldFadeInDuration, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldLastError, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldAttempts, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldCreatedAt, v))
@@ -294,6 +401,451 @@ func VideoPathContainsFold(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldContainsFold(FieldVideoPath, v))
 }
 
+// ArtistEQ applies the EQ predicate on the "artist" field.
+func ArtistEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldArtist, v))
+}
+
+// ArtistNEQ applies the NEQ predicate on the "artist" field.
+func ArtistNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldArtist, v))
+}
+
+// ArtistIn applies the In predicate on the "artist" field.
+func ArtistIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldArtist, vs...))
+}
+
+// ArtistNotIn applies the NotIn predicate on the "artist" field.
+func ArtistNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldArtist, vs...))
+}
+
+// ArtistGT applies the GT predicate on the "artist" field.
+func ArtistGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldArtist, v))
+}
+
+// ArtistGTE applies the GTE predicate on the "artist" field.
+func ArtistGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldArtist, v))
+}
+
+// ArtistLT applies the LT predicate on the "artist" field.
+func ArtistLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldArtist, v))
+}
+
+// ArtistLTE applies the LTE predicate on the "artist" field.
+func ArtistLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldArtist, v))
+}
+
+// ArtistContains applies the Contains predicate on the "artist" field.
+func ArtistContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldArtist, v))
+}
+
+// ArtistHasPrefix applies the HasPrefix predicate on the "artist" field.
+func ArtistHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldArtist, v))
+}
+
+// ArtistHasSuffix applies the HasSuffix predicate on the "artist" field.
+func ArtistHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldArtist, v))
+}
+
+// ArtistIsNil applies the IsNil predicate on the "artist" field.
+func ArtistIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldArtist))
+}
+
+// ArtistNotNil applies the NotNil predicate on the "artist" field.
+func ArtistNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldArtist))
+}
+
+// ArtistEqualFold applies the EqualFold predicate on the "artist" field.
+func ArtistEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldArtist, v))
+}
+
+// ArtistContainsFold applies the ContainsFold predicate on the "artist" field.
+func ArtistContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldArtist, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AlbumEQ applies the EQ predicate on the "album" field.
+func AlbumEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldAlbum, v))
+}
+
+// AlbumNEQ applies the NEQ predicate on the "album" field.
+func AlbumNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldAlbum, v))
+}
+
+// AlbumIn applies the In predicate on the "album" field.
+func AlbumIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldAlbum, vs...))
+}
+
+// AlbumNotIn applies the NotIn predicate on the "album" field.
+func AlbumNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldAlbum, vs...))
+}
+
+// AlbumGT applies the GT predicate on the "album" field.
+func AlbumGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldAlbum, v))
+}
+
+// AlbumGTE applies the GTE predicate on the "album" field.
+func AlbumGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldAlbum, v))
+}
+
+// AlbumLT applies the LT predicate on the "album" field.
+func AlbumLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldAlbum, v))
+}
+
+// AlbumLTE applies the LTE predicate on the "album" field.
+func AlbumLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldAlbum, v))
+}
+
+// AlbumContains applies the Contains predicate on the "album" field.
+func AlbumContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldAlbum, v))
+}
+
+// AlbumHasPrefix applies the HasPrefix predicate on the "album" field.
+func AlbumHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldAlbum, v))
+}
+
+// AlbumHasSuffix applies the HasSuffix predicate on the "album" field.
+func AlbumHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldAlbum, v))
+}
+
+// AlbumIsNil applies the IsNil predicate on the "album" field.
+func AlbumIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldAlbum))
+}
+
+// AlbumNotNil applies the NotNil predicate on the "album" field.
+func AlbumNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldAlbum))
+}
+
+// AlbumEqualFold applies the EqualFold predicate on the "album" field.
+func AlbumEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldAlbum, v))
+}
+
+// AlbumContainsFold applies the ContainsFold predicate on the "album" field.
+func AlbumContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldAlbum, v))
+}
+
+// BioEQ applies the EQ predicate on the "bio" field.
+func BioEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBio, v))
+}
+
+// BioNEQ applies the NEQ predicate on the "bio" field.
+func BioNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldBio, v))
+}
+
+// BioIn applies the In predicate on the "bio" field.
+func BioIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldBio, vs...))
+}
+
+// BioNotIn applies the NotIn predicate on the "bio" field.
+func BioNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldBio, vs...))
+}
+
+// BioGT applies the GT predicate on the "bio" field.
+func BioGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldBio, v))
+}
+
+// BioGTE applies the GTE predicate on the "bio" field.
+func BioGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldBio, v))
+}
+
+// BioLT applies the LT predicate on the "bio" field.
+func BioLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldBio, v))
+}
+
+// BioLTE applies the LTE predicate on the "bio" field.
+func BioLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldBio, v))
+}
+
+// BioContains applies the Contains predicate on the "bio" field.
+func BioContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldBio, v))
+}
+
+// BioHasPrefix applies the HasPrefix predicate on the "bio" field.
+func BioHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldBio, v))
+}
+
+// BioHasSuffix applies the HasSuffix predicate on the "bio" field.
+func BioHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldBio, v))
+}
+
+// BioIsNil applies the IsNil predicate on the "bio" field.
+func BioIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldBio))
+}
+
+// BioNotNil applies the NotNil predicate on the "bio" field.
+func BioNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldBio))
+}
+
+// BioEqualFold applies the EqualFold predicate on the "bio" field.
+func BioEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldBio, v))
+}
+
+// BioContainsFold applies the ContainsFold predicate on the "bio" field.
+func BioContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldBio, v))
+}
+
+// BioPathEQ applies the EQ predicate on the "bio_path" field.
+func BioPathEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBioPath, v))
+}
+
+// BioPathNEQ applies the NEQ predicate on the "bio_path" field.
+func BioPathNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldBioPath, v))
+}
+
+// BioPathIn applies the In predicate on the "bio_path" field.
+func BioPathIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldBioPath, vs...))
+}
+
+// BioPathNotIn applies the NotIn predicate on the "bio_path" field.
+func BioPathNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldBioPath, vs...))
+}
+
+// BioPathGT applies the GT predicate on the "bio_path" field.
+func BioPathGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldBioPath, v))
+}
+
+// BioPathGTE applies the GTE predicate on the "bio_path" field.
+func BioPathGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldBioPath, v))
+}
+
+// BioPathLT applies the LT predicate on the "bio_path" field.
+func BioPathLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldBioPath, v))
+}
+
+// BioPathLTE applies the LTE predicate on the "bio_path" field.
+func BioPathLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldBioPath, v))
+}
+
+// BioPathContains applies the Contains predicate on the "bio_path" field.
+func BioPathContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldBioPath, v))
+}
+
+// BioPathHasPrefix applies the HasPrefix predicate on the "bio_path" field.
+func BioPathHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldBioPath, v))
+}
+
+// BioPathHasSuffix applies the HasSuffix predicate on the "bio_path" field.
+func BioPathHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldBioPath, v))
+}
+
+// BioPathIsNil applies the IsNil predicate on the "bio_path" field.
+func BioPathIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldBioPath))
+}
+
+// BioPathNotNil applies the NotNil predicate on the "bio_path" field.
+func BioPathNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldBioPath))
+}
+
+// BioPathEqualFold applies the EqualFold predicate on the "bio_path" field.
+func BioPathEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldBioPath, v))
+}
+
+// BioPathContainsFold applies the ContainsFold predicate on the "bio_path" field.
+func BioPathContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldBioPath, v))
+}
+
+// HashtagsIsNil applies the IsNil predicate on the "hashtags" field.
+func HashtagsIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldHashtags))
+}
+
+// HashtagsNotNil applies the NotNil predicate on the "hashtags" field.
+func HashtagsNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldHashtags))
+}
+
+// AudioIDEQ applies the EQ predicate on the "audio_id" field.
+func AudioIDEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldAudioID, v))
+}
+
+// AudioIDNEQ applies the NEQ predicate on the "audio_id" field.
+func AudioIDNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldAudioID, v))
+}
+
+// AudioIDIn applies the In predicate on the "audio_id" field.
+func AudioIDIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldAudioID, vs...))
+}
+
+// AudioIDNotIn applies the NotIn predicate on the "audio_id" field.
+func AudioIDNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldAudioID, vs...))
+}
+
+// AudioIDIsNil applies the IsNil predicate on the "audio_id" field.
+func AudioIDIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldAudioID))
+}
+
+// AudioIDNotNil applies the NotNil predicate on the "audio_id" field.
+func AudioIDNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldAudioID))
+}
+
+// BackgroundVideoIDEQ applies the EQ predicate on the "background_video_id" field.
+func BackgroundVideoIDEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBackgroundVideoID, v))
+}
+
+// BackgroundVideoIDNEQ applies the NEQ predicate on the "background_video_id" field.
+func BackgroundVideoIDNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldBackgroundVideoID, v))
+}
+
+// BackgroundVideoIDIn applies the In predicate on the "background_video_id" field.
+func BackgroundVideoIDIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldBackgroundVideoID, vs...))
+}
+
+// BackgroundVideoIDNotIn applies the NotIn predicate on the "background_video_id" field.
+func BackgroundVideoIDNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldBackgroundVideoID, vs...))
+}
+
+// BackgroundVideoIDIsNil applies the IsNil predicate on the "background_video_id" field.
+func BackgroundVideoIDIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldBackgroundVideoID))
+}
+
+// BackgroundVideoIDNotNil applies the NotNil predicate on the "background_video_id" field.
+func BackgroundVideoIDNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldBackgroundVideoID))
+}
+
 // GenCaptionsPathEQ applies the EQ predicate on the "gen_captions_path" field.
 func GenCaptionsPathEQ(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldGenCaptionsPath, v))
@@ -519,64 +1071,1034 @@ func GenTrimmedVideoPathContainsFold(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldContainsFold(FieldGenTrimmedVideoPath, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldEQ(FieldCreatedAt, v))
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldStartTime, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldNEQ(FieldCreatedAt, v))
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldStartTime, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldIn(FieldCreatedAt, vs...))
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldStartTime, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldNotIn(FieldCreatedAt, vs...))
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldStartTime, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldGT(FieldCreatedAt, v))
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldStartTime, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldGTE(FieldCreatedAt, v))
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldStartTime, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldLT(FieldCreatedAt, v))
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldStartTime, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldLTE(FieldCreatedAt, v))
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldStartTime, v))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldEQ(FieldUpdatedAt, v))
+// StartTimeContains applies the Contains predicate on the "start_time" field.
+func StartTimeContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldStartTime, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldNEQ(FieldUpdatedAt, v))
+// StartTimeHasPrefix applies the HasPrefix predicate on the "start_time" field.
+func StartTimeHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldStartTime, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldIn(FieldUpdatedAt, vs...))
+// StartTimeHasSuffix applies the HasSuffix predicate on the "start_time" field.
+func StartTimeHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldStartTime, v))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Clip {
-	return predicate.Clip(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// StartTimeIsNil applies the IsNil predicate on the "start_time" field.
+func StartTimeIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldStartTime))
+}
+
+// StartTimeNotNil applies the NotNil predicate on the "start_time" field.
+func StartTimeNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldStartTime))
+}
+
+// StartTimeEqualFold applies the EqualFold predicate on the "start_time" field.
+func StartTimeEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldStartTime, v))
+}
+
+// StartTimeContainsFold applies the ContainsFold predicate on the "start_time" field.
+func StartTimeContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldEndTime, v))
+}
+
+// EndTimeContains applies the Contains predicate on the "end_time" field.
+func EndTimeContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldEndTime, v))
+}
+
+// EndTimeHasPrefix applies the HasPrefix predicate on the "end_time" field.
+func EndTimeHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldEndTime, v))
+}
+
+// EndTimeHasSuffix applies the HasSuffix predicate on the "end_time" field.
+func EndTimeHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldEndTime, v))
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "end_time" field.
+func EndTimeIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldEndTime))
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "end_time" field.
+func EndTimeNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldEndTime))
+}
+
+// EndTimeEqualFold applies the EqualFold predicate on the "end_time" field.
+func EndTimeEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldEndTime, v))
+}
+
+// EndTimeContainsFold applies the ContainsFold predicate on the "end_time" field.
+func EndTimeContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldEndTime, v))
+}
+
+// TranscriptPathEQ applies the EQ predicate on the "transcript_path" field.
+func TranscriptPathEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldTranscriptPath, v))
+}
+
+// TranscriptPathNEQ applies the NEQ predicate on the "transcript_path" field.
+func TranscriptPathNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldTranscriptPath, v))
+}
+
+// TranscriptPathIn applies the In predicate on the "transcript_path" field.
+func TranscriptPathIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldTranscriptPath, vs...))
+}
+
+// TranscriptPathNotIn applies the NotIn predicate on the "transcript_path" field.
+func TranscriptPathNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldTranscriptPath, vs...))
+}
+
+// TranscriptPathGT applies the GT predicate on the "transcript_path" field.
+func TranscriptPathGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldTranscriptPath, v))
+}
+
+// TranscriptPathGTE applies the GTE predicate on the "transcript_path" field.
+func TranscriptPathGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldTranscriptPath, v))
+}
+
+// TranscriptPathLT applies the LT predicate on the "transcript_path" field.
+func TranscriptPathLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldTranscriptPath, v))
+}
+
+// TranscriptPathLTE applies the LTE predicate on the "transcript_path" field.
+func TranscriptPathLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldTranscriptPath, v))
+}
+
+// TranscriptPathContains applies the Contains predicate on the "transcript_path" field.
+func TranscriptPathContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldTranscriptPath, v))
+}
+
+// TranscriptPathHasPrefix applies the HasPrefix predicate on the "transcript_path" field.
+func TranscriptPathHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldTranscriptPath, v))
+}
+
+// TranscriptPathHasSuffix applies the HasSuffix predicate on the "transcript_path" field.
+func TranscriptPathHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldTranscriptPath, v))
+}
+
+// TranscriptPathIsNil applies the IsNil predicate on the "transcript_path" field.
+func TranscriptPathIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldTranscriptPath))
+}
+
+// TranscriptPathNotNil applies the NotNil predicate on the "transcript_path" field.
+func TranscriptPathNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldTranscriptPath))
+}
+
+// TranscriptPathEqualFold applies the EqualFold predicate on the "transcript_path" field.
+func TranscriptPathEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldTranscriptPath, v))
+}
+
+// TranscriptPathContainsFold applies the ContainsFold predicate on the "transcript_path" field.
+func TranscriptPathContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldTranscriptPath, v))
+}
+
+// SubtitlesPathEQ applies the EQ predicate on the "subtitles_path" field.
+func SubtitlesPathEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathNEQ applies the NEQ predicate on the "subtitles_path" field.
+func SubtitlesPathNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathIn applies the In predicate on the "subtitles_path" field.
+func SubtitlesPathIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldSubtitlesPath, vs...))
+}
+
+// SubtitlesPathNotIn applies the NotIn predicate on the "subtitles_path" field.
+func SubtitlesPathNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldSubtitlesPath, vs...))
+}
+
+// SubtitlesPathGT applies the GT predicate on the "subtitles_path" field.
+func SubtitlesPathGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathGTE applies the GTE predicate on the "subtitles_path" field.
+func SubtitlesPathGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathLT applies the LT predicate on the "subtitles_path" field.
+func SubtitlesPathLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathLTE applies the LTE predicate on the "subtitles_path" field.
+func SubtitlesPathLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathContains applies the Contains predicate on the "subtitles_path" field.
+func SubtitlesPathContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathHasPrefix applies the HasPrefix predicate on the "subtitles_path" field.
+func SubtitlesPathHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathHasSuffix applies the HasSuffix predicate on the "subtitles_path" field.
+func SubtitlesPathHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathIsNil applies the IsNil predicate on the "subtitles_path" field.
+func SubtitlesPathIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldSubtitlesPath))
+}
+
+// SubtitlesPathNotNil applies the NotNil predicate on the "subtitles_path" field.
+func SubtitlesPathNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldSubtitlesPath))
+}
+
+// SubtitlesPathEqualFold applies the EqualFold predicate on the "subtitles_path" field.
+func SubtitlesPathEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldSubtitlesPath, v))
+}
+
+// SubtitlesPathContainsFold applies the ContainsFold predicate on the "subtitles_path" field.
+func SubtitlesPathContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldSubtitlesPath, v))
+}
+
+// LyricsPathEQ applies the EQ predicate on the "lyrics_path" field.
+func LyricsPathEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldLyricsPath, v))
+}
+
+// LyricsPathNEQ applies the NEQ predicate on the "lyrics_path" field.
+func LyricsPathNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldLyricsPath, v))
+}
+
+// LyricsPathIn applies the In predicate on the "lyrics_path" field.
+func LyricsPathIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldLyricsPath, vs...))
+}
+
+// LyricsPathNotIn applies the NotIn predicate on the "lyrics_path" field.
+func LyricsPathNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldLyricsPath, vs...))
+}
+
+// LyricsPathGT applies the GT predicate on the "lyrics_path" field.
+func LyricsPathGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldLyricsPath, v))
+}
+
+// LyricsPathGTE applies the GTE predicate on the "lyrics_path" field.
+func LyricsPathGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldLyricsPath, v))
+}
+
+// LyricsPathLT applies the LT predicate on the "lyrics_path" field.
+func LyricsPathLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldLyricsPath, v))
+}
+
+// LyricsPathLTE applies the LTE predicate on the "lyrics_path" field.
+func LyricsPathLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldLyricsPath, v))
+}
+
+// LyricsPathContains applies the Contains predicate on the "lyrics_path" field.
+func LyricsPathContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldLyricsPath, v))
+}
+
+// LyricsPathHasPrefix applies the HasPrefix predicate on the "lyrics_path" field.
+func LyricsPathHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldLyricsPath, v))
+}
+
+// LyricsPathHasSuffix applies the HasSuffix predicate on the "lyrics_path" field.
+func LyricsPathHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldLyricsPath, v))
+}
+
+// LyricsPathIsNil applies the IsNil predicate on the "lyrics_path" field.
+func LyricsPathIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldLyricsPath))
+}
+
+// LyricsPathNotNil applies the NotNil predicate on the "lyrics_path" field.
+func LyricsPathNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldLyricsPath))
+}
+
+// LyricsPathEqualFold applies the EqualFold predicate on the "lyrics_path" field.
+func LyricsPathEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldLyricsPath, v))
+}
+
+// LyricsPathContainsFold applies the ContainsFold predicate on the "lyrics_path" field.
+func LyricsPathContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldLyricsPath, v))
+}
+
+// LyricsAlignmentIsNil applies the IsNil predicate on the "lyrics_alignment" field.
+func LyricsAlignmentIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldLyricsAlignment))
+}
+
+// LyricsAlignmentNotNil applies the NotNil predicate on the "lyrics_alignment" field.
+func LyricsAlignmentNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldLyricsAlignment))
+}
+
+// CensorLogIsNil applies the IsNil predicate on the "censor_log" field.
+func CensorLogIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldCensorLog))
+}
+
+// CensorLogNotNil applies the NotNil predicate on the "censor_log" field.
+func CensorLogNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldCensorLog))
+}
+
+// CaptionsReviewedAtEQ applies the EQ predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldCaptionsReviewedAt, v))
+}
+
+// CaptionsReviewedAtNEQ applies the NEQ predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtNEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldCaptionsReviewedAt, v))
+}
+
+// CaptionsReviewedAtIn applies the In predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldCaptionsReviewedAt, vs...))
+}
+
+// CaptionsReviewedAtNotIn applies the NotIn predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtNotIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldCaptionsReviewedAt, vs...))
+}
+
+// CaptionsReviewedAtGT applies the GT predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtGT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldCaptionsReviewedAt, v))
+}
+
+// CaptionsReviewedAtGTE applies the GTE predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtGTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldCaptionsReviewedAt, v))
+}
+
+// CaptionsReviewedAtLT applies the LT predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtLT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldCaptionsReviewedAt, v))
+}
+
+// CaptionsReviewedAtLTE applies the LTE predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtLTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldCaptionsReviewedAt, v))
+}
+
+// CaptionsReviewedAtIsNil applies the IsNil predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldCaptionsReviewedAt))
+}
+
+// CaptionsReviewedAtNotNil applies the NotNil predicate on the "captions_reviewed_at" field.
+func CaptionsReviewedAtNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldCaptionsReviewedAt))
+}
+
+// CaptionStyleIsNil applies the IsNil predicate on the "caption_style" field.
+func CaptionStyleIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldCaptionStyle))
+}
+
+// CaptionStyleNotNil applies the NotNil predicate on the "caption_style" field.
+func CaptionStyleNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldCaptionStyle))
+}
+
+// SegmentPlanIsNil applies the IsNil predicate on the "segment_plan" field.
+func SegmentPlanIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldSegmentPlan))
+}
+
+// SegmentPlanNotNil applies the NotNil predicate on the "segment_plan" field.
+func SegmentPlanNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldSegmentPlan))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldSeed, v))
+}
+
+// SeedIsNil applies the IsNil predicate on the "seed" field.
+func SeedIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldSeed))
+}
+
+// SeedNotNil applies the NotNil predicate on the "seed" field.
+func SeedNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldSeed))
+}
+
+// BackgroundStartEQ applies the EQ predicate on the "background_start" field.
+func BackgroundStartEQ(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBackgroundStart, v))
+}
+
+// BackgroundStartNEQ applies the NEQ predicate on the "background_start" field.
+func BackgroundStartNEQ(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldBackgroundStart, v))
+}
+
+// BackgroundStartIn applies the In predicate on the "background_start" field.
+func BackgroundStartIn(vs ...float64) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldBackgroundStart, vs...))
+}
+
+// BackgroundStartNotIn applies the NotIn predicate on the "background_start" field.
+func BackgroundStartNotIn(vs ...float64) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldBackgroundStart, vs...))
+}
+
+// BackgroundStartGT applies the GT predicate on the "background_start" field.
+func BackgroundStartGT(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldBackgroundStart, v))
+}
+
+// BackgroundStartGTE applies the GTE predicate on the "background_start" field.
+func BackgroundStartGTE(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldBackgroundStart, v))
+}
+
+// BackgroundStartLT applies the LT predicate on the "background_start" field.
+func BackgroundStartLT(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldBackgroundStart, v))
+}
+
+// BackgroundStartLTE applies the LTE predicate on the "background_start" field.
+func BackgroundStartLTE(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldBackgroundStart, v))
+}
+
+// BackgroundStartIsNil applies the IsNil predicate on the "background_start" field.
+func BackgroundStartIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldBackgroundStart))
+}
+
+// BackgroundStartNotNil applies the NotNil predicate on the "background_start" field.
+func BackgroundStartNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldBackgroundStart))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldHeight))
+}
+
+// FadeDurationEQ applies the EQ predicate on the "fade_duration" field.
+func FadeDurationEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldFadeDuration, v))
+}
+
+// FadeDurationNEQ applies the NEQ predicate on the "fade_duration" field.
+func FadeDurationNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldFadeDuration, v))
+}
+
+// FadeDurationIn applies the In predicate on the "fade_duration" field.
+func FadeDurationIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldFadeDuration, vs...))
+}
+
+// FadeDurationNotIn applies the NotIn predicate on the "fade_duration" field.
+func FadeDurationNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldFadeDuration, vs...))
+}
+
+// FadeDurationGT applies the GT predicate on the "fade_duration" field.
+func FadeDurationGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldFadeDuration, v))
+}
+
+// FadeDurationGTE applies the GTE predicate on the "fade_duration" field.
+func FadeDurationGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldFadeDuration, v))
+}
+
+// FadeDurationLT applies the LT predicate on the "fade_duration" field.
+func FadeDurationLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldFadeDuration, v))
+}
+
+// FadeDurationLTE applies the LTE predicate on the "fade_duration" field.
+func FadeDurationLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldFadeDuration, v))
+}
+
+// FadeDurationIsNil applies the IsNil predicate on the "fade_duration" field.
+func FadeDurationIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldFadeDuration))
+}
+
+// FadeDurationNotNil applies the NotNil predicate on the "fade_duration" field.
+func FadeDurationNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldFadeDuration))
+}
+
+// FadeInDurationEQ applies the EQ predicate on the "fade_in_duration" field.
+func FadeInDurationEQ(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldFadeInDuration, v))
+}
+
+// FadeInDurationNEQ applies the NEQ predicate on the "fade_in_duration" field.
+func FadeInDurationNEQ(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldFadeInDuration, v))
+}
+
+// FadeInDurationIn applies the In predicate on the "fade_in_duration" field.
+func FadeInDurationIn(vs ...float64) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldFadeInDuration, vs...))
+}
+
+// FadeInDurationNotIn applies the NotIn predicate on the "fade_in_duration" field.
+func FadeInDurationNotIn(vs ...float64) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldFadeInDuration, vs...))
+}
+
+// FadeInDurationGT applies the GT predicate on the "fade_in_duration" field.
+func FadeInDurationGT(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldFadeInDuration, v))
+}
+
+// FadeInDurationGTE applies the GTE predicate on the "fade_in_duration" field.
+func FadeInDurationGTE(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldFadeInDuration, v))
+}
+
+// FadeInDurationLT applies the LT predicate on the "fade_in_duration" field.
+func FadeInDurationLT(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldFadeInDuration, v))
+}
+
+// FadeInDurationLTE applies the LTE predicate on the "fade_in_duration" field.
+func FadeInDurationLTE(v float64) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldFadeInDuration, v))
+}
+
+// FadeInDurationIsNil applies the IsNil predicate on the "fade_in_duration" field.
+func FadeInDurationIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldFadeInDuration))
+}
+
+// FadeInDurationNotNil applies the NotNil predicate on the "fade_in_duration" field.
+func FadeInDurationNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldFadeInDuration))
+}
+
+// AudioCensorIsNil applies the IsNil predicate on the "audio_censor" field.
+func AudioCensorIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldAudioCensor))
+}
+
+// AudioCensorNotNil applies the NotNil predicate on the "audio_censor" field.
+func AudioCensorNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldAudioCensor))
+}
+
+// LoudnessIsNil applies the IsNil predicate on the "loudness" field.
+func LoudnessIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldLoudness))
+}
+
+// LoudnessNotNil applies the NotNil predicate on the "loudness" field.
+func LoudnessNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldLoudness))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v model.ClipStatus) predicate.Clip {
+	vc := v
+	return predicate.Clip(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v model.ClipStatus) predicate.Clip {
+	vc := v
+	return predicate.Clip(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...model.ClipStatus) predicate.Clip {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Clip(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...model.ClipStatus) predicate.Clip {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Clip(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v model.ClipStage) predicate.Clip {
+	vc := v
+	return predicate.Clip(sql.FieldEQ(FieldStage, vc))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v model.ClipStage) predicate.Clip {
+	vc := v
+	return predicate.Clip(sql.FieldNEQ(FieldStage, vc))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...model.ClipStage) predicate.Clip {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Clip(sql.FieldIn(FieldStage, v...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...model.ClipStage) predicate.Clip {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Clip(sql.FieldNotIn(FieldStage, v...))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldAttempts, v))
+}
+
+// StageTimestampsIsNil applies the IsNil predicate on the "stage_timestamps" field.
+func StageTimestampsIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldStageTimestamps))
+}
+
+// StageTimestampsNotNil applies the NotNil predicate on the "stage_timestamps" field.
+func StageTimestampsNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldStageTimestamps))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldUpdatedAt, vs...))
 }
 
 // UpdatedAtGT applies the GT predicate on the "updated_at" field.
@@ -649,6 +2171,52 @@ func DeletedAtNotNil() predicate.Clip {
 	return predicate.Clip(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// HasAudio applies the HasEdge predicate on the "audio" edge.
+func HasAudio() predicate.Clip {
+	return predicate.Clip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AudioTable, AudioColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAudioWith applies the HasEdge predicate on the "audio" edge with a given conditions (other predicates).
+func HasAudioWith(preds ...predicate.Audio) predicate.Clip {
+	return predicate.Clip(func(s *sql.Selector) {
+		step := newAudioStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBackgroundVideo applies the HasEdge predicate on the "background_video" edge.
+func HasBackgroundVideo() predicate.Clip {
+	return predicate.Clip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BackgroundVideoTable, BackgroundVideoColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackgroundVideoWith applies the HasEdge predicate on the "background_video" edge with a given conditions (other predicates).
+func HasBackgroundVideoWith(preds ...predicate.BackgroundVideo) predicate.Clip {
+	return predicate.Clip(func(s *sql.Selector) {
+		step := newBackgroundVideoStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Clip) predicate.Clip {
 	return predicate.Clip(sql.AndPredicates(predicates...))
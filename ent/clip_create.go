@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 // ClipCreate is the builder for creating a Clip entity.
@@ -38,6 +41,110 @@ func (_c *ClipCreate) SetVideoPath(v string) *ClipCreate {
 	return _c
 }
 
+// SetArtist sets the "artist" field.
+func (_c *ClipCreate) SetArtist(v string) *ClipCreate {
+	_c.mutation.SetArtist(v)
+	return _c
+}
+
+// SetNillableArtist sets the "artist" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableArtist(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetArtist(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ClipCreate) SetTitle(v string) *ClipCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableTitle(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetAlbum sets the "album" field.
+func (_c *ClipCreate) SetAlbum(v string) *ClipCreate {
+	_c.mutation.SetAlbum(v)
+	return _c
+}
+
+// SetNillableAlbum sets the "album" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableAlbum(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetAlbum(*v)
+	}
+	return _c
+}
+
+// SetBio sets the "bio" field.
+func (_c *ClipCreate) SetBio(v string) *ClipCreate {
+	_c.mutation.SetBio(v)
+	return _c
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableBio(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetBio(*v)
+	}
+	return _c
+}
+
+// SetBioPath sets the "bio_path" field.
+func (_c *ClipCreate) SetBioPath(v string) *ClipCreate {
+	_c.mutation.SetBioPath(v)
+	return _c
+}
+
+// SetNillableBioPath sets the "bio_path" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableBioPath(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetBioPath(*v)
+	}
+	return _c
+}
+
+// SetHashtags sets the "hashtags" field.
+func (_c *ClipCreate) SetHashtags(v []string) *ClipCreate {
+	_c.mutation.SetHashtags(v)
+	return _c
+}
+
+// SetAudioID sets the "audio_id" field.
+func (_c *ClipCreate) SetAudioID(v int) *ClipCreate {
+	_c.mutation.SetAudioID(v)
+	return _c
+}
+
+// SetNillableAudioID sets the "audio_id" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableAudioID(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetAudioID(*v)
+	}
+	return _c
+}
+
+// SetBackgroundVideoID sets the "background_video_id" field.
+func (_c *ClipCreate) SetBackgroundVideoID(v int) *ClipCreate {
+	_c.mutation.SetBackgroundVideoID(v)
+	return _c
+}
+
+// SetNillableBackgroundVideoID sets the "background_video_id" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableBackgroundVideoID(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetBackgroundVideoID(*v)
+	}
+	return _c
+}
+
 // SetGenCaptionsPath sets the "gen_captions_path" field.
 func (_c *ClipCreate) SetGenCaptionsPath(v string) *ClipCreate {
 	_c.mutation.SetGenCaptionsPath(v)
@@ -80,6 +187,272 @@ func (_c *ClipCreate) SetNillableGenTrimmedVideoPath(v *string) *ClipCreate {
 	return _c
 }
 
+// SetStartTime sets the "start_time" field.
+func (_c *ClipCreate) SetStartTime(v string) *ClipCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableStartTime(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetStartTime(*v)
+	}
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *ClipCreate) SetEndTime(v string) *ClipCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableEndTime(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetEndTime(*v)
+	}
+	return _c
+}
+
+// SetTranscriptPath sets the "transcript_path" field.
+func (_c *ClipCreate) SetTranscriptPath(v string) *ClipCreate {
+	_c.mutation.SetTranscriptPath(v)
+	return _c
+}
+
+// SetNillableTranscriptPath sets the "transcript_path" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableTranscriptPath(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetTranscriptPath(*v)
+	}
+	return _c
+}
+
+// SetSubtitlesPath sets the "subtitles_path" field.
+func (_c *ClipCreate) SetSubtitlesPath(v string) *ClipCreate {
+	_c.mutation.SetSubtitlesPath(v)
+	return _c
+}
+
+// SetNillableSubtitlesPath sets the "subtitles_path" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableSubtitlesPath(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetSubtitlesPath(*v)
+	}
+	return _c
+}
+
+// SetLyricsPath sets the "lyrics_path" field.
+func (_c *ClipCreate) SetLyricsPath(v string) *ClipCreate {
+	_c.mutation.SetLyricsPath(v)
+	return _c
+}
+
+// SetNillableLyricsPath sets the "lyrics_path" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableLyricsPath(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetLyricsPath(*v)
+	}
+	return _c
+}
+
+// SetLyricsAlignment sets the "lyrics_alignment" field.
+func (_c *ClipCreate) SetLyricsAlignment(v *model.LyricsAlignment) *ClipCreate {
+	_c.mutation.SetLyricsAlignment(v)
+	return _c
+}
+
+// SetCensorLog sets the "censor_log" field.
+func (_c *ClipCreate) SetCensorLog(v []model.CensorEvent) *ClipCreate {
+	_c.mutation.SetCensorLog(v)
+	return _c
+}
+
+// SetCaptionsReviewedAt sets the "captions_reviewed_at" field.
+func (_c *ClipCreate) SetCaptionsReviewedAt(v time.Time) *ClipCreate {
+	_c.mutation.SetCaptionsReviewedAt(v)
+	return _c
+}
+
+// SetNillableCaptionsReviewedAt sets the "captions_reviewed_at" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableCaptionsReviewedAt(v *time.Time) *ClipCreate {
+	if v != nil {
+		_c.SetCaptionsReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetCaptionStyle sets the "caption_style" field.
+func (_c *ClipCreate) SetCaptionStyle(v *model.CaptionStyle) *ClipCreate {
+	_c.mutation.SetCaptionStyle(v)
+	return _c
+}
+
+// SetSegmentPlan sets the "segment_plan" field.
+func (_c *ClipCreate) SetSegmentPlan(v *model.SegmentPlan) *ClipCreate {
+	_c.mutation.SetSegmentPlan(v)
+	return _c
+}
+
+// SetSeed sets the "seed" field.
+func (_c *ClipCreate) SetSeed(v int64) *ClipCreate {
+	_c.mutation.SetSeed(v)
+	return _c
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableSeed(v *int64) *ClipCreate {
+	if v != nil {
+		_c.SetSeed(*v)
+	}
+	return _c
+}
+
+// SetBackgroundStart sets the "background_start" field.
+func (_c *ClipCreate) SetBackgroundStart(v float64) *ClipCreate {
+	_c.mutation.SetBackgroundStart(v)
+	return _c
+}
+
+// SetNillableBackgroundStart sets the "background_start" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableBackgroundStart(v *float64) *ClipCreate {
+	if v != nil {
+		_c.SetBackgroundStart(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *ClipCreate) SetWidth(v int) *ClipCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableWidth(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *ClipCreate) SetHeight(v int) *ClipCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableHeight(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetFadeDuration sets the "fade_duration" field.
+func (_c *ClipCreate) SetFadeDuration(v int) *ClipCreate {
+	_c.mutation.SetFadeDuration(v)
+	return _c
+}
+
+// SetNillableFadeDuration sets the "fade_duration" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableFadeDuration(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetFadeDuration(*v)
+	}
+	return _c
+}
+
+// SetFadeInDuration sets the "fade_in_duration" field.
+func (_c *ClipCreate) SetFadeInDuration(v float64) *ClipCreate {
+	_c.mutation.SetFadeInDuration(v)
+	return _c
+}
+
+// SetNillableFadeInDuration sets the "fade_in_duration" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableFadeInDuration(v *float64) *ClipCreate {
+	if v != nil {
+		_c.SetFadeInDuration(*v)
+	}
+	return _c
+}
+
+// SetAudioCensor sets the "audio_censor" field.
+func (_c *ClipCreate) SetAudioCensor(v *model.AudioCensor) *ClipCreate {
+	_c.mutation.SetAudioCensor(v)
+	return _c
+}
+
+// SetLoudness sets the "loudness" field.
+func (_c *ClipCreate) SetLoudness(v *model.ClipLoudness) *ClipCreate {
+	_c.mutation.SetLoudness(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ClipCreate) SetStatus(v model.ClipStatus) *ClipCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableStatus(v *model.ClipStatus) *ClipCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStage sets the "stage" field.
+func (_c *ClipCreate) SetStage(v model.ClipStage) *ClipCreate {
+	_c.mutation.SetStage(v)
+	return _c
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableStage(v *model.ClipStage) *ClipCreate {
+	if v != nil {
+		_c.SetStage(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ClipCreate) SetLastError(v string) *ClipCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableLastError(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *ClipCreate) SetAttempts(v int) *ClipCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableAttempts(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetStageTimestamps sets the "stage_timestamps" field.
+func (_c *ClipCreate) SetStageTimestamps(v map[model.ClipStage]*model.StageTimestamps) *ClipCreate {
+	_c.mutation.SetStageTimestamps(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ClipCreate) SetCreatedAt(v time.Time) *ClipCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -122,6 +495,16 @@ func (_c *ClipCreate) SetNillableDeletedAt(v *time.Time) *ClipCreate {
 	return _c
 }
 
+// SetAudio sets the "audio" edge to the Audio entity.
+func (_c *ClipCreate) SetAudio(v *Audio) *ClipCreate {
+	return _c.SetAudioID(v.ID)
+}
+
+// SetBackgroundVideo sets the "background_video" edge to the BackgroundVideo entity.
+func (_c *ClipCreate) SetBackgroundVideo(v *BackgroundVideo) *ClipCreate {
+	return _c.SetBackgroundVideoID(v.ID)
+}
+
 // Mutation returns the ClipMutation object of the builder.
 func (_c *ClipCreate) Mutation() *ClipMutation {
 	return _c.mutation
@@ -157,6 +540,18 @@ func (_c *ClipCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ClipCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := clip.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Stage(); !ok {
+		v := clip.DefaultStage
+		_c.mutation.SetStage(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := clip.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := clip.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -178,6 +573,30 @@ func (_c *ClipCreate) check() error {
 	if _, ok := _c.mutation.VideoPath(); !ok {
 		return &ValidationError{Name: "video_path", err: errors.New(`ent: missing required field "Clip.video_path"`)}
 	}
+	if v, ok := _c.mutation.CaptionStyle(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "caption_style", err: fmt.Errorf(`ent: validator failed for field "Clip.caption_style": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Clip.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := clip.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Clip.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "Clip.stage"`)}
+	}
+	if v, ok := _c.mutation.Stage(); ok {
+		if err := clip.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Clip.stage": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Clip.attempts"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Clip.created_at"`)}
 	}
@@ -222,6 +641,30 @@ func (_c *ClipCreate) createSpec() (*Clip, *sqlgraph.CreateSpec) {
 		_spec.SetField(clip.FieldVideoPath, field.TypeString, value)
 		_node.VideoPath = value
 	}
+	if value, ok := _c.mutation.Artist(); ok {
+		_spec.SetField(clip.FieldArtist, field.TypeString, value)
+		_node.Artist = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(clip.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Album(); ok {
+		_spec.SetField(clip.FieldAlbum, field.TypeString, value)
+		_node.Album = value
+	}
+	if value, ok := _c.mutation.Bio(); ok {
+		_spec.SetField(clip.FieldBio, field.TypeString, value)
+		_node.Bio = &value
+	}
+	if value, ok := _c.mutation.BioPath(); ok {
+		_spec.SetField(clip.FieldBioPath, field.TypeString, value)
+		_node.BioPath = &value
+	}
+	if value, ok := _c.mutation.Hashtags(); ok {
+		_spec.SetField(clip.FieldHashtags, field.TypeJSON, value)
+		_node.Hashtags = value
+	}
 	if value, ok := _c.mutation.GenCaptionsPath(); ok {
 		_spec.SetField(clip.FieldGenCaptionsPath, field.TypeString, value)
 		_node.GenCaptionsPath = &value
@@ -234,6 +677,98 @@ func (_c *ClipCreate) createSpec() (*Clip, *sqlgraph.CreateSpec) {
 		_spec.SetField(clip.FieldGenTrimmedVideoPath, field.TypeString, value)
 		_node.GenTrimmedVideoPath = &value
 	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(clip.FieldStartTime, field.TypeString, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(clip.FieldEndTime, field.TypeString, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.TranscriptPath(); ok {
+		_spec.SetField(clip.FieldTranscriptPath, field.TypeString, value)
+		_node.TranscriptPath = &value
+	}
+	if value, ok := _c.mutation.SubtitlesPath(); ok {
+		_spec.SetField(clip.FieldSubtitlesPath, field.TypeString, value)
+		_node.SubtitlesPath = &value
+	}
+	if value, ok := _c.mutation.LyricsPath(); ok {
+		_spec.SetField(clip.FieldLyricsPath, field.TypeString, value)
+		_node.LyricsPath = &value
+	}
+	if value, ok := _c.mutation.LyricsAlignment(); ok {
+		_spec.SetField(clip.FieldLyricsAlignment, field.TypeJSON, value)
+		_node.LyricsAlignment = value
+	}
+	if value, ok := _c.mutation.CensorLog(); ok {
+		_spec.SetField(clip.FieldCensorLog, field.TypeJSON, value)
+		_node.CensorLog = value
+	}
+	if value, ok := _c.mutation.CaptionsReviewedAt(); ok {
+		_spec.SetField(clip.FieldCaptionsReviewedAt, field.TypeTime, value)
+		_node.CaptionsReviewedAt = &value
+	}
+	if value, ok := _c.mutation.CaptionStyle(); ok {
+		_spec.SetField(clip.FieldCaptionStyle, field.TypeJSON, value)
+		_node.CaptionStyle = value
+	}
+	if value, ok := _c.mutation.SegmentPlan(); ok {
+		_spec.SetField(clip.FieldSegmentPlan, field.TypeJSON, value)
+		_node.SegmentPlan = value
+	}
+	if value, ok := _c.mutation.Seed(); ok {
+		_spec.SetField(clip.FieldSeed, field.TypeInt64, value)
+		_node.Seed = &value
+	}
+	if value, ok := _c.mutation.BackgroundStart(); ok {
+		_spec.SetField(clip.FieldBackgroundStart, field.TypeFloat64, value)
+		_node.BackgroundStart = &value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(clip.FieldWidth, field.TypeInt, value)
+		_node.Width = &value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(clip.FieldHeight, field.TypeInt, value)
+		_node.Height = &value
+	}
+	if value, ok := _c.mutation.FadeDuration(); ok {
+		_spec.SetField(clip.FieldFadeDuration, field.TypeInt, value)
+		_node.FadeDuration = &value
+	}
+	if value, ok := _c.mutation.FadeInDuration(); ok {
+		_spec.SetField(clip.FieldFadeInDuration, field.TypeFloat64, value)
+		_node.FadeInDuration = &value
+	}
+	if value, ok := _c.mutation.AudioCensor(); ok {
+		_spec.SetField(clip.FieldAudioCensor, field.TypeJSON, value)
+		_node.AudioCensor = value
+	}
+	if value, ok := _c.mutation.Loudness(); ok {
+		_spec.SetField(clip.FieldLoudness, field.TypeJSON, value)
+		_node.Loudness = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(clip.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Stage(); ok {
+		_spec.SetField(clip.FieldStage, field.TypeEnum, value)
+		_node.Stage = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(clip.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(clip.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.StageTimestamps(); ok {
+		_spec.SetField(clip.FieldStageTimestamps, field.TypeJSON, value)
+		_node.StageTimestamps = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(clip.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -246,6 +781,40 @@ func (_c *ClipCreate) createSpec() (*Clip, *sqlgraph.CreateSpec) {
 		_spec.SetField(clip.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if nodes := _c.mutation.AudioIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.AudioTable,
+			Columns: []string{clip.AudioColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AudioID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BackgroundVideoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.BackgroundVideoTable,
+			Columns: []string{clip.BackgroundVideoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BackgroundVideoID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
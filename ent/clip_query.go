@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
 	"github.com/sam-laister/tiktok-creator/ent/predicate"
 )
@@ -18,10 +20,12 @@ import (
 // ClipQuery is the builder for querying Clip entities.
 type ClipQuery struct {
 	config
-	ctx        *QueryContext
-	order      []clip.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Clip
+	ctx                 *QueryContext
+	order               []clip.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Clip
+	withAudio           *AudioQuery
+	withBackgroundVideo *BackgroundVideoQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +62,50 @@ func (_q *ClipQuery) Order(o ...clip.OrderOption) *ClipQuery {
 	return _q
 }
 
+// QueryAudio chains the current query on the "audio" edge.
+func (_q *ClipQuery) QueryAudio() *AudioQuery {
+	query := (&AudioClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clip.Table, clip.FieldID, selector),
+			sqlgraph.To(audio.Table, audio.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, clip.AudioTable, clip.AudioColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBackgroundVideo chains the current query on the "background_video" edge.
+func (_q *ClipQuery) QueryBackgroundVideo() *BackgroundVideoQuery {
+	query := (&BackgroundVideoClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clip.Table, clip.FieldID, selector),
+			sqlgraph.To(backgroundvideo.Table, backgroundvideo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, clip.BackgroundVideoTable, clip.BackgroundVideoColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Clip entity from the query.
 // Returns a *NotFoundError when no Clip was found.
 func (_q *ClipQuery) First(ctx context.Context) (*Clip, error) {
@@ -245,17 +293,41 @@ func (_q *ClipQuery) Clone() *ClipQuery {
 		return nil
 	}
 	return &ClipQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]clip.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Clip{}, _q.predicates...),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]clip.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Clip{}, _q.predicates...),
+		withAudio:           _q.withAudio.Clone(),
+		withBackgroundVideo: _q.withBackgroundVideo.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithAudio tells the query-builder to eager-load the nodes that are connected to
+// the "audio" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ClipQuery) WithAudio(opts ...func(*AudioQuery)) *ClipQuery {
+	query := (&AudioClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAudio = query
+	return _q
+}
+
+// WithBackgroundVideo tells the query-builder to eager-load the nodes that are connected to
+// the "background_video" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ClipQuery) WithBackgroundVideo(opts ...func(*BackgroundVideoQuery)) *ClipQuery {
+	query := (&BackgroundVideoClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBackgroundVideo = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +404,12 @@ func (_q *ClipQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *ClipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Clip, error) {
 	var (
-		nodes = []*Clip{}
-		_spec = _q.querySpec()
+		nodes       = []*Clip{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withAudio != nil,
+			_q.withBackgroundVideo != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Clip).scanValues(nil, columns)
@@ -341,6 +417,7 @@ func (_q *ClipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Clip, e
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Clip{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +429,86 @@ func (_q *ClipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Clip, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withAudio; query != nil {
+		if err := _q.loadAudio(ctx, query, nodes, nil,
+			func(n *Clip, e *Audio) { n.Edges.Audio = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBackgroundVideo; query != nil {
+		if err := _q.loadBackgroundVideo(ctx, query, nodes, nil,
+			func(n *Clip, e *BackgroundVideo) { n.Edges.BackgroundVideo = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *ClipQuery) loadAudio(ctx context.Context, query *AudioQuery, nodes []*Clip, init func(*Clip), assign func(*Clip, *Audio)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Clip)
+	for i := range nodes {
+		if nodes[i].AudioID == nil {
+			continue
+		}
+		fk := *nodes[i].AudioID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(audio.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "audio_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ClipQuery) loadBackgroundVideo(ctx context.Context, query *BackgroundVideoQuery, nodes []*Clip, init func(*Clip), assign func(*Clip, *BackgroundVideo)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Clip)
+	for i := range nodes {
+		if nodes[i].BackgroundVideoID == nil {
+			continue
+		}
+		fk := *nodes[i].BackgroundVideoID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backgroundvideo.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "background_video_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
 func (_q *ClipQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
@@ -380,6 +534,12 @@ func (_q *ClipQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withAudio != nil {
+			_spec.Node.AddColumnOnce(clip.FieldAudioID)
+		}
+		if _q.withBackgroundVideo != nil {
+			_spec.Node.AddColumnOnce(clip.FieldBackgroundVideoID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
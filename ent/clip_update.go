@@ -10,9 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
 	"github.com/sam-laister/tiktok-creator/ent/predicate"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 // ClipUpdate is the builder for updating Clip entities.
@@ -70,6 +74,164 @@ func (_u *ClipUpdate) SetNillableVideoPath(v *string) *ClipUpdate {
 	return _u
 }
 
+// SetArtist sets the "artist" field.
+func (_u *ClipUpdate) SetArtist(v string) *ClipUpdate {
+	_u.mutation.SetArtist(v)
+	return _u
+}
+
+// SetNillableArtist sets the "artist" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableArtist(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetArtist(*v)
+	}
+	return _u
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (_u *ClipUpdate) ClearArtist() *ClipUpdate {
+	_u.mutation.ClearArtist()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ClipUpdate) SetTitle(v string) *ClipUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableTitle(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *ClipUpdate) ClearTitle() *ClipUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetAlbum sets the "album" field.
+func (_u *ClipUpdate) SetAlbum(v string) *ClipUpdate {
+	_u.mutation.SetAlbum(v)
+	return _u
+}
+
+// SetNillableAlbum sets the "album" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableAlbum(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetAlbum(*v)
+	}
+	return _u
+}
+
+// ClearAlbum clears the value of the "album" field.
+func (_u *ClipUpdate) ClearAlbum() *ClipUpdate {
+	_u.mutation.ClearAlbum()
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *ClipUpdate) SetBio(v string) *ClipUpdate {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableBio(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *ClipUpdate) ClearBio() *ClipUpdate {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetBioPath sets the "bio_path" field.
+func (_u *ClipUpdate) SetBioPath(v string) *ClipUpdate {
+	_u.mutation.SetBioPath(v)
+	return _u
+}
+
+// SetNillableBioPath sets the "bio_path" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableBioPath(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetBioPath(*v)
+	}
+	return _u
+}
+
+// ClearBioPath clears the value of the "bio_path" field.
+func (_u *ClipUpdate) ClearBioPath() *ClipUpdate {
+	_u.mutation.ClearBioPath()
+	return _u
+}
+
+// SetHashtags sets the "hashtags" field.
+func (_u *ClipUpdate) SetHashtags(v []string) *ClipUpdate {
+	_u.mutation.SetHashtags(v)
+	return _u
+}
+
+// AppendHashtags appends value to the "hashtags" field.
+func (_u *ClipUpdate) AppendHashtags(v []string) *ClipUpdate {
+	_u.mutation.AppendHashtags(v)
+	return _u
+}
+
+// ClearHashtags clears the value of the "hashtags" field.
+func (_u *ClipUpdate) ClearHashtags() *ClipUpdate {
+	_u.mutation.ClearHashtags()
+	return _u
+}
+
+// SetAudioID sets the "audio_id" field.
+func (_u *ClipUpdate) SetAudioID(v int) *ClipUpdate {
+	_u.mutation.SetAudioID(v)
+	return _u
+}
+
+// SetNillableAudioID sets the "audio_id" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableAudioID(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetAudioID(*v)
+	}
+	return _u
+}
+
+// ClearAudioID clears the value of the "audio_id" field.
+func (_u *ClipUpdate) ClearAudioID() *ClipUpdate {
+	_u.mutation.ClearAudioID()
+	return _u
+}
+
+// SetBackgroundVideoID sets the "background_video_id" field.
+func (_u *ClipUpdate) SetBackgroundVideoID(v int) *ClipUpdate {
+	_u.mutation.SetBackgroundVideoID(v)
+	return _u
+}
+
+// SetNillableBackgroundVideoID sets the "background_video_id" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableBackgroundVideoID(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetBackgroundVideoID(*v)
+	}
+	return _u
+}
+
+// ClearBackgroundVideoID clears the value of the "background_video_id" field.
+func (_u *ClipUpdate) ClearBackgroundVideoID() *ClipUpdate {
+	_u.mutation.ClearBackgroundVideoID()
+	return _u
+}
+
 // SetGenCaptionsPath sets the "gen_captions_path" field.
 func (_u *ClipUpdate) SetGenCaptionsPath(v string) *ClipUpdate {
 	_u.mutation.SetGenCaptionsPath(v)
@@ -130,253 +292,1592 @@ func (_u *ClipUpdate) ClearGenTrimmedVideoPath() *ClipUpdate {
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *ClipUpdate) SetCreatedAt(v time.Time) *ClipUpdate {
-	_u.mutation.SetCreatedAt(v)
+// SetStartTime sets the "start_time" field.
+func (_u *ClipUpdate) SetStartTime(v string) *ClipUpdate {
+	_u.mutation.SetStartTime(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *ClipUpdate) SetNillableCreatedAt(v *time.Time) *ClipUpdate {
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableStartTime(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (_u *ClipUpdate) ClearStartTime() *ClipUpdate {
+	_u.mutation.ClearStartTime()
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *ClipUpdate) SetEndTime(v string) *ClipUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableEndTime(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (_u *ClipUpdate) ClearEndTime() *ClipUpdate {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetTranscriptPath sets the "transcript_path" field.
+func (_u *ClipUpdate) SetTranscriptPath(v string) *ClipUpdate {
+	_u.mutation.SetTranscriptPath(v)
+	return _u
+}
+
+// SetNillableTranscriptPath sets the "transcript_path" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableTranscriptPath(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetTranscriptPath(*v)
+	}
+	return _u
+}
+
+// ClearTranscriptPath clears the value of the "transcript_path" field.
+func (_u *ClipUpdate) ClearTranscriptPath() *ClipUpdate {
+	_u.mutation.ClearTranscriptPath()
+	return _u
+}
+
+// SetSubtitlesPath sets the "subtitles_path" field.
+func (_u *ClipUpdate) SetSubtitlesPath(v string) *ClipUpdate {
+	_u.mutation.SetSubtitlesPath(v)
+	return _u
+}
+
+// SetNillableSubtitlesPath sets the "subtitles_path" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableSubtitlesPath(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetSubtitlesPath(*v)
+	}
+	return _u
+}
+
+// ClearSubtitlesPath clears the value of the "subtitles_path" field.
+func (_u *ClipUpdate) ClearSubtitlesPath() *ClipUpdate {
+	_u.mutation.ClearSubtitlesPath()
+	return _u
+}
+
+// SetLyricsPath sets the "lyrics_path" field.
+func (_u *ClipUpdate) SetLyricsPath(v string) *ClipUpdate {
+	_u.mutation.SetLyricsPath(v)
+	return _u
+}
+
+// SetNillableLyricsPath sets the "lyrics_path" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableLyricsPath(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetLyricsPath(*v)
+	}
+	return _u
+}
+
+// ClearLyricsPath clears the value of the "lyrics_path" field.
+func (_u *ClipUpdate) ClearLyricsPath() *ClipUpdate {
+	_u.mutation.ClearLyricsPath()
+	return _u
+}
+
+// SetLyricsAlignment sets the "lyrics_alignment" field.
+func (_u *ClipUpdate) SetLyricsAlignment(v *model.LyricsAlignment) *ClipUpdate {
+	_u.mutation.SetLyricsAlignment(v)
+	return _u
+}
+
+// ClearLyricsAlignment clears the value of the "lyrics_alignment" field.
+func (_u *ClipUpdate) ClearLyricsAlignment() *ClipUpdate {
+	_u.mutation.ClearLyricsAlignment()
+	return _u
+}
+
+// SetCensorLog sets the "censor_log" field.
+func (_u *ClipUpdate) SetCensorLog(v []model.CensorEvent) *ClipUpdate {
+	_u.mutation.SetCensorLog(v)
+	return _u
+}
+
+// AppendCensorLog appends value to the "censor_log" field.
+func (_u *ClipUpdate) AppendCensorLog(v []model.CensorEvent) *ClipUpdate {
+	_u.mutation.AppendCensorLog(v)
+	return _u
+}
+
+// ClearCensorLog clears the value of the "censor_log" field.
+func (_u *ClipUpdate) ClearCensorLog() *ClipUpdate {
+	_u.mutation.ClearCensorLog()
+	return _u
+}
+
+// SetCaptionsReviewedAt sets the "captions_reviewed_at" field.
+func (_u *ClipUpdate) SetCaptionsReviewedAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetCaptionsReviewedAt(v)
+	return _u
+}
+
+// SetNillableCaptionsReviewedAt sets the "captions_reviewed_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableCaptionsReviewedAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetCaptionsReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearCaptionsReviewedAt clears the value of the "captions_reviewed_at" field.
+func (_u *ClipUpdate) ClearCaptionsReviewedAt() *ClipUpdate {
+	_u.mutation.ClearCaptionsReviewedAt()
+	return _u
+}
+
+// SetCaptionStyle sets the "caption_style" field.
+func (_u *ClipUpdate) SetCaptionStyle(v *model.CaptionStyle) *ClipUpdate {
+	_u.mutation.SetCaptionStyle(v)
+	return _u
+}
+
+// ClearCaptionStyle clears the value of the "caption_style" field.
+func (_u *ClipUpdate) ClearCaptionStyle() *ClipUpdate {
+	_u.mutation.ClearCaptionStyle()
+	return _u
+}
+
+// SetSegmentPlan sets the "segment_plan" field.
+func (_u *ClipUpdate) SetSegmentPlan(v *model.SegmentPlan) *ClipUpdate {
+	_u.mutation.SetSegmentPlan(v)
+	return _u
+}
+
+// ClearSegmentPlan clears the value of the "segment_plan" field.
+func (_u *ClipUpdate) ClearSegmentPlan() *ClipUpdate {
+	_u.mutation.ClearSegmentPlan()
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *ClipUpdate) SetSeed(v int64) *ClipUpdate {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableSeed(v *int64) *ClipUpdate {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *ClipUpdate) AddSeed(v int64) *ClipUpdate {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (_u *ClipUpdate) ClearSeed() *ClipUpdate {
+	_u.mutation.ClearSeed()
+	return _u
+}
+
+// SetBackgroundStart sets the "background_start" field.
+func (_u *ClipUpdate) SetBackgroundStart(v float64) *ClipUpdate {
+	_u.mutation.ResetBackgroundStart()
+	_u.mutation.SetBackgroundStart(v)
+	return _u
+}
+
+// SetNillableBackgroundStart sets the "background_start" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableBackgroundStart(v *float64) *ClipUpdate {
+	if v != nil {
+		_u.SetBackgroundStart(*v)
+	}
+	return _u
+}
+
+// AddBackgroundStart adds value to the "background_start" field.
+func (_u *ClipUpdate) AddBackgroundStart(v float64) *ClipUpdate {
+	_u.mutation.AddBackgroundStart(v)
+	return _u
+}
+
+// ClearBackgroundStart clears the value of the "background_start" field.
+func (_u *ClipUpdate) ClearBackgroundStart() *ClipUpdate {
+	_u.mutation.ClearBackgroundStart()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *ClipUpdate) SetWidth(v int) *ClipUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableWidth(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *ClipUpdate) AddWidth(v int) *ClipUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *ClipUpdate) ClearWidth() *ClipUpdate {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *ClipUpdate) SetHeight(v int) *ClipUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableHeight(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *ClipUpdate) AddHeight(v int) *ClipUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *ClipUpdate) ClearHeight() *ClipUpdate {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetFadeDuration sets the "fade_duration" field.
+func (_u *ClipUpdate) SetFadeDuration(v int) *ClipUpdate {
+	_u.mutation.ResetFadeDuration()
+	_u.mutation.SetFadeDuration(v)
+	return _u
+}
+
+// SetNillableFadeDuration sets the "fade_duration" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableFadeDuration(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetFadeDuration(*v)
+	}
+	return _u
+}
+
+// AddFadeDuration adds value to the "fade_duration" field.
+func (_u *ClipUpdate) AddFadeDuration(v int) *ClipUpdate {
+	_u.mutation.AddFadeDuration(v)
+	return _u
+}
+
+// ClearFadeDuration clears the value of the "fade_duration" field.
+func (_u *ClipUpdate) ClearFadeDuration() *ClipUpdate {
+	_u.mutation.ClearFadeDuration()
+	return _u
+}
+
+// SetFadeInDuration sets the "fade_in_duration" field.
+func (_u *ClipUpdate) SetFadeInDuration(v float64) *ClipUpdate {
+	_u.mutation.ResetFadeInDuration()
+	_u.mutation.SetFadeInDuration(v)
+	return _u
+}
+
+// SetNillableFadeInDuration sets the "fade_in_duration" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableFadeInDuration(v *float64) *ClipUpdate {
+	if v != nil {
+		_u.SetFadeInDuration(*v)
+	}
+	return _u
+}
+
+// AddFadeInDuration adds value to the "fade_in_duration" field.
+func (_u *ClipUpdate) AddFadeInDuration(v float64) *ClipUpdate {
+	_u.mutation.AddFadeInDuration(v)
+	return _u
+}
+
+// ClearFadeInDuration clears the value of the "fade_in_duration" field.
+func (_u *ClipUpdate) ClearFadeInDuration() *ClipUpdate {
+	_u.mutation.ClearFadeInDuration()
+	return _u
+}
+
+// SetAudioCensor sets the "audio_censor" field.
+func (_u *ClipUpdate) SetAudioCensor(v *model.AudioCensor) *ClipUpdate {
+	_u.mutation.SetAudioCensor(v)
+	return _u
+}
+
+// ClearAudioCensor clears the value of the "audio_censor" field.
+func (_u *ClipUpdate) ClearAudioCensor() *ClipUpdate {
+	_u.mutation.ClearAudioCensor()
+	return _u
+}
+
+// SetLoudness sets the "loudness" field.
+func (_u *ClipUpdate) SetLoudness(v *model.ClipLoudness) *ClipUpdate {
+	_u.mutation.SetLoudness(v)
+	return _u
+}
+
+// ClearLoudness clears the value of the "loudness" field.
+func (_u *ClipUpdate) ClearLoudness() *ClipUpdate {
+	_u.mutation.ClearLoudness()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ClipUpdate) SetStatus(v model.ClipStatus) *ClipUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableStatus(v *model.ClipStatus) *ClipUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStage sets the "stage" field.
+func (_u *ClipUpdate) SetStage(v model.ClipStage) *ClipUpdate {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableStage(v *model.ClipStage) *ClipUpdate {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ClipUpdate) SetLastError(v string) *ClipUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableLastError(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ClipUpdate) ClearLastError() *ClipUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ClipUpdate) SetAttempts(v int) *ClipUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableAttempts(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ClipUpdate) AddAttempts(v int) *ClipUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetStageTimestamps sets the "stage_timestamps" field.
+func (_u *ClipUpdate) SetStageTimestamps(v map[model.ClipStage]*model.StageTimestamps) *ClipUpdate {
+	_u.mutation.SetStageTimestamps(v)
+	return _u
+}
+
+// ClearStageTimestamps clears the value of the "stage_timestamps" field.
+func (_u *ClipUpdate) ClearStageTimestamps() *ClipUpdate {
+	_u.mutation.ClearStageTimestamps()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ClipUpdate) SetCreatedAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableCreatedAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClipUpdate) SetUpdatedAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableUpdatedAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ClipUpdate) SetDeletedAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableDeletedAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ClipUpdate) ClearDeletedAt() *ClipUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetAudio sets the "audio" edge to the Audio entity.
+func (_u *ClipUpdate) SetAudio(v *Audio) *ClipUpdate {
+	return _u.SetAudioID(v.ID)
+}
+
+// SetBackgroundVideo sets the "background_video" edge to the BackgroundVideo entity.
+func (_u *ClipUpdate) SetBackgroundVideo(v *BackgroundVideo) *ClipUpdate {
+	return _u.SetBackgroundVideoID(v.ID)
+}
+
+// Mutation returns the ClipMutation object of the builder.
+func (_u *ClipUpdate) Mutation() *ClipMutation {
+	return _u.mutation
+}
+
+// ClearAudio clears the "audio" edge to the Audio entity.
+func (_u *ClipUpdate) ClearAudio() *ClipUpdate {
+	_u.mutation.ClearAudio()
+	return _u
+}
+
+// ClearBackgroundVideo clears the "background_video" edge to the BackgroundVideo entity.
+func (_u *ClipUpdate) ClearBackgroundVideo() *ClipUpdate {
+	_u.mutation.ClearBackgroundVideo()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClipUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClipUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClipUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClipUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClipUpdate) check() error {
+	if v, ok := _u.mutation.CaptionStyle(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "caption_style", err: fmt.Errorf(`ent: validator failed for field "Clip.caption_style": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := clip.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Clip.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stage(); ok {
+		if err := clip.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Clip.stage": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clip.Table, clip.Columns, sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(clip.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AudioPath(); ok {
+		_spec.SetField(clip.FieldAudioPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VideoPath(); ok {
+		_spec.SetField(clip.FieldVideoPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Artist(); ok {
+		_spec.SetField(clip.FieldArtist, field.TypeString, value)
+	}
+	if _u.mutation.ArtistCleared() {
+		_spec.ClearField(clip.FieldArtist, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(clip.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(clip.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Album(); ok {
+		_spec.SetField(clip.FieldAlbum, field.TypeString, value)
+	}
+	if _u.mutation.AlbumCleared() {
+		_spec.ClearField(clip.FieldAlbum, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(clip.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(clip.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.BioPath(); ok {
+		_spec.SetField(clip.FieldBioPath, field.TypeString, value)
+	}
+	if _u.mutation.BioPathCleared() {
+		_spec.ClearField(clip.FieldBioPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hashtags(); ok {
+		_spec.SetField(clip.FieldHashtags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHashtags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clip.FieldHashtags, value)
+		})
+	}
+	if _u.mutation.HashtagsCleared() {
+		_spec.ClearField(clip.FieldHashtags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GenCaptionsPath(); ok {
+		_spec.SetField(clip.FieldGenCaptionsPath, field.TypeString, value)
+	}
+	if _u.mutation.GenCaptionsPathCleared() {
+		_spec.ClearField(clip.FieldGenCaptionsPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.GenRawVideoPath(); ok {
+		_spec.SetField(clip.FieldGenRawVideoPath, field.TypeString, value)
+	}
+	if _u.mutation.GenRawVideoPathCleared() {
+		_spec.ClearField(clip.FieldGenRawVideoPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.GenTrimmedVideoPath(); ok {
+		_spec.SetField(clip.FieldGenTrimmedVideoPath, field.TypeString, value)
+	}
+	if _u.mutation.GenTrimmedVideoPathCleared() {
+		_spec.ClearField(clip.FieldGenTrimmedVideoPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(clip.FieldStartTime, field.TypeString, value)
+	}
+	if _u.mutation.StartTimeCleared() {
+		_spec.ClearField(clip.FieldStartTime, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(clip.FieldEndTime, field.TypeString, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(clip.FieldEndTime, field.TypeString)
+	}
+	if value, ok := _u.mutation.TranscriptPath(); ok {
+		_spec.SetField(clip.FieldTranscriptPath, field.TypeString, value)
+	}
+	if _u.mutation.TranscriptPathCleared() {
+		_spec.ClearField(clip.FieldTranscriptPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubtitlesPath(); ok {
+		_spec.SetField(clip.FieldSubtitlesPath, field.TypeString, value)
+	}
+	if _u.mutation.SubtitlesPathCleared() {
+		_spec.ClearField(clip.FieldSubtitlesPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.LyricsPath(); ok {
+		_spec.SetField(clip.FieldLyricsPath, field.TypeString, value)
+	}
+	if _u.mutation.LyricsPathCleared() {
+		_spec.ClearField(clip.FieldLyricsPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.LyricsAlignment(); ok {
+		_spec.SetField(clip.FieldLyricsAlignment, field.TypeJSON, value)
+	}
+	if _u.mutation.LyricsAlignmentCleared() {
+		_spec.ClearField(clip.FieldLyricsAlignment, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CensorLog(); ok {
+		_spec.SetField(clip.FieldCensorLog, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCensorLog(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clip.FieldCensorLog, value)
+		})
+	}
+	if _u.mutation.CensorLogCleared() {
+		_spec.ClearField(clip.FieldCensorLog, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CaptionsReviewedAt(); ok {
+		_spec.SetField(clip.FieldCaptionsReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CaptionsReviewedAtCleared() {
+		_spec.ClearField(clip.FieldCaptionsReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CaptionStyle(); ok {
+		_spec.SetField(clip.FieldCaptionStyle, field.TypeJSON, value)
+	}
+	if _u.mutation.CaptionStyleCleared() {
+		_spec.ClearField(clip.FieldCaptionStyle, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SegmentPlan(); ok {
+		_spec.SetField(clip.FieldSegmentPlan, field.TypeJSON, value)
+	}
+	if _u.mutation.SegmentPlanCleared() {
+		_spec.ClearField(clip.FieldSegmentPlan, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(clip.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(clip.FieldSeed, field.TypeInt64, value)
+	}
+	if _u.mutation.SeedCleared() {
+		_spec.ClearField(clip.FieldSeed, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.BackgroundStart(); ok {
+		_spec.SetField(clip.FieldBackgroundStart, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBackgroundStart(); ok {
+		_spec.AddField(clip.FieldBackgroundStart, field.TypeFloat64, value)
+	}
+	if _u.mutation.BackgroundStartCleared() {
+		_spec.ClearField(clip.FieldBackgroundStart, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(clip.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(clip.FieldWidth, field.TypeInt, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(clip.FieldWidth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(clip.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(clip.FieldHeight, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(clip.FieldHeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FadeDuration(); ok {
+		_spec.SetField(clip.FieldFadeDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFadeDuration(); ok {
+		_spec.AddField(clip.FieldFadeDuration, field.TypeInt, value)
+	}
+	if _u.mutation.FadeDurationCleared() {
+		_spec.ClearField(clip.FieldFadeDuration, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FadeInDuration(); ok {
+		_spec.SetField(clip.FieldFadeInDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFadeInDuration(); ok {
+		_spec.AddField(clip.FieldFadeInDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.FadeInDurationCleared() {
+		_spec.ClearField(clip.FieldFadeInDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AudioCensor(); ok {
+		_spec.SetField(clip.FieldAudioCensor, field.TypeJSON, value)
+	}
+	if _u.mutation.AudioCensorCleared() {
+		_spec.ClearField(clip.FieldAudioCensor, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Loudness(); ok {
+		_spec.SetField(clip.FieldLoudness, field.TypeJSON, value)
+	}
+	if _u.mutation.LoudnessCleared() {
+		_spec.ClearField(clip.FieldLoudness, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(clip.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(clip.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(clip.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(clip.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(clip.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(clip.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StageTimestamps(); ok {
+		_spec.SetField(clip.FieldStageTimestamps, field.TypeJSON, value)
+	}
+	if _u.mutation.StageTimestampsCleared() {
+		_spec.ClearField(clip.FieldStageTimestamps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(clip.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clip.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(clip.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(clip.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.AudioCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.AudioTable,
+			Columns: []string{clip.AudioColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AudioIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.AudioTable,
+			Columns: []string{clip.AudioColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BackgroundVideoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.BackgroundVideoTable,
+			Columns: []string{clip.BackgroundVideoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackgroundVideoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.BackgroundVideoTable,
+			Columns: []string{clip.BackgroundVideoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clip.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClipUpdateOne is the builder for updating a single Clip entity.
+type ClipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClipMutation
+}
+
+// SetHash sets the "hash" field.
+func (_u *ClipUpdateOne) SetHash(v string) *ClipUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableHash(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetAudioPath sets the "audio_path" field.
+func (_u *ClipUpdateOne) SetAudioPath(v string) *ClipUpdateOne {
+	_u.mutation.SetAudioPath(v)
+	return _u
+}
+
+// SetNillableAudioPath sets the "audio_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableAudioPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetAudioPath(*v)
+	}
+	return _u
+}
+
+// SetVideoPath sets the "video_path" field.
+func (_u *ClipUpdateOne) SetVideoPath(v string) *ClipUpdateOne {
+	_u.mutation.SetVideoPath(v)
+	return _u
+}
+
+// SetNillableVideoPath sets the "video_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableVideoPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetVideoPath(*v)
+	}
+	return _u
+}
+
+// SetArtist sets the "artist" field.
+func (_u *ClipUpdateOne) SetArtist(v string) *ClipUpdateOne {
+	_u.mutation.SetArtist(v)
+	return _u
+}
+
+// SetNillableArtist sets the "artist" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableArtist(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetArtist(*v)
+	}
+	return _u
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (_u *ClipUpdateOne) ClearArtist() *ClipUpdateOne {
+	_u.mutation.ClearArtist()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ClipUpdateOne) SetTitle(v string) *ClipUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableTitle(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *ClipUpdateOne) ClearTitle() *ClipUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetAlbum sets the "album" field.
+func (_u *ClipUpdateOne) SetAlbum(v string) *ClipUpdateOne {
+	_u.mutation.SetAlbum(v)
+	return _u
+}
+
+// SetNillableAlbum sets the "album" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableAlbum(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetAlbum(*v)
+	}
+	return _u
+}
+
+// ClearAlbum clears the value of the "album" field.
+func (_u *ClipUpdateOne) ClearAlbum() *ClipUpdateOne {
+	_u.mutation.ClearAlbum()
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *ClipUpdateOne) SetBio(v string) *ClipUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableBio(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *ClipUpdateOne) ClearBio() *ClipUpdateOne {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetBioPath sets the "bio_path" field.
+func (_u *ClipUpdateOne) SetBioPath(v string) *ClipUpdateOne {
+	_u.mutation.SetBioPath(v)
+	return _u
+}
+
+// SetNillableBioPath sets the "bio_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableBioPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetBioPath(*v)
+	}
+	return _u
+}
+
+// ClearBioPath clears the value of the "bio_path" field.
+func (_u *ClipUpdateOne) ClearBioPath() *ClipUpdateOne {
+	_u.mutation.ClearBioPath()
+	return _u
+}
+
+// SetHashtags sets the "hashtags" field.
+func (_u *ClipUpdateOne) SetHashtags(v []string) *ClipUpdateOne {
+	_u.mutation.SetHashtags(v)
+	return _u
+}
+
+// AppendHashtags appends value to the "hashtags" field.
+func (_u *ClipUpdateOne) AppendHashtags(v []string) *ClipUpdateOne {
+	_u.mutation.AppendHashtags(v)
+	return _u
+}
+
+// ClearHashtags clears the value of the "hashtags" field.
+func (_u *ClipUpdateOne) ClearHashtags() *ClipUpdateOne {
+	_u.mutation.ClearHashtags()
+	return _u
+}
+
+// SetAudioID sets the "audio_id" field.
+func (_u *ClipUpdateOne) SetAudioID(v int) *ClipUpdateOne {
+	_u.mutation.SetAudioID(v)
+	return _u
+}
+
+// SetNillableAudioID sets the "audio_id" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableAudioID(v *int) *ClipUpdateOne {
+	if v != nil {
+		_u.SetAudioID(*v)
+	}
+	return _u
+}
+
+// ClearAudioID clears the value of the "audio_id" field.
+func (_u *ClipUpdateOne) ClearAudioID() *ClipUpdateOne {
+	_u.mutation.ClearAudioID()
+	return _u
+}
+
+// SetBackgroundVideoID sets the "background_video_id" field.
+func (_u *ClipUpdateOne) SetBackgroundVideoID(v int) *ClipUpdateOne {
+	_u.mutation.SetBackgroundVideoID(v)
+	return _u
+}
+
+// SetNillableBackgroundVideoID sets the "background_video_id" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableBackgroundVideoID(v *int) *ClipUpdateOne {
+	if v != nil {
+		_u.SetBackgroundVideoID(*v)
+	}
+	return _u
+}
+
+// ClearBackgroundVideoID clears the value of the "background_video_id" field.
+func (_u *ClipUpdateOne) ClearBackgroundVideoID() *ClipUpdateOne {
+	_u.mutation.ClearBackgroundVideoID()
+	return _u
+}
+
+// SetGenCaptionsPath sets the "gen_captions_path" field.
+func (_u *ClipUpdateOne) SetGenCaptionsPath(v string) *ClipUpdateOne {
+	_u.mutation.SetGenCaptionsPath(v)
+	return _u
+}
+
+// SetNillableGenCaptionsPath sets the "gen_captions_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableGenCaptionsPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetGenCaptionsPath(*v)
+	}
+	return _u
+}
+
+// ClearGenCaptionsPath clears the value of the "gen_captions_path" field.
+func (_u *ClipUpdateOne) ClearGenCaptionsPath() *ClipUpdateOne {
+	_u.mutation.ClearGenCaptionsPath()
+	return _u
+}
+
+// SetGenRawVideoPath sets the "gen_raw_video_path" field.
+func (_u *ClipUpdateOne) SetGenRawVideoPath(v string) *ClipUpdateOne {
+	_u.mutation.SetGenRawVideoPath(v)
+	return _u
+}
+
+// SetNillableGenRawVideoPath sets the "gen_raw_video_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableGenRawVideoPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetGenRawVideoPath(*v)
+	}
+	return _u
+}
+
+// ClearGenRawVideoPath clears the value of the "gen_raw_video_path" field.
+func (_u *ClipUpdateOne) ClearGenRawVideoPath() *ClipUpdateOne {
+	_u.mutation.ClearGenRawVideoPath()
+	return _u
+}
+
+// SetGenTrimmedVideoPath sets the "gen_trimmed_video_path" field.
+func (_u *ClipUpdateOne) SetGenTrimmedVideoPath(v string) *ClipUpdateOne {
+	_u.mutation.SetGenTrimmedVideoPath(v)
+	return _u
+}
+
+// SetNillableGenTrimmedVideoPath sets the "gen_trimmed_video_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableGenTrimmedVideoPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetGenTrimmedVideoPath(*v)
+	}
+	return _u
+}
+
+// ClearGenTrimmedVideoPath clears the value of the "gen_trimmed_video_path" field.
+func (_u *ClipUpdateOne) ClearGenTrimmedVideoPath() *ClipUpdateOne {
+	_u.mutation.ClearGenTrimmedVideoPath()
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *ClipUpdateOne) SetStartTime(v string) *ClipUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableStartTime(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (_u *ClipUpdateOne) ClearStartTime() *ClipUpdateOne {
+	_u.mutation.ClearStartTime()
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *ClipUpdateOne) SetEndTime(v string) *ClipUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableEndTime(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (_u *ClipUpdateOne) ClearEndTime() *ClipUpdateOne {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetTranscriptPath sets the "transcript_path" field.
+func (_u *ClipUpdateOne) SetTranscriptPath(v string) *ClipUpdateOne {
+	_u.mutation.SetTranscriptPath(v)
+	return _u
+}
+
+// SetNillableTranscriptPath sets the "transcript_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableTranscriptPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetTranscriptPath(*v)
+	}
+	return _u
+}
+
+// ClearTranscriptPath clears the value of the "transcript_path" field.
+func (_u *ClipUpdateOne) ClearTranscriptPath() *ClipUpdateOne {
+	_u.mutation.ClearTranscriptPath()
+	return _u
+}
+
+// SetSubtitlesPath sets the "subtitles_path" field.
+func (_u *ClipUpdateOne) SetSubtitlesPath(v string) *ClipUpdateOne {
+	_u.mutation.SetSubtitlesPath(v)
+	return _u
+}
+
+// SetNillableSubtitlesPath sets the "subtitles_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableSubtitlesPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetSubtitlesPath(*v)
+	}
+	return _u
+}
+
+// ClearSubtitlesPath clears the value of the "subtitles_path" field.
+func (_u *ClipUpdateOne) ClearSubtitlesPath() *ClipUpdateOne {
+	_u.mutation.ClearSubtitlesPath()
+	return _u
+}
+
+// SetLyricsPath sets the "lyrics_path" field.
+func (_u *ClipUpdateOne) SetLyricsPath(v string) *ClipUpdateOne {
+	_u.mutation.SetLyricsPath(v)
+	return _u
+}
+
+// SetNillableLyricsPath sets the "lyrics_path" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableLyricsPath(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetLyricsPath(*v)
+	}
+	return _u
+}
+
+// ClearLyricsPath clears the value of the "lyrics_path" field.
+func (_u *ClipUpdateOne) ClearLyricsPath() *ClipUpdateOne {
+	_u.mutation.ClearLyricsPath()
+	return _u
+}
+
+// SetLyricsAlignment sets the "lyrics_alignment" field.
+func (_u *ClipUpdateOne) SetLyricsAlignment(v *model.LyricsAlignment) *ClipUpdateOne {
+	_u.mutation.SetLyricsAlignment(v)
+	return _u
+}
+
+// ClearLyricsAlignment clears the value of the "lyrics_alignment" field.
+func (_u *ClipUpdateOne) ClearLyricsAlignment() *ClipUpdateOne {
+	_u.mutation.ClearLyricsAlignment()
+	return _u
+}
+
+// SetCensorLog sets the "censor_log" field.
+func (_u *ClipUpdateOne) SetCensorLog(v []model.CensorEvent) *ClipUpdateOne {
+	_u.mutation.SetCensorLog(v)
+	return _u
+}
+
+// AppendCensorLog appends value to the "censor_log" field.
+func (_u *ClipUpdateOne) AppendCensorLog(v []model.CensorEvent) *ClipUpdateOne {
+	_u.mutation.AppendCensorLog(v)
+	return _u
+}
+
+// ClearCensorLog clears the value of the "censor_log" field.
+func (_u *ClipUpdateOne) ClearCensorLog() *ClipUpdateOne {
+	_u.mutation.ClearCensorLog()
+	return _u
+}
+
+// SetCaptionsReviewedAt sets the "captions_reviewed_at" field.
+func (_u *ClipUpdateOne) SetCaptionsReviewedAt(v time.Time) *ClipUpdateOne {
+	_u.mutation.SetCaptionsReviewedAt(v)
+	return _u
+}
+
+// SetNillableCaptionsReviewedAt sets the "captions_reviewed_at" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableCaptionsReviewedAt(v *time.Time) *ClipUpdateOne {
+	if v != nil {
+		_u.SetCaptionsReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearCaptionsReviewedAt clears the value of the "captions_reviewed_at" field.
+func (_u *ClipUpdateOne) ClearCaptionsReviewedAt() *ClipUpdateOne {
+	_u.mutation.ClearCaptionsReviewedAt()
+	return _u
+}
+
+// SetCaptionStyle sets the "caption_style" field.
+func (_u *ClipUpdateOne) SetCaptionStyle(v *model.CaptionStyle) *ClipUpdateOne {
+	_u.mutation.SetCaptionStyle(v)
+	return _u
+}
+
+// ClearCaptionStyle clears the value of the "caption_style" field.
+func (_u *ClipUpdateOne) ClearCaptionStyle() *ClipUpdateOne {
+	_u.mutation.ClearCaptionStyle()
+	return _u
+}
+
+// SetSegmentPlan sets the "segment_plan" field.
+func (_u *ClipUpdateOne) SetSegmentPlan(v *model.SegmentPlan) *ClipUpdateOne {
+	_u.mutation.SetSegmentPlan(v)
+	return _u
+}
+
+// ClearSegmentPlan clears the value of the "segment_plan" field.
+func (_u *ClipUpdateOne) ClearSegmentPlan() *ClipUpdateOne {
+	_u.mutation.ClearSegmentPlan()
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *ClipUpdateOne) SetSeed(v int64) *ClipUpdateOne {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableSeed(v *int64) *ClipUpdateOne {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *ClipUpdateOne) AddSeed(v int64) *ClipUpdateOne {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (_u *ClipUpdateOne) ClearSeed() *ClipUpdateOne {
+	_u.mutation.ClearSeed()
+	return _u
+}
+
+// SetBackgroundStart sets the "background_start" field.
+func (_u *ClipUpdateOne) SetBackgroundStart(v float64) *ClipUpdateOne {
+	_u.mutation.ResetBackgroundStart()
+	_u.mutation.SetBackgroundStart(v)
+	return _u
+}
+
+// SetNillableBackgroundStart sets the "background_start" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableBackgroundStart(v *float64) *ClipUpdateOne {
+	if v != nil {
+		_u.SetBackgroundStart(*v)
+	}
+	return _u
+}
+
+// AddBackgroundStart adds value to the "background_start" field.
+func (_u *ClipUpdateOne) AddBackgroundStart(v float64) *ClipUpdateOne {
+	_u.mutation.AddBackgroundStart(v)
+	return _u
+}
+
+// ClearBackgroundStart clears the value of the "background_start" field.
+func (_u *ClipUpdateOne) ClearBackgroundStart() *ClipUpdateOne {
+	_u.mutation.ClearBackgroundStart()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *ClipUpdateOne) SetWidth(v int) *ClipUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableWidth(v *int) *ClipUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *ClipUpdateOne) AddWidth(v int) *ClipUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *ClipUpdateOne) ClearWidth() *ClipUpdateOne {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *ClipUpdateOne) SetHeight(v int) *ClipUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableHeight(v *int) *ClipUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetHeight(*v)
 	}
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ClipUpdate) SetUpdatedAt(v time.Time) *ClipUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// AddHeight adds value to the "height" field.
+func (_u *ClipUpdateOne) AddHeight(v int) *ClipUpdateOne {
+	_u.mutation.AddHeight(v)
 	return _u
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_u *ClipUpdate) SetNillableUpdatedAt(v *time.Time) *ClipUpdate {
-	if v != nil {
-		_u.SetUpdatedAt(*v)
-	}
+// ClearHeight clears the value of the "height" field.
+func (_u *ClipUpdateOne) ClearHeight() *ClipUpdateOne {
+	_u.mutation.ClearHeight()
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ClipUpdate) SetDeletedAt(v time.Time) *ClipUpdate {
-	_u.mutation.SetDeletedAt(v)
+// SetFadeDuration sets the "fade_duration" field.
+func (_u *ClipUpdateOne) SetFadeDuration(v int) *ClipUpdateOne {
+	_u.mutation.ResetFadeDuration()
+	_u.mutation.SetFadeDuration(v)
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ClipUpdate) SetNillableDeletedAt(v *time.Time) *ClipUpdate {
+// SetNillableFadeDuration sets the "fade_duration" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableFadeDuration(v *int) *ClipUpdateOne {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetFadeDuration(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ClipUpdate) ClearDeletedAt() *ClipUpdate {
-	_u.mutation.ClearDeletedAt()
+// AddFadeDuration adds value to the "fade_duration" field.
+func (_u *ClipUpdateOne) AddFadeDuration(v int) *ClipUpdateOne {
+	_u.mutation.AddFadeDuration(v)
 	return _u
 }
 
-// Mutation returns the ClipMutation object of the builder.
-func (_u *ClipUpdate) Mutation() *ClipMutation {
-	return _u.mutation
+// ClearFadeDuration clears the value of the "fade_duration" field.
+func (_u *ClipUpdateOne) ClearFadeDuration() *ClipUpdateOne {
+	_u.mutation.ClearFadeDuration()
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *ClipUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetFadeInDuration sets the "fade_in_duration" field.
+func (_u *ClipUpdateOne) SetFadeInDuration(v float64) *ClipUpdateOne {
+	_u.mutation.ResetFadeInDuration()
+	_u.mutation.SetFadeInDuration(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *ClipUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableFadeInDuration sets the "fade_in_duration" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableFadeInDuration(v *float64) *ClipUpdateOne {
+	if v != nil {
+		_u.SetFadeInDuration(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *ClipUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddFadeInDuration adds value to the "fade_in_duration" field.
+func (_u *ClipUpdateOne) AddFadeInDuration(v float64) *ClipUpdateOne {
+	_u.mutation.AddFadeInDuration(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *ClipUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// ClearFadeInDuration clears the value of the "fade_in_duration" field.
+func (_u *ClipUpdateOne) ClearFadeInDuration() *ClipUpdateOne {
+	_u.mutation.ClearFadeInDuration()
+	return _u
 }
 
-func (_u *ClipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(clip.Table, clip.Columns, sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Hash(); ok {
-		_spec.SetField(clip.FieldHash, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.AudioPath(); ok {
-		_spec.SetField(clip.FieldAudioPath, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.VideoPath(); ok {
-		_spec.SetField(clip.FieldVideoPath, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.GenCaptionsPath(); ok {
-		_spec.SetField(clip.FieldGenCaptionsPath, field.TypeString, value)
-	}
-	if _u.mutation.GenCaptionsPathCleared() {
-		_spec.ClearField(clip.FieldGenCaptionsPath, field.TypeString)
-	}
-	if value, ok := _u.mutation.GenRawVideoPath(); ok {
-		_spec.SetField(clip.FieldGenRawVideoPath, field.TypeString, value)
-	}
-	if _u.mutation.GenRawVideoPathCleared() {
-		_spec.ClearField(clip.FieldGenRawVideoPath, field.TypeString)
-	}
-	if value, ok := _u.mutation.GenTrimmedVideoPath(); ok {
-		_spec.SetField(clip.FieldGenTrimmedVideoPath, field.TypeString, value)
-	}
-	if _u.mutation.GenTrimmedVideoPathCleared() {
-		_spec.ClearField(clip.FieldGenTrimmedVideoPath, field.TypeString)
-	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(clip.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(clip.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(clip.FieldDeletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(clip.FieldDeletedAt, field.TypeTime)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{clip.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
+// SetAudioCensor sets the "audio_censor" field.
+func (_u *ClipUpdateOne) SetAudioCensor(v *model.AudioCensor) *ClipUpdateOne {
+	_u.mutation.SetAudioCensor(v)
+	return _u
 }
 
-// ClipUpdateOne is the builder for updating a single Clip entity.
-type ClipUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ClipMutation
+// ClearAudioCensor clears the value of the "audio_censor" field.
+func (_u *ClipUpdateOne) ClearAudioCensor() *ClipUpdateOne {
+	_u.mutation.ClearAudioCensor()
+	return _u
 }
 
-// SetHash sets the "hash" field.
-func (_u *ClipUpdateOne) SetHash(v string) *ClipUpdateOne {
-	_u.mutation.SetHash(v)
+// SetLoudness sets the "loudness" field.
+func (_u *ClipUpdateOne) SetLoudness(v *model.ClipLoudness) *ClipUpdateOne {
+	_u.mutation.SetLoudness(v)
 	return _u
 }
 
-// SetNillableHash sets the "hash" field if the given value is not nil.
-func (_u *ClipUpdateOne) SetNillableHash(v *string) *ClipUpdateOne {
-	if v != nil {
-		_u.SetHash(*v)
-	}
+// ClearLoudness clears the value of the "loudness" field.
+func (_u *ClipUpdateOne) ClearLoudness() *ClipUpdateOne {
+	_u.mutation.ClearLoudness()
 	return _u
 }
 
-// SetAudioPath sets the "audio_path" field.
-func (_u *ClipUpdateOne) SetAudioPath(v string) *ClipUpdateOne {
-	_u.mutation.SetAudioPath(v)
+// SetStatus sets the "status" field.
+func (_u *ClipUpdateOne) SetStatus(v model.ClipStatus) *ClipUpdateOne {
+	_u.mutation.SetStatus(v)
 	return _u
 }
 
-// SetNillableAudioPath sets the "audio_path" field if the given value is not nil.
-func (_u *ClipUpdateOne) SetNillableAudioPath(v *string) *ClipUpdateOne {
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableStatus(v *model.ClipStatus) *ClipUpdateOne {
 	if v != nil {
-		_u.SetAudioPath(*v)
+		_u.SetStatus(*v)
 	}
 	return _u
 }
 
-// SetVideoPath sets the "video_path" field.
-func (_u *ClipUpdateOne) SetVideoPath(v string) *ClipUpdateOne {
-	_u.mutation.SetVideoPath(v)
+// SetStage sets the "stage" field.
+func (_u *ClipUpdateOne) SetStage(v model.ClipStage) *ClipUpdateOne {
+	_u.mutation.SetStage(v)
 	return _u
 }
 
-// SetNillableVideoPath sets the "video_path" field if the given value is not nil.
-func (_u *ClipUpdateOne) SetNillableVideoPath(v *string) *ClipUpdateOne {
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableStage(v *model.ClipStage) *ClipUpdateOne {
 	if v != nil {
-		_u.SetVideoPath(*v)
+		_u.SetStage(*v)
 	}
 	return _u
 }
 
-// SetGenCaptionsPath sets the "gen_captions_path" field.
-func (_u *ClipUpdateOne) SetGenCaptionsPath(v string) *ClipUpdateOne {
-	_u.mutation.SetGenCaptionsPath(v)
+// SetLastError sets the "last_error" field.
+func (_u *ClipUpdateOne) SetLastError(v string) *ClipUpdateOne {
+	_u.mutation.SetLastError(v)
 	return _u
 }
 
-// SetNillableGenCaptionsPath sets the "gen_captions_path" field if the given value is not nil.
-func (_u *ClipUpdateOne) SetNillableGenCaptionsPath(v *string) *ClipUpdateOne {
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableLastError(v *string) *ClipUpdateOne {
 	if v != nil {
-		_u.SetGenCaptionsPath(*v)
+		_u.SetLastError(*v)
 	}
 	return _u
 }
 
-// ClearGenCaptionsPath clears the value of the "gen_captions_path" field.
-func (_u *ClipUpdateOne) ClearGenCaptionsPath() *ClipUpdateOne {
-	_u.mutation.ClearGenCaptionsPath()
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ClipUpdateOne) ClearLastError() *ClipUpdateOne {
+	_u.mutation.ClearLastError()
 	return _u
 }
 
-// SetGenRawVideoPath sets the "gen_raw_video_path" field.
-func (_u *ClipUpdateOne) SetGenRawVideoPath(v string) *ClipUpdateOne {
-	_u.mutation.SetGenRawVideoPath(v)
+// SetAttempts sets the "attempts" field.
+func (_u *ClipUpdateOne) SetAttempts(v int) *ClipUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
 	return _u
 }
 
-// SetNillableGenRawVideoPath sets the "gen_raw_video_path" field if the given value is not nil.
-func (_u *ClipUpdateOne) SetNillableGenRawVideoPath(v *string) *ClipUpdateOne {
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableAttempts(v *int) *ClipUpdateOne {
 	if v != nil {
-		_u.SetGenRawVideoPath(*v)
+		_u.SetAttempts(*v)
 	}
 	return _u
 }
 
-// ClearGenRawVideoPath clears the value of the "gen_raw_video_path" field.
-func (_u *ClipUpdateOne) ClearGenRawVideoPath() *ClipUpdateOne {
-	_u.mutation.ClearGenRawVideoPath()
-	return _u
-}
-
-// SetGenTrimmedVideoPath sets the "gen_trimmed_video_path" field.
-func (_u *ClipUpdateOne) SetGenTrimmedVideoPath(v string) *ClipUpdateOne {
-	_u.mutation.SetGenTrimmedVideoPath(v)
+// AddAttempts adds value to the "attempts" field.
+func (_u *ClipUpdateOne) AddAttempts(v int) *ClipUpdateOne {
+	_u.mutation.AddAttempts(v)
 	return _u
 }
 
-// SetNillableGenTrimmedVideoPath sets the "gen_trimmed_video_path" field if the given value is not nil.
-func (_u *ClipUpdateOne) SetNillableGenTrimmedVideoPath(v *string) *ClipUpdateOne {
-	if v != nil {
-		_u.SetGenTrimmedVideoPath(*v)
-	}
+// SetStageTimestamps sets the "stage_timestamps" field.
+func (_u *ClipUpdateOne) SetStageTimestamps(v map[model.ClipStage]*model.StageTimestamps) *ClipUpdateOne {
+	_u.mutation.SetStageTimestamps(v)
 	return _u
 }
 
-// ClearGenTrimmedVideoPath clears the value of the "gen_trimmed_video_path" field.
-func (_u *ClipUpdateOne) ClearGenTrimmedVideoPath() *ClipUpdateOne {
-	_u.mutation.ClearGenTrimmedVideoPath()
+// ClearStageTimestamps clears the value of the "stage_timestamps" field.
+func (_u *ClipUpdateOne) ClearStageTimestamps() *ClipUpdateOne {
+	_u.mutation.ClearStageTimestamps()
 	return _u
 }
 
@@ -428,11 +1929,33 @@ func (_u *ClipUpdateOne) ClearDeletedAt() *ClipUpdateOne {
 	return _u
 }
 
+// SetAudio sets the "audio" edge to the Audio entity.
+func (_u *ClipUpdateOne) SetAudio(v *Audio) *ClipUpdateOne {
+	return _u.SetAudioID(v.ID)
+}
+
+// SetBackgroundVideo sets the "background_video" edge to the BackgroundVideo entity.
+func (_u *ClipUpdateOne) SetBackgroundVideo(v *BackgroundVideo) *ClipUpdateOne {
+	return _u.SetBackgroundVideoID(v.ID)
+}
+
 // Mutation returns the ClipMutation object of the builder.
 func (_u *ClipUpdateOne) Mutation() *ClipMutation {
 	return _u.mutation
 }
 
+// ClearAudio clears the "audio" edge to the Audio entity.
+func (_u *ClipUpdateOne) ClearAudio() *ClipUpdateOne {
+	_u.mutation.ClearAudio()
+	return _u
+}
+
+// ClearBackgroundVideo clears the "background_video" edge to the BackgroundVideo entity.
+func (_u *ClipUpdateOne) ClearBackgroundVideo() *ClipUpdateOne {
+	_u.mutation.ClearBackgroundVideo()
+	return _u
+}
+
 // Where appends a list predicates to the ClipUpdate builder.
 func (_u *ClipUpdateOne) Where(ps ...predicate.Clip) *ClipUpdateOne {
 	_u.mutation.Where(ps...)
@@ -473,7 +1996,30 @@ func (_u *ClipUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClipUpdateOne) check() error {
+	if v, ok := _u.mutation.CaptionStyle(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "caption_style", err: fmt.Errorf(`ent: validator failed for field "Clip.caption_style": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := clip.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Clip.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stage(); ok {
+		if err := clip.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Clip.stage": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *ClipUpdateOne) sqlSave(ctx context.Context) (_node *Clip, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(clip.Table, clip.Columns, sqlgraph.NewFieldSpec(clip.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -508,6 +2054,47 @@ func (_u *ClipUpdateOne) sqlSave(ctx context.Context) (_node *Clip, err error) {
 	if value, ok := _u.mutation.VideoPath(); ok {
 		_spec.SetField(clip.FieldVideoPath, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Artist(); ok {
+		_spec.SetField(clip.FieldArtist, field.TypeString, value)
+	}
+	if _u.mutation.ArtistCleared() {
+		_spec.ClearField(clip.FieldArtist, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(clip.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(clip.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Album(); ok {
+		_spec.SetField(clip.FieldAlbum, field.TypeString, value)
+	}
+	if _u.mutation.AlbumCleared() {
+		_spec.ClearField(clip.FieldAlbum, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(clip.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(clip.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.BioPath(); ok {
+		_spec.SetField(clip.FieldBioPath, field.TypeString, value)
+	}
+	if _u.mutation.BioPathCleared() {
+		_spec.ClearField(clip.FieldBioPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hashtags(); ok {
+		_spec.SetField(clip.FieldHashtags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHashtags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clip.FieldHashtags, value)
+		})
+	}
+	if _u.mutation.HashtagsCleared() {
+		_spec.ClearField(clip.FieldHashtags, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.GenCaptionsPath(); ok {
 		_spec.SetField(clip.FieldGenCaptionsPath, field.TypeString, value)
 	}
@@ -526,6 +2113,161 @@ func (_u *ClipUpdateOne) sqlSave(ctx context.Context) (_node *Clip, err error) {
 	if _u.mutation.GenTrimmedVideoPathCleared() {
 		_spec.ClearField(clip.FieldGenTrimmedVideoPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(clip.FieldStartTime, field.TypeString, value)
+	}
+	if _u.mutation.StartTimeCleared() {
+		_spec.ClearField(clip.FieldStartTime, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(clip.FieldEndTime, field.TypeString, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(clip.FieldEndTime, field.TypeString)
+	}
+	if value, ok := _u.mutation.TranscriptPath(); ok {
+		_spec.SetField(clip.FieldTranscriptPath, field.TypeString, value)
+	}
+	if _u.mutation.TranscriptPathCleared() {
+		_spec.ClearField(clip.FieldTranscriptPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubtitlesPath(); ok {
+		_spec.SetField(clip.FieldSubtitlesPath, field.TypeString, value)
+	}
+	if _u.mutation.SubtitlesPathCleared() {
+		_spec.ClearField(clip.FieldSubtitlesPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.LyricsPath(); ok {
+		_spec.SetField(clip.FieldLyricsPath, field.TypeString, value)
+	}
+	if _u.mutation.LyricsPathCleared() {
+		_spec.ClearField(clip.FieldLyricsPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.LyricsAlignment(); ok {
+		_spec.SetField(clip.FieldLyricsAlignment, field.TypeJSON, value)
+	}
+	if _u.mutation.LyricsAlignmentCleared() {
+		_spec.ClearField(clip.FieldLyricsAlignment, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CensorLog(); ok {
+		_spec.SetField(clip.FieldCensorLog, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCensorLog(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clip.FieldCensorLog, value)
+		})
+	}
+	if _u.mutation.CensorLogCleared() {
+		_spec.ClearField(clip.FieldCensorLog, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CaptionsReviewedAt(); ok {
+		_spec.SetField(clip.FieldCaptionsReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CaptionsReviewedAtCleared() {
+		_spec.ClearField(clip.FieldCaptionsReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CaptionStyle(); ok {
+		_spec.SetField(clip.FieldCaptionStyle, field.TypeJSON, value)
+	}
+	if _u.mutation.CaptionStyleCleared() {
+		_spec.ClearField(clip.FieldCaptionStyle, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SegmentPlan(); ok {
+		_spec.SetField(clip.FieldSegmentPlan, field.TypeJSON, value)
+	}
+	if _u.mutation.SegmentPlanCleared() {
+		_spec.ClearField(clip.FieldSegmentPlan, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(clip.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(clip.FieldSeed, field.TypeInt64, value)
+	}
+	if _u.mutation.SeedCleared() {
+		_spec.ClearField(clip.FieldSeed, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.BackgroundStart(); ok {
+		_spec.SetField(clip.FieldBackgroundStart, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBackgroundStart(); ok {
+		_spec.AddField(clip.FieldBackgroundStart, field.TypeFloat64, value)
+	}
+	if _u.mutation.BackgroundStartCleared() {
+		_spec.ClearField(clip.FieldBackgroundStart, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(clip.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(clip.FieldWidth, field.TypeInt, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(clip.FieldWidth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(clip.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(clip.FieldHeight, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(clip.FieldHeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FadeDuration(); ok {
+		_spec.SetField(clip.FieldFadeDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFadeDuration(); ok {
+		_spec.AddField(clip.FieldFadeDuration, field.TypeInt, value)
+	}
+	if _u.mutation.FadeDurationCleared() {
+		_spec.ClearField(clip.FieldFadeDuration, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FadeInDuration(); ok {
+		_spec.SetField(clip.FieldFadeInDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFadeInDuration(); ok {
+		_spec.AddField(clip.FieldFadeInDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.FadeInDurationCleared() {
+		_spec.ClearField(clip.FieldFadeInDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AudioCensor(); ok {
+		_spec.SetField(clip.FieldAudioCensor, field.TypeJSON, value)
+	}
+	if _u.mutation.AudioCensorCleared() {
+		_spec.ClearField(clip.FieldAudioCensor, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Loudness(); ok {
+		_spec.SetField(clip.FieldLoudness, field.TypeJSON, value)
+	}
+	if _u.mutation.LoudnessCleared() {
+		_spec.ClearField(clip.FieldLoudness, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(clip.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(clip.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(clip.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(clip.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(clip.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(clip.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StageTimestamps(); ok {
+		_spec.SetField(clip.FieldStageTimestamps, field.TypeJSON, value)
+	}
+	if _u.mutation.StageTimestampsCleared() {
+		_spec.ClearField(clip.FieldStageTimestamps, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(clip.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -538,6 +2280,64 @@ func (_u *ClipUpdateOne) sqlSave(ctx context.Context) (_node *Clip, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(clip.FieldDeletedAt, field.TypeTime)
 	}
+	if _u.mutation.AudioCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.AudioTable,
+			Columns: []string{clip.AudioColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AudioIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.AudioTable,
+			Columns: []string{clip.AudioColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(audio.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BackgroundVideoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.BackgroundVideoTable,
+			Columns: []string{clip.BackgroundVideoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackgroundVideoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clip.BackgroundVideoTable,
+			Columns: []string{clip.BackgroundVideoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backgroundvideo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Clip{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,6 +9,30 @@ import (
 	"github.com/sam-laister/tiktok-creator/ent"
 )
 
+// The AudioFunc type is an adapter to allow the use of ordinary
+// function as Audio mutator.
+type AudioFunc func(context.Context, *ent.AudioMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AudioFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AudioMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AudioMutation", m)
+}
+
+// The BackgroundVideoFunc type is an adapter to allow the use of ordinary
+// function as BackgroundVideo mutator.
+type BackgroundVideoFunc func(context.Context, *ent.BackgroundVideoMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackgroundVideoFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackgroundVideoMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackgroundVideoMutation", m)
+}
+
 // The ClipFunc type is an adapter to allow the use of ordinary
 // function as Clip mutator.
 type ClipFunc func(context.Context, *ent.ClipMutation) (ent.Value, error)
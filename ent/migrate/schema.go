@@ -8,30 +8,128 @@ import (
 )
 
 var (
+	// AudiosColumns holds the columns for the "audios" table.
+	AudiosColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "duration", Type: field.TypeFloat64},
+		{Name: "codec", Type: field.TypeString},
+		{Name: "sample_rate", Type: field.TypeInt},
+		{Name: "channels", Type: field.TypeInt},
+		{Name: "loudness", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "scanned_at", Type: field.TypeTime},
+	}
+	// AudiosTable holds the schema information for the "audios" table.
+	AudiosTable = &schema.Table{
+		Name:       "audios",
+		Columns:    AudiosColumns,
+		PrimaryKey: []*schema.Column{AudiosColumns[0]},
+	}
+	// BackgroundVideosColumns holds the columns for the "background_videos" table.
+	BackgroundVideosColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString, Unique: true},
+		{Name: "weight", Type: field.TypeFloat64, Default: 1},
+		{Name: "use_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hash", Type: field.TypeString, Nullable: true},
+		{Name: "duration", Type: field.TypeFloat64, Nullable: true},
+		{Name: "codec", Type: field.TypeString, Nullable: true},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "fps", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "scanned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BackgroundVideosTable holds the schema information for the "background_videos" table.
+	BackgroundVideosTable = &schema.Table{
+		Name:       "background_videos",
+		Columns:    BackgroundVideosColumns,
+		PrimaryKey: []*schema.Column{BackgroundVideosColumns[0]},
+	}
 	// ClipsColumns holds the columns for the "clips" table.
 	ClipsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "hash", Type: field.TypeString},
 		{Name: "audio_path", Type: field.TypeString},
 		{Name: "video_path", Type: field.TypeString},
+		{Name: "artist", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "album", Type: field.TypeString, Nullable: true},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "bio_path", Type: field.TypeString, Nullable: true},
+		{Name: "hashtags", Type: field.TypeJSON, Nullable: true},
 		{Name: "gen_captions_path", Type: field.TypeString, Nullable: true},
 		{Name: "gen_raw_video_path", Type: field.TypeString, Nullable: true},
 		{Name: "gen_trimmed_video_path", Type: field.TypeString, Nullable: true},
+		{Name: "start_time", Type: field.TypeString, Nullable: true},
+		{Name: "end_time", Type: field.TypeString, Nullable: true},
+		{Name: "transcript_path", Type: field.TypeString, Nullable: true},
+		{Name: "subtitles_path", Type: field.TypeString, Nullable: true},
+		{Name: "lyrics_path", Type: field.TypeString, Nullable: true},
+		{Name: "lyrics_alignment", Type: field.TypeJSON, Nullable: true},
+		{Name: "censor_log", Type: field.TypeJSON, Nullable: true},
+		{Name: "captions_reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "caption_style", Type: field.TypeJSON, Nullable: true},
+		{Name: "segment_plan", Type: field.TypeJSON, Nullable: true},
+		{Name: "seed", Type: field.TypeInt64, Nullable: true},
+		{Name: "background_start", Type: field.TypeFloat64, Nullable: true},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "fade_duration", Type: field.TypeInt, Nullable: true},
+		{Name: "fade_in_duration", Type: field.TypeFloat64, Nullable: true},
+		{Name: "audio_censor", Type: field.TypeJSON, Nullable: true},
+		{Name: "loudness", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "failed", "completed"}, Default: "pending"},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"captions", "burn", "trim", "bio"}, Default: "captions"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "stage_timestamps", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "audio_id", Type: field.TypeInt, Nullable: true},
+		{Name: "background_video_id", Type: field.TypeInt, Nullable: true},
 	}
 	// ClipsTable holds the schema information for the "clips" table.
 	ClipsTable = &schema.Table{
 		Name:       "clips",
 		Columns:    ClipsColumns,
 		PrimaryKey: []*schema.Column{ClipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "clips_audios_clips",
+				Columns:    []*schema.Column{ClipsColumns[39]},
+				RefColumns: []*schema.Column{AudiosColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "clips_background_videos_clips",
+				Columns:    []*schema.Column{ClipsColumns[40]},
+				RefColumns: []*schema.Column{BackgroundVideosColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clip_status",
+				Unique:  false,
+				Columns: []*schema.Column{ClipsColumns[31]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AudiosTable,
+		BackgroundVideosTable,
 		ClipsTable,
 	}
 )
 
 func init() {
+	ClipsTable.ForeignKeys[0].RefTable = AudiosTable
+	ClipsTable.ForeignKeys[1].RefTable = BackgroundVideosTable
 }
@@ -11,8 +11,11 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
 	"github.com/sam-laister/tiktok-creator/ent/predicate"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 const (
@@ -24,41 +27,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeClip = "Clip"
+	TypeAudio           = "Audio"
+	TypeBackgroundVideo = "BackgroundVideo"
+	TypeClip            = "Clip"
 )
 
-// ClipMutation represents an operation that mutates the Clip nodes in the graph.
-type ClipMutation struct {
+// AudioMutation represents an operation that mutates the Audio nodes in the graph.
+type AudioMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *int
-	hash                   *string
-	audio_path             *string
-	video_path             *string
-	gen_captions_path      *string
-	gen_raw_video_path     *string
-	gen_trimmed_video_path *string
-	created_at             *time.Time
-	updated_at             *time.Time
-	deleted_at             *time.Time
-	clearedFields          map[string]struct{}
-	done                   bool
-	oldValue               func(context.Context) (*Clip, error)
-	predicates             []predicate.Clip
+	op             Op
+	typ            string
+	id             *int
+	hash           *string
+	_path          *string
+	duration       *float64
+	addduration    *float64
+	codec          *string
+	sample_rate    *int
+	addsample_rate *int
+	channels       *int
+	addchannels    *int
+	loudness       *float64
+	addloudness    *float64
+	tags           *map[string]string
+	scanned_at     *time.Time
+	clearedFields  map[string]struct{}
+	clips          map[int]struct{}
+	removedclips   map[int]struct{}
+	clearedclips   bool
+	done           bool
+	oldValue       func(context.Context) (*Audio, error)
+	predicates     []predicate.Audio
 }
 
-var _ ent.Mutation = (*ClipMutation)(nil)
+var _ ent.Mutation = (*AudioMutation)(nil)
 
-// clipOption allows management of the mutation configuration using functional options.
-type clipOption func(*ClipMutation)
+// audioOption allows management of the mutation configuration using functional options.
+type audioOption func(*AudioMutation)
 
-// newClipMutation creates new mutation for the Clip entity.
-func newClipMutation(c config, op Op, opts ...clipOption) *ClipMutation {
-	m := &ClipMutation{
+// newAudioMutation creates new mutation for the Audio entity.
+func newAudioMutation(c config, op Op, opts ...audioOption) *AudioMutation {
+	m := &AudioMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeClip,
+		typ:           TypeAudio,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -67,20 +79,20 @@ func newClipMutation(c config, op Op, opts ...clipOption) *ClipMutation {
 	return m
 }
 
-// withClipID sets the ID field of the mutation.
-func withClipID(id int) clipOption {
-	return func(m *ClipMutation) {
+// withAudioID sets the ID field of the mutation.
+func withAudioID(id int) audioOption {
+	return func(m *AudioMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Clip
+			value *Audio
 		)
-		m.oldValue = func(ctx context.Context) (*Clip, error) {
+		m.oldValue = func(ctx context.Context) (*Audio, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Clip.Get(ctx, id)
+					value, err = m.Client().Audio.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -89,10 +101,10 @@ func withClipID(id int) clipOption {
 	}
 }
 
-// withClip sets the old Clip of the mutation.
-func withClip(node *Clip) clipOption {
-	return func(m *ClipMutation) {
-		m.oldValue = func(context.Context) (*Clip, error) {
+// withAudio sets the old Audio of the mutation.
+func withAudio(node *Audio) audioOption {
+	return func(m *AudioMutation) {
+		m.oldValue = func(context.Context) (*Audio, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -101,7 +113,7 @@ func withClip(node *Clip) clipOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ClipMutation) Client() *Client {
+func (m AudioMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -109,7 +121,7 @@ func (m ClipMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ClipMutation) Tx() (*Tx, error) {
+func (m AudioMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -120,7 +132,7 @@ func (m ClipMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ClipMutation) ID() (id int, exists bool) {
+func (m *AudioMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -131,7 +143,7 @@ func (m *ClipMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ClipMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AudioMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -140,19 +152,19 @@ func (m *ClipMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Clip.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Audio.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetHash sets the "hash" field.
-func (m *ClipMutation) SetHash(s string) {
+func (m *AudioMutation) SetHash(s string) {
 	m.hash = &s
 }
 
 // Hash returns the value of the "hash" field in the mutation.
-func (m *ClipMutation) Hash() (r string, exists bool) {
+func (m *AudioMutation) Hash() (r string, exists bool) {
 	v := m.hash
 	if v == nil {
 		return
@@ -160,10 +172,10 @@ func (m *ClipMutation) Hash() (r string, exists bool) {
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldHash(ctx context.Context) (v string, err error) {
+func (m *AudioMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
@@ -178,373 +190,4594 @@ func (m *ClipMutation) OldHash(ctx context.Context) (v string, err error) {
 }
 
 // ResetHash resets all changes to the "hash" field.
-func (m *ClipMutation) ResetHash() {
+func (m *AudioMutation) ResetHash() {
 	m.hash = nil
 }
 
-// SetAudioPath sets the "audio_path" field.
-func (m *ClipMutation) SetAudioPath(s string) {
-	m.audio_path = &s
+// SetPath sets the "path" field.
+func (m *AudioMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// AudioPath returns the value of the "audio_path" field in the mutation.
-func (m *ClipMutation) AudioPath() (r string, exists bool) {
-	v := m.audio_path
+// Path returns the value of the "path" field in the mutation.
+func (m *AudioMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAudioPath returns the old "audio_path" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldAudioPath(ctx context.Context) (v string, err error) {
+func (m *AudioMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAudioPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAudioPath requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAudioPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.AudioPath, nil
+	return oldValue.Path, nil
 }
 
-// ResetAudioPath resets all changes to the "audio_path" field.
-func (m *ClipMutation) ResetAudioPath() {
-	m.audio_path = nil
+// ResetPath resets all changes to the "path" field.
+func (m *AudioMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetVideoPath sets the "video_path" field.
-func (m *ClipMutation) SetVideoPath(s string) {
-	m.video_path = &s
+// SetDuration sets the "duration" field.
+func (m *AudioMutation) SetDuration(f float64) {
+	m.duration = &f
+	m.addduration = nil
 }
 
-// VideoPath returns the value of the "video_path" field in the mutation.
-func (m *ClipMutation) VideoPath() (r string, exists bool) {
-	v := m.video_path
+// Duration returns the value of the "duration" field in the mutation.
+func (m *AudioMutation) Duration() (r float64, exists bool) {
+	v := m.duration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVideoPath returns the old "video_path" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldDuration returns the old "duration" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldVideoPath(ctx context.Context) (v string, err error) {
+func (m *AudioMutation) OldDuration(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVideoPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVideoPath requires an ID field in the mutation")
+		return v, errors.New("OldDuration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVideoPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
 	}
-	return oldValue.VideoPath, nil
+	return oldValue.Duration, nil
 }
 
-// ResetVideoPath resets all changes to the "video_path" field.
-func (m *ClipMutation) ResetVideoPath() {
-	m.video_path = nil
+// AddDuration adds f to the "duration" field.
+func (m *AudioMutation) AddDuration(f float64) {
+	if m.addduration != nil {
+		*m.addduration += f
+	} else {
+		m.addduration = &f
+	}
 }
 
-// SetGenCaptionsPath sets the "gen_captions_path" field.
-func (m *ClipMutation) SetGenCaptionsPath(s string) {
-	m.gen_captions_path = &s
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *AudioMutation) AddedDuration() (r float64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GenCaptionsPath returns the value of the "gen_captions_path" field in the mutation.
-func (m *ClipMutation) GenCaptionsPath() (r string, exists bool) {
-	v := m.gen_captions_path
+// ResetDuration resets all changes to the "duration" field.
+func (m *AudioMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+}
+
+// SetCodec sets the "codec" field.
+func (m *AudioMutation) SetCodec(s string) {
+	m.codec = &s
+}
+
+// Codec returns the value of the "codec" field in the mutation.
+func (m *AudioMutation) Codec() (r string, exists bool) {
+	v := m.codec
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenCaptionsPath returns the old "gen_captions_path" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldCodec returns the old "codec" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldGenCaptionsPath(ctx context.Context) (v *string, err error) {
+func (m *AudioMutation) OldCodec(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenCaptionsPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldCodec is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenCaptionsPath requires an ID field in the mutation")
+		return v, errors.New("OldCodec requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenCaptionsPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldCodec: %w", err)
 	}
-	return oldValue.GenCaptionsPath, nil
-}
-
-// ClearGenCaptionsPath clears the value of the "gen_captions_path" field.
-func (m *ClipMutation) ClearGenCaptionsPath() {
-	m.gen_captions_path = nil
-	m.clearedFields[clip.FieldGenCaptionsPath] = struct{}{}
-}
-
-// GenCaptionsPathCleared returns if the "gen_captions_path" field was cleared in this mutation.
-func (m *ClipMutation) GenCaptionsPathCleared() bool {
-	_, ok := m.clearedFields[clip.FieldGenCaptionsPath]
-	return ok
+	return oldValue.Codec, nil
 }
 
-// ResetGenCaptionsPath resets all changes to the "gen_captions_path" field.
-func (m *ClipMutation) ResetGenCaptionsPath() {
-	m.gen_captions_path = nil
-	delete(m.clearedFields, clip.FieldGenCaptionsPath)
+// ResetCodec resets all changes to the "codec" field.
+func (m *AudioMutation) ResetCodec() {
+	m.codec = nil
 }
 
-// SetGenRawVideoPath sets the "gen_raw_video_path" field.
-func (m *ClipMutation) SetGenRawVideoPath(s string) {
-	m.gen_raw_video_path = &s
+// SetSampleRate sets the "sample_rate" field.
+func (m *AudioMutation) SetSampleRate(i int) {
+	m.sample_rate = &i
+	m.addsample_rate = nil
 }
 
-// GenRawVideoPath returns the value of the "gen_raw_video_path" field in the mutation.
-func (m *ClipMutation) GenRawVideoPath() (r string, exists bool) {
-	v := m.gen_raw_video_path
+// SampleRate returns the value of the "sample_rate" field in the mutation.
+func (m *AudioMutation) SampleRate() (r int, exists bool) {
+	v := m.sample_rate
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenRawVideoPath returns the old "gen_raw_video_path" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldSampleRate returns the old "sample_rate" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldGenRawVideoPath(ctx context.Context) (v *string, err error) {
+func (m *AudioMutation) OldSampleRate(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenRawVideoPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldSampleRate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenRawVideoPath requires an ID field in the mutation")
+		return v, errors.New("OldSampleRate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenRawVideoPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldSampleRate: %w", err)
 	}
-	return oldValue.GenRawVideoPath, nil
+	return oldValue.SampleRate, nil
 }
 
-// ClearGenRawVideoPath clears the value of the "gen_raw_video_path" field.
-func (m *ClipMutation) ClearGenRawVideoPath() {
-	m.gen_raw_video_path = nil
-	m.clearedFields[clip.FieldGenRawVideoPath] = struct{}{}
+// AddSampleRate adds i to the "sample_rate" field.
+func (m *AudioMutation) AddSampleRate(i int) {
+	if m.addsample_rate != nil {
+		*m.addsample_rate += i
+	} else {
+		m.addsample_rate = &i
+	}
 }
 
-// GenRawVideoPathCleared returns if the "gen_raw_video_path" field was cleared in this mutation.
-func (m *ClipMutation) GenRawVideoPathCleared() bool {
-	_, ok := m.clearedFields[clip.FieldGenRawVideoPath]
-	return ok
+// AddedSampleRate returns the value that was added to the "sample_rate" field in this mutation.
+func (m *AudioMutation) AddedSampleRate() (r int, exists bool) {
+	v := m.addsample_rate
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetGenRawVideoPath resets all changes to the "gen_raw_video_path" field.
-func (m *ClipMutation) ResetGenRawVideoPath() {
-	m.gen_raw_video_path = nil
-	delete(m.clearedFields, clip.FieldGenRawVideoPath)
+// ResetSampleRate resets all changes to the "sample_rate" field.
+func (m *AudioMutation) ResetSampleRate() {
+	m.sample_rate = nil
+	m.addsample_rate = nil
 }
 
-// SetGenTrimmedVideoPath sets the "gen_trimmed_video_path" field.
-func (m *ClipMutation) SetGenTrimmedVideoPath(s string) {
-	m.gen_trimmed_video_path = &s
+// SetChannels sets the "channels" field.
+func (m *AudioMutation) SetChannels(i int) {
+	m.channels = &i
+	m.addchannels = nil
 }
 
-// GenTrimmedVideoPath returns the value of the "gen_trimmed_video_path" field in the mutation.
-func (m *ClipMutation) GenTrimmedVideoPath() (r string, exists bool) {
-	v := m.gen_trimmed_video_path
+// Channels returns the value of the "channels" field in the mutation.
+func (m *AudioMutation) Channels() (r int, exists bool) {
+	v := m.channels
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenTrimmedVideoPath returns the old "gen_trimmed_video_path" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldChannels returns the old "channels" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldGenTrimmedVideoPath(ctx context.Context) (v *string, err error) {
+func (m *AudioMutation) OldChannels(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenTrimmedVideoPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannels is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenTrimmedVideoPath requires an ID field in the mutation")
+		return v, errors.New("OldChannels requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenTrimmedVideoPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannels: %w", err)
 	}
-	return oldValue.GenTrimmedVideoPath, nil
+	return oldValue.Channels, nil
 }
 
-// ClearGenTrimmedVideoPath clears the value of the "gen_trimmed_video_path" field.
-func (m *ClipMutation) ClearGenTrimmedVideoPath() {
-	m.gen_trimmed_video_path = nil
-	m.clearedFields[clip.FieldGenTrimmedVideoPath] = struct{}{}
+// AddChannels adds i to the "channels" field.
+func (m *AudioMutation) AddChannels(i int) {
+	if m.addchannels != nil {
+		*m.addchannels += i
+	} else {
+		m.addchannels = &i
+	}
 }
 
-// GenTrimmedVideoPathCleared returns if the "gen_trimmed_video_path" field was cleared in this mutation.
-func (m *ClipMutation) GenTrimmedVideoPathCleared() bool {
-	_, ok := m.clearedFields[clip.FieldGenTrimmedVideoPath]
-	return ok
+// AddedChannels returns the value that was added to the "channels" field in this mutation.
+func (m *AudioMutation) AddedChannels() (r int, exists bool) {
+	v := m.addchannels
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetGenTrimmedVideoPath resets all changes to the "gen_trimmed_video_path" field.
-func (m *ClipMutation) ResetGenTrimmedVideoPath() {
-	m.gen_trimmed_video_path = nil
-	delete(m.clearedFields, clip.FieldGenTrimmedVideoPath)
+// ResetChannels resets all changes to the "channels" field.
+func (m *AudioMutation) ResetChannels() {
+	m.channels = nil
+	m.addchannels = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ClipMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLoudness sets the "loudness" field.
+func (m *AudioMutation) SetLoudness(f float64) {
+	m.loudness = &f
+	m.addloudness = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ClipMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Loudness returns the value of the "loudness" field in the mutation.
+func (m *AudioMutation) Loudness() (r float64, exists bool) {
+	v := m.loudness
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldLoudness returns the old "loudness" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AudioMutation) OldLoudness(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLoudness is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLoudness requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLoudness: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Loudness, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ClipMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddLoudness adds f to the "loudness" field.
+func (m *AudioMutation) AddLoudness(f float64) {
+	if m.addloudness != nil {
+		*m.addloudness += f
+	} else {
+		m.addloudness = &f
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ClipMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedLoudness returns the value that was added to the "loudness" field in this mutation.
+func (m *AudioMutation) AddedLoudness() (r float64, exists bool) {
+	v := m.addloudness
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ClipMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ClearLoudness clears the value of the "loudness" field.
+func (m *AudioMutation) ClearLoudness() {
+	m.loudness = nil
+	m.addloudness = nil
+	m.clearedFields[audio.FieldLoudness] = struct{}{}
+}
+
+// LoudnessCleared returns if the "loudness" field was cleared in this mutation.
+func (m *AudioMutation) LoudnessCleared() bool {
+	_, ok := m.clearedFields[audio.FieldLoudness]
+	return ok
+}
+
+// ResetLoudness resets all changes to the "loudness" field.
+func (m *AudioMutation) ResetLoudness() {
+	m.loudness = nil
+	m.addloudness = nil
+	delete(m.clearedFields, audio.FieldLoudness)
+}
+
+// SetTags sets the "tags" field.
+func (m *AudioMutation) SetTags(value map[string]string) {
+	m.tags = &value
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *AudioMutation) Tags() (r map[string]string, exists bool) {
+	v := m.tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldTags returns the old "tags" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AudioMutation) OldTags(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Tags, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ClipMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearTags clears the value of the "tags" field.
+func (m *AudioMutation) ClearTags() {
+	m.tags = nil
+	m.clearedFields[audio.FieldTags] = struct{}{}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ClipMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *AudioMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[audio.FieldTags]
+	return ok
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ClipMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ResetTags resets all changes to the "tags" field.
+func (m *AudioMutation) ResetTags() {
+	m.tags = nil
+	delete(m.clearedFields, audio.FieldTags)
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (m *AudioMutation) SetScannedAt(t time.Time) {
+	m.scanned_at = &t
+}
+
+// ScannedAt returns the value of the "scanned_at" field in the mutation.
+func (m *AudioMutation) ScannedAt() (r time.Time, exists bool) {
+	v := m.scanned_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Clip entity.
-// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// OldScannedAt returns the old "scanned_at" field's value of the Audio entity.
+// If the Audio object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClipMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *AudioMutation) OldScannedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScannedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldScannedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScannedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.ScannedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ClipMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[clip.FieldDeletedAt] = struct{}{}
+// ResetScannedAt resets all changes to the "scanned_at" field.
+func (m *AudioMutation) ResetScannedAt() {
+	m.scanned_at = nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ClipMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[clip.FieldDeletedAt]
-	return ok
+// AddClipIDs adds the "clips" edge to the Clip entity by ids.
+func (m *AudioMutation) AddClipIDs(ids ...int) {
+	if m.clips == nil {
+		m.clips = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.clips[ids[i]] = struct{}{}
+	}
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ClipMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, clip.FieldDeletedAt)
+// ClearClips clears the "clips" edge to the Clip entity.
+func (m *AudioMutation) ClearClips() {
+	m.clearedclips = true
 }
 
-// Where appends a list predicates to the ClipMutation builder.
-func (m *ClipMutation) Where(ps ...predicate.Clip) {
-	m.predicates = append(m.predicates, ps...)
+// ClipsCleared reports if the "clips" edge to the Clip entity was cleared.
+func (m *AudioMutation) ClipsCleared() bool {
+	return m.clearedclips
 }
 
-// WhereP appends storage-level predicates to the ClipMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ClipMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Clip, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// RemoveClipIDs removes the "clips" edge to the Clip entity by IDs.
+func (m *AudioMutation) RemoveClipIDs(ids ...int) {
+	if m.removedclips == nil {
+		m.removedclips = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.clips, ids[i])
+		m.removedclips[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *ClipMutation) Op() Op {
-	return m.op
+// RemovedClips returns the removed IDs of the "clips" edge to the Clip entity.
+func (m *AudioMutation) RemovedClipsIDs() (ids []int) {
+	for id := range m.removedclips {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ClipMutation) SetOp(op Op) {
-	m.op = op
+// ClipsIDs returns the "clips" edge IDs in the mutation.
+func (m *AudioMutation) ClipsIDs() (ids []int) {
+	for id := range m.clips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetClips resets all changes to the "clips" edge.
+func (m *AudioMutation) ResetClips() {
+	m.clips = nil
+	m.clearedclips = false
+	m.removedclips = nil
+}
+
+// Where appends a list predicates to the AudioMutation builder.
+func (m *AudioMutation) Where(ps ...predicate.Audio) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AudioMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AudioMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Audio, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AudioMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AudioMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Audio).
+func (m *AudioMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AudioMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.hash != nil {
+		fields = append(fields, audio.FieldHash)
+	}
+	if m._path != nil {
+		fields = append(fields, audio.FieldPath)
+	}
+	if m.duration != nil {
+		fields = append(fields, audio.FieldDuration)
+	}
+	if m.codec != nil {
+		fields = append(fields, audio.FieldCodec)
+	}
+	if m.sample_rate != nil {
+		fields = append(fields, audio.FieldSampleRate)
+	}
+	if m.channels != nil {
+		fields = append(fields, audio.FieldChannels)
+	}
+	if m.loudness != nil {
+		fields = append(fields, audio.FieldLoudness)
+	}
+	if m.tags != nil {
+		fields = append(fields, audio.FieldTags)
+	}
+	if m.scanned_at != nil {
+		fields = append(fields, audio.FieldScannedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AudioMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case audio.FieldHash:
+		return m.Hash()
+	case audio.FieldPath:
+		return m.Path()
+	case audio.FieldDuration:
+		return m.Duration()
+	case audio.FieldCodec:
+		return m.Codec()
+	case audio.FieldSampleRate:
+		return m.SampleRate()
+	case audio.FieldChannels:
+		return m.Channels()
+	case audio.FieldLoudness:
+		return m.Loudness()
+	case audio.FieldTags:
+		return m.Tags()
+	case audio.FieldScannedAt:
+		return m.ScannedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AudioMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case audio.FieldHash:
+		return m.OldHash(ctx)
+	case audio.FieldPath:
+		return m.OldPath(ctx)
+	case audio.FieldDuration:
+		return m.OldDuration(ctx)
+	case audio.FieldCodec:
+		return m.OldCodec(ctx)
+	case audio.FieldSampleRate:
+		return m.OldSampleRate(ctx)
+	case audio.FieldChannels:
+		return m.OldChannels(ctx)
+	case audio.FieldLoudness:
+		return m.OldLoudness(ctx)
+	case audio.FieldTags:
+		return m.OldTags(ctx)
+	case audio.FieldScannedAt:
+		return m.OldScannedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Audio field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AudioMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case audio.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case audio.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case audio.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case audio.FieldCodec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodec(v)
+		return nil
+	case audio.FieldSampleRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSampleRate(v)
+		return nil
+	case audio.FieldChannels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannels(v)
+		return nil
+	case audio.FieldLoudness:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoudness(v)
+		return nil
+	case audio.FieldTags:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case audio.FieldScannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Audio field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AudioMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration != nil {
+		fields = append(fields, audio.FieldDuration)
+	}
+	if m.addsample_rate != nil {
+		fields = append(fields, audio.FieldSampleRate)
+	}
+	if m.addchannels != nil {
+		fields = append(fields, audio.FieldChannels)
+	}
+	if m.addloudness != nil {
+		fields = append(fields, audio.FieldLoudness)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AudioMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case audio.FieldDuration:
+		return m.AddedDuration()
+	case audio.FieldSampleRate:
+		return m.AddedSampleRate()
+	case audio.FieldChannels:
+		return m.AddedChannels()
+	case audio.FieldLoudness:
+		return m.AddedLoudness()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AudioMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case audio.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	case audio.FieldSampleRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSampleRate(v)
+		return nil
+	case audio.FieldChannels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChannels(v)
+		return nil
+	case audio.FieldLoudness:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLoudness(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Audio numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AudioMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(audio.FieldLoudness) {
+		fields = append(fields, audio.FieldLoudness)
+	}
+	if m.FieldCleared(audio.FieldTags) {
+		fields = append(fields, audio.FieldTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AudioMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AudioMutation) ClearField(name string) error {
+	switch name {
+	case audio.FieldLoudness:
+		m.ClearLoudness()
+		return nil
+	case audio.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Audio nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AudioMutation) ResetField(name string) error {
+	switch name {
+	case audio.FieldHash:
+		m.ResetHash()
+		return nil
+	case audio.FieldPath:
+		m.ResetPath()
+		return nil
+	case audio.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case audio.FieldCodec:
+		m.ResetCodec()
+		return nil
+	case audio.FieldSampleRate:
+		m.ResetSampleRate()
+		return nil
+	case audio.FieldChannels:
+		m.ResetChannels()
+		return nil
+	case audio.FieldLoudness:
+		m.ResetLoudness()
+		return nil
+	case audio.FieldTags:
+		m.ResetTags()
+		return nil
+	case audio.FieldScannedAt:
+		m.ResetScannedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Audio field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AudioMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clips != nil {
+		edges = append(edges, audio.EdgeClips)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AudioMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case audio.EdgeClips:
+		ids := make([]ent.Value, 0, len(m.clips))
+		for id := range m.clips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AudioMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedclips != nil {
+		edges = append(edges, audio.EdgeClips)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AudioMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case audio.EdgeClips:
+		ids := make([]ent.Value, 0, len(m.removedclips))
+		for id := range m.removedclips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AudioMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedclips {
+		edges = append(edges, audio.EdgeClips)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AudioMutation) EdgeCleared(name string) bool {
+	switch name {
+	case audio.EdgeClips:
+		return m.clearedclips
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AudioMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Audio unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AudioMutation) ResetEdge(name string) error {
+	switch name {
+	case audio.EdgeClips:
+		m.ResetClips()
+		return nil
+	}
+	return fmt.Errorf("unknown Audio edge %s", name)
+}
+
+// BackgroundVideoMutation represents an operation that mutates the BackgroundVideo nodes in the graph.
+type BackgroundVideoMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_path         *string
+	weight        *float64
+	addweight     *float64
+	use_count     *int
+	adduse_count  *int
+	last_used_at  *time.Time
+	hash          *string
+	duration      *float64
+	addduration   *float64
+	codec         *string
+	width         *int
+	addwidth      *int
+	height        *int
+	addheight     *int
+	fps           *float64
+	addfps        *float64
+	tags          *map[string]string
+	scanned_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	clips         map[int]struct{}
+	removedclips  map[int]struct{}
+	clearedclips  bool
+	done          bool
+	oldValue      func(context.Context) (*BackgroundVideo, error)
+	predicates    []predicate.BackgroundVideo
+}
+
+var _ ent.Mutation = (*BackgroundVideoMutation)(nil)
+
+// backgroundvideoOption allows management of the mutation configuration using functional options.
+type backgroundvideoOption func(*BackgroundVideoMutation)
+
+// newBackgroundVideoMutation creates new mutation for the BackgroundVideo entity.
+func newBackgroundVideoMutation(c config, op Op, opts ...backgroundvideoOption) *BackgroundVideoMutation {
+	m := &BackgroundVideoMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackgroundVideo,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBackgroundVideoID sets the ID field of the mutation.
+func withBackgroundVideoID(id int) backgroundvideoOption {
+	return func(m *BackgroundVideoMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BackgroundVideo
+		)
+		m.oldValue = func(ctx context.Context) (*BackgroundVideo, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BackgroundVideo.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBackgroundVideo sets the old BackgroundVideo of the mutation.
+func withBackgroundVideo(node *BackgroundVideo) backgroundvideoOption {
+	return func(m *BackgroundVideoMutation) {
+		m.oldValue = func(context.Context) (*BackgroundVideo, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BackgroundVideoMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BackgroundVideoMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BackgroundVideoMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BackgroundVideoMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BackgroundVideo.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPath sets the "path" field.
+func (m *BackgroundVideoMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BackgroundVideoMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BackgroundVideoMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *BackgroundVideoMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *BackgroundVideoMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds f to the "weight" field.
+func (m *BackgroundVideoMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *BackgroundVideoMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *BackgroundVideoMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetUseCount sets the "use_count" field.
+func (m *BackgroundVideoMutation) SetUseCount(i int) {
+	m.use_count = &i
+	m.adduse_count = nil
+}
+
+// UseCount returns the value of the "use_count" field in the mutation.
+func (m *BackgroundVideoMutation) UseCount() (r int, exists bool) {
+	v := m.use_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUseCount returns the old "use_count" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldUseCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUseCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUseCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUseCount: %w", err)
+	}
+	return oldValue.UseCount, nil
+}
+
+// AddUseCount adds i to the "use_count" field.
+func (m *BackgroundVideoMutation) AddUseCount(i int) {
+	if m.adduse_count != nil {
+		*m.adduse_count += i
+	} else {
+		m.adduse_count = &i
+	}
+}
+
+// AddedUseCount returns the value that was added to the "use_count" field in this mutation.
+func (m *BackgroundVideoMutation) AddedUseCount() (r int, exists bool) {
+	v := m.adduse_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUseCount resets all changes to the "use_count" field.
+func (m *BackgroundVideoMutation) ResetUseCount() {
+	m.use_count = nil
+	m.adduse_count = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *BackgroundVideoMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *BackgroundVideoMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *BackgroundVideoMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[backgroundvideo.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *BackgroundVideoMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, backgroundvideo.FieldLastUsedAt)
+}
+
+// SetHash sets the "hash" field.
+func (m *BackgroundVideoMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *BackgroundVideoMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ClearHash clears the value of the "hash" field.
+func (m *BackgroundVideoMutation) ClearHash() {
+	m.hash = nil
+	m.clearedFields[backgroundvideo.FieldHash] = struct{}{}
+}
+
+// HashCleared returns if the "hash" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) HashCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldHash]
+	return ok
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *BackgroundVideoMutation) ResetHash() {
+	m.hash = nil
+	delete(m.clearedFields, backgroundvideo.FieldHash)
+}
+
+// SetDuration sets the "duration" field.
+func (m *BackgroundVideoMutation) SetDuration(f float64) {
+	m.duration = &f
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *BackgroundVideoMutation) Duration() (r float64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldDuration(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds f to the "duration" field.
+func (m *BackgroundVideoMutation) AddDuration(f float64) {
+	if m.addduration != nil {
+		*m.addduration += f
+	} else {
+		m.addduration = &f
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *BackgroundVideoMutation) AddedDuration() (r float64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (m *BackgroundVideoMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[backgroundvideo.FieldDuration] = struct{}{}
+}
+
+// DurationCleared returns if the "duration" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldDuration]
+	return ok
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *BackgroundVideoMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, backgroundvideo.FieldDuration)
+}
+
+// SetCodec sets the "codec" field.
+func (m *BackgroundVideoMutation) SetCodec(s string) {
+	m.codec = &s
+}
+
+// Codec returns the value of the "codec" field in the mutation.
+func (m *BackgroundVideoMutation) Codec() (r string, exists bool) {
+	v := m.codec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodec returns the old "codec" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldCodec(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodec: %w", err)
+	}
+	return oldValue.Codec, nil
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (m *BackgroundVideoMutation) ClearCodec() {
+	m.codec = nil
+	m.clearedFields[backgroundvideo.FieldCodec] = struct{}{}
+}
+
+// CodecCleared returns if the "codec" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) CodecCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldCodec]
+	return ok
+}
+
+// ResetCodec resets all changes to the "codec" field.
+func (m *BackgroundVideoMutation) ResetCodec() {
+	m.codec = nil
+	delete(m.clearedFields, backgroundvideo.FieldCodec)
+}
+
+// SetWidth sets the "width" field.
+func (m *BackgroundVideoMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *BackgroundVideoMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldWidth(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *BackgroundVideoMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *BackgroundVideoMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWidth clears the value of the "width" field.
+func (m *BackgroundVideoMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[backgroundvideo.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldWidth]
+	return ok
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *BackgroundVideoMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, backgroundvideo.FieldWidth)
+}
+
+// SetHeight sets the "height" field.
+func (m *BackgroundVideoMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *BackgroundVideoMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldHeight(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *BackgroundVideoMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *BackgroundVideoMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeight clears the value of the "height" field.
+func (m *BackgroundVideoMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[backgroundvideo.FieldHeight] = struct{}{}
+}
+
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldHeight]
+	return ok
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *BackgroundVideoMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, backgroundvideo.FieldHeight)
+}
+
+// SetFps sets the "fps" field.
+func (m *BackgroundVideoMutation) SetFps(f float64) {
+	m.fps = &f
+	m.addfps = nil
+}
+
+// Fps returns the value of the "fps" field in the mutation.
+func (m *BackgroundVideoMutation) Fps() (r float64, exists bool) {
+	v := m.fps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFps returns the old "fps" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldFps(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFps: %w", err)
+	}
+	return oldValue.Fps, nil
+}
+
+// AddFps adds f to the "fps" field.
+func (m *BackgroundVideoMutation) AddFps(f float64) {
+	if m.addfps != nil {
+		*m.addfps += f
+	} else {
+		m.addfps = &f
+	}
+}
+
+// AddedFps returns the value that was added to the "fps" field in this mutation.
+func (m *BackgroundVideoMutation) AddedFps() (r float64, exists bool) {
+	v := m.addfps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFps clears the value of the "fps" field.
+func (m *BackgroundVideoMutation) ClearFps() {
+	m.fps = nil
+	m.addfps = nil
+	m.clearedFields[backgroundvideo.FieldFps] = struct{}{}
+}
+
+// FpsCleared returns if the "fps" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) FpsCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldFps]
+	return ok
+}
+
+// ResetFps resets all changes to the "fps" field.
+func (m *BackgroundVideoMutation) ResetFps() {
+	m.fps = nil
+	m.addfps = nil
+	delete(m.clearedFields, backgroundvideo.FieldFps)
+}
+
+// SetTags sets the "tags" field.
+func (m *BackgroundVideoMutation) SetTags(value map[string]string) {
+	m.tags = &value
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *BackgroundVideoMutation) Tags() (r map[string]string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldTags(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *BackgroundVideoMutation) ClearTags() {
+	m.tags = nil
+	m.clearedFields[backgroundvideo.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *BackgroundVideoMutation) ResetTags() {
+	m.tags = nil
+	delete(m.clearedFields, backgroundvideo.FieldTags)
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (m *BackgroundVideoMutation) SetScannedAt(t time.Time) {
+	m.scanned_at = &t
+}
+
+// ScannedAt returns the value of the "scanned_at" field in the mutation.
+func (m *BackgroundVideoMutation) ScannedAt() (r time.Time, exists bool) {
+	v := m.scanned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScannedAt returns the old "scanned_at" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldScannedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScannedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScannedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScannedAt: %w", err)
+	}
+	return oldValue.ScannedAt, nil
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (m *BackgroundVideoMutation) ClearScannedAt() {
+	m.scanned_at = nil
+	m.clearedFields[backgroundvideo.FieldScannedAt] = struct{}{}
+}
+
+// ScannedAtCleared returns if the "scanned_at" field was cleared in this mutation.
+func (m *BackgroundVideoMutation) ScannedAtCleared() bool {
+	_, ok := m.clearedFields[backgroundvideo.FieldScannedAt]
+	return ok
+}
+
+// ResetScannedAt resets all changes to the "scanned_at" field.
+func (m *BackgroundVideoMutation) ResetScannedAt() {
+	m.scanned_at = nil
+	delete(m.clearedFields, backgroundvideo.FieldScannedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BackgroundVideoMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackgroundVideoMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BackgroundVideo entity.
+// If the BackgroundVideo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackgroundVideoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackgroundVideoMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddClipIDs adds the "clips" edge to the Clip entity by ids.
+func (m *BackgroundVideoMutation) AddClipIDs(ids ...int) {
+	if m.clips == nil {
+		m.clips = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.clips[ids[i]] = struct{}{}
+	}
+}
+
+// ClearClips clears the "clips" edge to the Clip entity.
+func (m *BackgroundVideoMutation) ClearClips() {
+	m.clearedclips = true
+}
+
+// ClipsCleared reports if the "clips" edge to the Clip entity was cleared.
+func (m *BackgroundVideoMutation) ClipsCleared() bool {
+	return m.clearedclips
+}
+
+// RemoveClipIDs removes the "clips" edge to the Clip entity by IDs.
+func (m *BackgroundVideoMutation) RemoveClipIDs(ids ...int) {
+	if m.removedclips == nil {
+		m.removedclips = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.clips, ids[i])
+		m.removedclips[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedClips returns the removed IDs of the "clips" edge to the Clip entity.
+func (m *BackgroundVideoMutation) RemovedClipsIDs() (ids []int) {
+	for id := range m.removedclips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ClipsIDs returns the "clips" edge IDs in the mutation.
+func (m *BackgroundVideoMutation) ClipsIDs() (ids []int) {
+	for id := range m.clips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetClips resets all changes to the "clips" edge.
+func (m *BackgroundVideoMutation) ResetClips() {
+	m.clips = nil
+	m.clearedclips = false
+	m.removedclips = nil
+}
+
+// Where appends a list predicates to the BackgroundVideoMutation builder.
+func (m *BackgroundVideoMutation) Where(ps ...predicate.BackgroundVideo) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackgroundVideoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackgroundVideoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackgroundVideo, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackgroundVideoMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BackgroundVideoMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BackgroundVideo).
+func (m *BackgroundVideoMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackgroundVideoMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m._path != nil {
+		fields = append(fields, backgroundvideo.FieldPath)
+	}
+	if m.weight != nil {
+		fields = append(fields, backgroundvideo.FieldWeight)
+	}
+	if m.use_count != nil {
+		fields = append(fields, backgroundvideo.FieldUseCount)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, backgroundvideo.FieldLastUsedAt)
+	}
+	if m.hash != nil {
+		fields = append(fields, backgroundvideo.FieldHash)
+	}
+	if m.duration != nil {
+		fields = append(fields, backgroundvideo.FieldDuration)
+	}
+	if m.codec != nil {
+		fields = append(fields, backgroundvideo.FieldCodec)
+	}
+	if m.width != nil {
+		fields = append(fields, backgroundvideo.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, backgroundvideo.FieldHeight)
+	}
+	if m.fps != nil {
+		fields = append(fields, backgroundvideo.FieldFps)
+	}
+	if m.tags != nil {
+		fields = append(fields, backgroundvideo.FieldTags)
+	}
+	if m.scanned_at != nil {
+		fields = append(fields, backgroundvideo.FieldScannedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, backgroundvideo.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackgroundVideoMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backgroundvideo.FieldPath:
+		return m.Path()
+	case backgroundvideo.FieldWeight:
+		return m.Weight()
+	case backgroundvideo.FieldUseCount:
+		return m.UseCount()
+	case backgroundvideo.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case backgroundvideo.FieldHash:
+		return m.Hash()
+	case backgroundvideo.FieldDuration:
+		return m.Duration()
+	case backgroundvideo.FieldCodec:
+		return m.Codec()
+	case backgroundvideo.FieldWidth:
+		return m.Width()
+	case backgroundvideo.FieldHeight:
+		return m.Height()
+	case backgroundvideo.FieldFps:
+		return m.Fps()
+	case backgroundvideo.FieldTags:
+		return m.Tags()
+	case backgroundvideo.FieldScannedAt:
+		return m.ScannedAt()
+	case backgroundvideo.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackgroundVideoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backgroundvideo.FieldPath:
+		return m.OldPath(ctx)
+	case backgroundvideo.FieldWeight:
+		return m.OldWeight(ctx)
+	case backgroundvideo.FieldUseCount:
+		return m.OldUseCount(ctx)
+	case backgroundvideo.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case backgroundvideo.FieldHash:
+		return m.OldHash(ctx)
+	case backgroundvideo.FieldDuration:
+		return m.OldDuration(ctx)
+	case backgroundvideo.FieldCodec:
+		return m.OldCodec(ctx)
+	case backgroundvideo.FieldWidth:
+		return m.OldWidth(ctx)
+	case backgroundvideo.FieldHeight:
+		return m.OldHeight(ctx)
+	case backgroundvideo.FieldFps:
+		return m.OldFps(ctx)
+	case backgroundvideo.FieldTags:
+		return m.OldTags(ctx)
+	case backgroundvideo.FieldScannedAt:
+		return m.OldScannedAt(ctx)
+	case backgroundvideo.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackgroundVideo field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackgroundVideoMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backgroundvideo.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case backgroundvideo.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case backgroundvideo.FieldUseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUseCount(v)
+		return nil
+	case backgroundvideo.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case backgroundvideo.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case backgroundvideo.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case backgroundvideo.FieldCodec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodec(v)
+		return nil
+	case backgroundvideo.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case backgroundvideo.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case backgroundvideo.FieldFps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFps(v)
+		return nil
+	case backgroundvideo.FieldTags:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case backgroundvideo.FieldScannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannedAt(v)
+		return nil
+	case backgroundvideo.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackgroundVideo field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackgroundVideoMutation) AddedFields() []string {
+	var fields []string
+	if m.addweight != nil {
+		fields = append(fields, backgroundvideo.FieldWeight)
+	}
+	if m.adduse_count != nil {
+		fields = append(fields, backgroundvideo.FieldUseCount)
+	}
+	if m.addduration != nil {
+		fields = append(fields, backgroundvideo.FieldDuration)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, backgroundvideo.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, backgroundvideo.FieldHeight)
+	}
+	if m.addfps != nil {
+		fields = append(fields, backgroundvideo.FieldFps)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackgroundVideoMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backgroundvideo.FieldWeight:
+		return m.AddedWeight()
+	case backgroundvideo.FieldUseCount:
+		return m.AddedUseCount()
+	case backgroundvideo.FieldDuration:
+		return m.AddedDuration()
+	case backgroundvideo.FieldWidth:
+		return m.AddedWidth()
+	case backgroundvideo.FieldHeight:
+		return m.AddedHeight()
+	case backgroundvideo.FieldFps:
+		return m.AddedFps()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackgroundVideoMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case backgroundvideo.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	case backgroundvideo.FieldUseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUseCount(v)
+		return nil
+	case backgroundvideo.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	case backgroundvideo.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case backgroundvideo.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case backgroundvideo.FieldFps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFps(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackgroundVideo numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackgroundVideoMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backgroundvideo.FieldLastUsedAt) {
+		fields = append(fields, backgroundvideo.FieldLastUsedAt)
+	}
+	if m.FieldCleared(backgroundvideo.FieldHash) {
+		fields = append(fields, backgroundvideo.FieldHash)
+	}
+	if m.FieldCleared(backgroundvideo.FieldDuration) {
+		fields = append(fields, backgroundvideo.FieldDuration)
+	}
+	if m.FieldCleared(backgroundvideo.FieldCodec) {
+		fields = append(fields, backgroundvideo.FieldCodec)
+	}
+	if m.FieldCleared(backgroundvideo.FieldWidth) {
+		fields = append(fields, backgroundvideo.FieldWidth)
+	}
+	if m.FieldCleared(backgroundvideo.FieldHeight) {
+		fields = append(fields, backgroundvideo.FieldHeight)
+	}
+	if m.FieldCleared(backgroundvideo.FieldFps) {
+		fields = append(fields, backgroundvideo.FieldFps)
+	}
+	if m.FieldCleared(backgroundvideo.FieldTags) {
+		fields = append(fields, backgroundvideo.FieldTags)
+	}
+	if m.FieldCleared(backgroundvideo.FieldScannedAt) {
+		fields = append(fields, backgroundvideo.FieldScannedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackgroundVideoMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackgroundVideoMutation) ClearField(name string) error {
+	switch name {
+	case backgroundvideo.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case backgroundvideo.FieldHash:
+		m.ClearHash()
+		return nil
+	case backgroundvideo.FieldDuration:
+		m.ClearDuration()
+		return nil
+	case backgroundvideo.FieldCodec:
+		m.ClearCodec()
+		return nil
+	case backgroundvideo.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case backgroundvideo.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case backgroundvideo.FieldFps:
+		m.ClearFps()
+		return nil
+	case backgroundvideo.FieldTags:
+		m.ClearTags()
+		return nil
+	case backgroundvideo.FieldScannedAt:
+		m.ClearScannedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackgroundVideo nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackgroundVideoMutation) ResetField(name string) error {
+	switch name {
+	case backgroundvideo.FieldPath:
+		m.ResetPath()
+		return nil
+	case backgroundvideo.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case backgroundvideo.FieldUseCount:
+		m.ResetUseCount()
+		return nil
+	case backgroundvideo.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case backgroundvideo.FieldHash:
+		m.ResetHash()
+		return nil
+	case backgroundvideo.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case backgroundvideo.FieldCodec:
+		m.ResetCodec()
+		return nil
+	case backgroundvideo.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case backgroundvideo.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case backgroundvideo.FieldFps:
+		m.ResetFps()
+		return nil
+	case backgroundvideo.FieldTags:
+		m.ResetTags()
+		return nil
+	case backgroundvideo.FieldScannedAt:
+		m.ResetScannedAt()
+		return nil
+	case backgroundvideo.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackgroundVideo field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackgroundVideoMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clips != nil {
+		edges = append(edges, backgroundvideo.EdgeClips)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackgroundVideoMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backgroundvideo.EdgeClips:
+		ids := make([]ent.Value, 0, len(m.clips))
+		for id := range m.clips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackgroundVideoMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedclips != nil {
+		edges = append(edges, backgroundvideo.EdgeClips)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackgroundVideoMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backgroundvideo.EdgeClips:
+		ids := make([]ent.Value, 0, len(m.removedclips))
+		for id := range m.removedclips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackgroundVideoMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedclips {
+		edges = append(edges, backgroundvideo.EdgeClips)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackgroundVideoMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backgroundvideo.EdgeClips:
+		return m.clearedclips
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackgroundVideoMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BackgroundVideo unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackgroundVideoMutation) ResetEdge(name string) error {
+	switch name {
+	case backgroundvideo.EdgeClips:
+		m.ResetClips()
+		return nil
+	}
+	return fmt.Errorf("unknown BackgroundVideo edge %s", name)
+}
+
+// ClipMutation represents an operation that mutates the Clip nodes in the graph.
+type ClipMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	hash                    *string
+	audio_path              *string
+	video_path              *string
+	artist                  *string
+	title                   *string
+	album                   *string
+	bio                     *string
+	bio_path                *string
+	hashtags                *[]string
+	appendhashtags          []string
+	gen_captions_path       *string
+	gen_raw_video_path      *string
+	gen_trimmed_video_path  *string
+	start_time              *string
+	end_time                *string
+	transcript_path         *string
+	subtitles_path          *string
+	lyrics_path             *string
+	lyrics_alignment        **model.LyricsAlignment
+	censor_log              *[]model.CensorEvent
+	appendcensor_log        []model.CensorEvent
+	captions_reviewed_at    *time.Time
+	caption_style           **model.CaptionStyle
+	segment_plan            **model.SegmentPlan
+	seed                    *int64
+	addseed                 *int64
+	background_start        *float64
+	addbackground_start     *float64
+	width                   *int
+	addwidth                *int
+	height                  *int
+	addheight               *int
+	fade_duration           *int
+	addfade_duration        *int
+	fade_in_duration        *float64
+	addfade_in_duration     *float64
+	audio_censor            **model.AudioCensor
+	loudness                **model.ClipLoudness
+	status                  *model.ClipStatus
+	stage                   *model.ClipStage
+	last_error              *string
+	attempts                *int
+	addattempts             *int
+	stage_timestamps        *map[model.ClipStage]*model.StageTimestamps
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	clearedFields           map[string]struct{}
+	audio                   *int
+	clearedaudio            bool
+	background_video        *int
+	clearedbackground_video bool
+	done                    bool
+	oldValue                func(context.Context) (*Clip, error)
+	predicates              []predicate.Clip
+}
+
+var _ ent.Mutation = (*ClipMutation)(nil)
+
+// clipOption allows management of the mutation configuration using functional options.
+type clipOption func(*ClipMutation)
+
+// newClipMutation creates new mutation for the Clip entity.
+func newClipMutation(c config, op Op, opts ...clipOption) *ClipMutation {
+	m := &ClipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeClip,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withClipID sets the ID field of the mutation.
+func withClipID(id int) clipOption {
+	return func(m *ClipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Clip
+		)
+		m.oldValue = func(ctx context.Context) (*Clip, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Clip.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withClip sets the old Clip of the mutation.
+func withClip(node *Clip) clipOption {
+	return func(m *ClipMutation) {
+		m.oldValue = func(context.Context) (*Clip, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ClipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ClipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ClipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ClipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Clip.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *ClipMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ClipMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *ClipMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetAudioPath sets the "audio_path" field.
+func (m *ClipMutation) SetAudioPath(s string) {
+	m.audio_path = &s
+}
+
+// AudioPath returns the value of the "audio_path" field in the mutation.
+func (m *ClipMutation) AudioPath() (r string, exists bool) {
+	v := m.audio_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioPath returns the old "audio_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldAudioPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioPath: %w", err)
+	}
+	return oldValue.AudioPath, nil
+}
+
+// ResetAudioPath resets all changes to the "audio_path" field.
+func (m *ClipMutation) ResetAudioPath() {
+	m.audio_path = nil
+}
+
+// SetVideoPath sets the "video_path" field.
+func (m *ClipMutation) SetVideoPath(s string) {
+	m.video_path = &s
+}
+
+// VideoPath returns the value of the "video_path" field in the mutation.
+func (m *ClipMutation) VideoPath() (r string, exists bool) {
+	v := m.video_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVideoPath returns the old "video_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldVideoPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVideoPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVideoPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVideoPath: %w", err)
+	}
+	return oldValue.VideoPath, nil
+}
+
+// ResetVideoPath resets all changes to the "video_path" field.
+func (m *ClipMutation) ResetVideoPath() {
+	m.video_path = nil
+}
+
+// SetArtist sets the "artist" field.
+func (m *ClipMutation) SetArtist(s string) {
+	m.artist = &s
+}
+
+// Artist returns the value of the "artist" field in the mutation.
+func (m *ClipMutation) Artist() (r string, exists bool) {
+	v := m.artist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtist returns the old "artist" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldArtist(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+	}
+	return oldValue.Artist, nil
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (m *ClipMutation) ClearArtist() {
+	m.artist = nil
+	m.clearedFields[clip.FieldArtist] = struct{}{}
+}
+
+// ArtistCleared returns if the "artist" field was cleared in this mutation.
+func (m *ClipMutation) ArtistCleared() bool {
+	_, ok := m.clearedFields[clip.FieldArtist]
+	return ok
+}
+
+// ResetArtist resets all changes to the "artist" field.
+func (m *ClipMutation) ResetArtist() {
+	m.artist = nil
+	delete(m.clearedFields, clip.FieldArtist)
+}
+
+// SetTitle sets the "title" field.
+func (m *ClipMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ClipMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ClipMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[clip.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ClipMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[clip.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ClipMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, clip.FieldTitle)
+}
+
+// SetAlbum sets the "album" field.
+func (m *ClipMutation) SetAlbum(s string) {
+	m.album = &s
+}
+
+// Album returns the value of the "album" field in the mutation.
+func (m *ClipMutation) Album() (r string, exists bool) {
+	v := m.album
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlbum returns the old "album" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldAlbum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlbum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlbum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlbum: %w", err)
+	}
+	return oldValue.Album, nil
+}
+
+// ClearAlbum clears the value of the "album" field.
+func (m *ClipMutation) ClearAlbum() {
+	m.album = nil
+	m.clearedFields[clip.FieldAlbum] = struct{}{}
+}
+
+// AlbumCleared returns if the "album" field was cleared in this mutation.
+func (m *ClipMutation) AlbumCleared() bool {
+	_, ok := m.clearedFields[clip.FieldAlbum]
+	return ok
+}
+
+// ResetAlbum resets all changes to the "album" field.
+func (m *ClipMutation) ResetAlbum() {
+	m.album = nil
+	delete(m.clearedFields, clip.FieldAlbum)
+}
+
+// SetBio sets the "bio" field.
+func (m *ClipMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *ClipMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldBio(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ClearBio clears the value of the "bio" field.
+func (m *ClipMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[clip.FieldBio] = struct{}{}
+}
+
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *ClipMutation) BioCleared() bool {
+	_, ok := m.clearedFields[clip.FieldBio]
+	return ok
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *ClipMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, clip.FieldBio)
+}
+
+// SetBioPath sets the "bio_path" field.
+func (m *ClipMutation) SetBioPath(s string) {
+	m.bio_path = &s
+}
+
+// BioPath returns the value of the "bio_path" field in the mutation.
+func (m *ClipMutation) BioPath() (r string, exists bool) {
+	v := m.bio_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBioPath returns the old "bio_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldBioPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBioPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBioPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBioPath: %w", err)
+	}
+	return oldValue.BioPath, nil
+}
+
+// ClearBioPath clears the value of the "bio_path" field.
+func (m *ClipMutation) ClearBioPath() {
+	m.bio_path = nil
+	m.clearedFields[clip.FieldBioPath] = struct{}{}
+}
+
+// BioPathCleared returns if the "bio_path" field was cleared in this mutation.
+func (m *ClipMutation) BioPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldBioPath]
+	return ok
+}
+
+// ResetBioPath resets all changes to the "bio_path" field.
+func (m *ClipMutation) ResetBioPath() {
+	m.bio_path = nil
+	delete(m.clearedFields, clip.FieldBioPath)
+}
+
+// SetHashtags sets the "hashtags" field.
+func (m *ClipMutation) SetHashtags(s []string) {
+	m.hashtags = &s
+	m.appendhashtags = nil
+}
+
+// Hashtags returns the value of the "hashtags" field in the mutation.
+func (m *ClipMutation) Hashtags() (r []string, exists bool) {
+	v := m.hashtags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHashtags returns the old "hashtags" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldHashtags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHashtags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHashtags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHashtags: %w", err)
+	}
+	return oldValue.Hashtags, nil
+}
+
+// AppendHashtags adds s to the "hashtags" field.
+func (m *ClipMutation) AppendHashtags(s []string) {
+	m.appendhashtags = append(m.appendhashtags, s...)
+}
+
+// AppendedHashtags returns the list of values that were appended to the "hashtags" field in this mutation.
+func (m *ClipMutation) AppendedHashtags() ([]string, bool) {
+	if len(m.appendhashtags) == 0 {
+		return nil, false
+	}
+	return m.appendhashtags, true
+}
+
+// ClearHashtags clears the value of the "hashtags" field.
+func (m *ClipMutation) ClearHashtags() {
+	m.hashtags = nil
+	m.appendhashtags = nil
+	m.clearedFields[clip.FieldHashtags] = struct{}{}
+}
+
+// HashtagsCleared returns if the "hashtags" field was cleared in this mutation.
+func (m *ClipMutation) HashtagsCleared() bool {
+	_, ok := m.clearedFields[clip.FieldHashtags]
+	return ok
+}
+
+// ResetHashtags resets all changes to the "hashtags" field.
+func (m *ClipMutation) ResetHashtags() {
+	m.hashtags = nil
+	m.appendhashtags = nil
+	delete(m.clearedFields, clip.FieldHashtags)
+}
+
+// SetAudioID sets the "audio_id" field.
+func (m *ClipMutation) SetAudioID(i int) {
+	m.audio = &i
+}
+
+// AudioID returns the value of the "audio_id" field in the mutation.
+func (m *ClipMutation) AudioID() (r int, exists bool) {
+	v := m.audio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioID returns the old "audio_id" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldAudioID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioID: %w", err)
+	}
+	return oldValue.AudioID, nil
+}
+
+// ClearAudioID clears the value of the "audio_id" field.
+func (m *ClipMutation) ClearAudioID() {
+	m.audio = nil
+	m.clearedFields[clip.FieldAudioID] = struct{}{}
+}
+
+// AudioIDCleared returns if the "audio_id" field was cleared in this mutation.
+func (m *ClipMutation) AudioIDCleared() bool {
+	_, ok := m.clearedFields[clip.FieldAudioID]
+	return ok
+}
+
+// ResetAudioID resets all changes to the "audio_id" field.
+func (m *ClipMutation) ResetAudioID() {
+	m.audio = nil
+	delete(m.clearedFields, clip.FieldAudioID)
+}
+
+// SetBackgroundVideoID sets the "background_video_id" field.
+func (m *ClipMutation) SetBackgroundVideoID(i int) {
+	m.background_video = &i
+}
+
+// BackgroundVideoID returns the value of the "background_video_id" field in the mutation.
+func (m *ClipMutation) BackgroundVideoID() (r int, exists bool) {
+	v := m.background_video
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackgroundVideoID returns the old "background_video_id" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldBackgroundVideoID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackgroundVideoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackgroundVideoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackgroundVideoID: %w", err)
+	}
+	return oldValue.BackgroundVideoID, nil
+}
+
+// ClearBackgroundVideoID clears the value of the "background_video_id" field.
+func (m *ClipMutation) ClearBackgroundVideoID() {
+	m.background_video = nil
+	m.clearedFields[clip.FieldBackgroundVideoID] = struct{}{}
+}
+
+// BackgroundVideoIDCleared returns if the "background_video_id" field was cleared in this mutation.
+func (m *ClipMutation) BackgroundVideoIDCleared() bool {
+	_, ok := m.clearedFields[clip.FieldBackgroundVideoID]
+	return ok
+}
+
+// ResetBackgroundVideoID resets all changes to the "background_video_id" field.
+func (m *ClipMutation) ResetBackgroundVideoID() {
+	m.background_video = nil
+	delete(m.clearedFields, clip.FieldBackgroundVideoID)
+}
+
+// SetGenCaptionsPath sets the "gen_captions_path" field.
+func (m *ClipMutation) SetGenCaptionsPath(s string) {
+	m.gen_captions_path = &s
+}
+
+// GenCaptionsPath returns the value of the "gen_captions_path" field in the mutation.
+func (m *ClipMutation) GenCaptionsPath() (r string, exists bool) {
+	v := m.gen_captions_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenCaptionsPath returns the old "gen_captions_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldGenCaptionsPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenCaptionsPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenCaptionsPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenCaptionsPath: %w", err)
+	}
+	return oldValue.GenCaptionsPath, nil
+}
+
+// ClearGenCaptionsPath clears the value of the "gen_captions_path" field.
+func (m *ClipMutation) ClearGenCaptionsPath() {
+	m.gen_captions_path = nil
+	m.clearedFields[clip.FieldGenCaptionsPath] = struct{}{}
+}
+
+// GenCaptionsPathCleared returns if the "gen_captions_path" field was cleared in this mutation.
+func (m *ClipMutation) GenCaptionsPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldGenCaptionsPath]
+	return ok
+}
+
+// ResetGenCaptionsPath resets all changes to the "gen_captions_path" field.
+func (m *ClipMutation) ResetGenCaptionsPath() {
+	m.gen_captions_path = nil
+	delete(m.clearedFields, clip.FieldGenCaptionsPath)
+}
+
+// SetGenRawVideoPath sets the "gen_raw_video_path" field.
+func (m *ClipMutation) SetGenRawVideoPath(s string) {
+	m.gen_raw_video_path = &s
+}
+
+// GenRawVideoPath returns the value of the "gen_raw_video_path" field in the mutation.
+func (m *ClipMutation) GenRawVideoPath() (r string, exists bool) {
+	v := m.gen_raw_video_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenRawVideoPath returns the old "gen_raw_video_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldGenRawVideoPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenRawVideoPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenRawVideoPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenRawVideoPath: %w", err)
+	}
+	return oldValue.GenRawVideoPath, nil
+}
+
+// ClearGenRawVideoPath clears the value of the "gen_raw_video_path" field.
+func (m *ClipMutation) ClearGenRawVideoPath() {
+	m.gen_raw_video_path = nil
+	m.clearedFields[clip.FieldGenRawVideoPath] = struct{}{}
+}
+
+// GenRawVideoPathCleared returns if the "gen_raw_video_path" field was cleared in this mutation.
+func (m *ClipMutation) GenRawVideoPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldGenRawVideoPath]
+	return ok
+}
+
+// ResetGenRawVideoPath resets all changes to the "gen_raw_video_path" field.
+func (m *ClipMutation) ResetGenRawVideoPath() {
+	m.gen_raw_video_path = nil
+	delete(m.clearedFields, clip.FieldGenRawVideoPath)
+}
+
+// SetGenTrimmedVideoPath sets the "gen_trimmed_video_path" field.
+func (m *ClipMutation) SetGenTrimmedVideoPath(s string) {
+	m.gen_trimmed_video_path = &s
+}
+
+// GenTrimmedVideoPath returns the value of the "gen_trimmed_video_path" field in the mutation.
+func (m *ClipMutation) GenTrimmedVideoPath() (r string, exists bool) {
+	v := m.gen_trimmed_video_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenTrimmedVideoPath returns the old "gen_trimmed_video_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldGenTrimmedVideoPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenTrimmedVideoPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenTrimmedVideoPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenTrimmedVideoPath: %w", err)
+	}
+	return oldValue.GenTrimmedVideoPath, nil
+}
+
+// ClearGenTrimmedVideoPath clears the value of the "gen_trimmed_video_path" field.
+func (m *ClipMutation) ClearGenTrimmedVideoPath() {
+	m.gen_trimmed_video_path = nil
+	m.clearedFields[clip.FieldGenTrimmedVideoPath] = struct{}{}
+}
+
+// GenTrimmedVideoPathCleared returns if the "gen_trimmed_video_path" field was cleared in this mutation.
+func (m *ClipMutation) GenTrimmedVideoPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldGenTrimmedVideoPath]
+	return ok
+}
+
+// ResetGenTrimmedVideoPath resets all changes to the "gen_trimmed_video_path" field.
+func (m *ClipMutation) ResetGenTrimmedVideoPath() {
+	m.gen_trimmed_video_path = nil
+	delete(m.clearedFields, clip.FieldGenTrimmedVideoPath)
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *ClipMutation) SetStartTime(s string) {
+	m.start_time = &s
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *ClipMutation) StartTime() (r string, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldStartTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (m *ClipMutation) ClearStartTime() {
+	m.start_time = nil
+	m.clearedFields[clip.FieldStartTime] = struct{}{}
+}
+
+// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
+func (m *ClipMutation) StartTimeCleared() bool {
+	_, ok := m.clearedFields[clip.FieldStartTime]
+	return ok
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *ClipMutation) ResetStartTime() {
+	m.start_time = nil
+	delete(m.clearedFields, clip.FieldStartTime)
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *ClipMutation) SetEndTime(s string) {
+	m.end_time = &s
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *ClipMutation) EndTime() (r string, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldEndTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *ClipMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[clip.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *ClipMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[clip.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *ClipMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, clip.FieldEndTime)
+}
+
+// SetTranscriptPath sets the "transcript_path" field.
+func (m *ClipMutation) SetTranscriptPath(s string) {
+	m.transcript_path = &s
+}
+
+// TranscriptPath returns the value of the "transcript_path" field in the mutation.
+func (m *ClipMutation) TranscriptPath() (r string, exists bool) {
+	v := m.transcript_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTranscriptPath returns the old "transcript_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldTranscriptPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranscriptPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranscriptPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranscriptPath: %w", err)
+	}
+	return oldValue.TranscriptPath, nil
+}
+
+// ClearTranscriptPath clears the value of the "transcript_path" field.
+func (m *ClipMutation) ClearTranscriptPath() {
+	m.transcript_path = nil
+	m.clearedFields[clip.FieldTranscriptPath] = struct{}{}
+}
+
+// TranscriptPathCleared returns if the "transcript_path" field was cleared in this mutation.
+func (m *ClipMutation) TranscriptPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldTranscriptPath]
+	return ok
+}
+
+// ResetTranscriptPath resets all changes to the "transcript_path" field.
+func (m *ClipMutation) ResetTranscriptPath() {
+	m.transcript_path = nil
+	delete(m.clearedFields, clip.FieldTranscriptPath)
+}
+
+// SetSubtitlesPath sets the "subtitles_path" field.
+func (m *ClipMutation) SetSubtitlesPath(s string) {
+	m.subtitles_path = &s
+}
+
+// SubtitlesPath returns the value of the "subtitles_path" field in the mutation.
+func (m *ClipMutation) SubtitlesPath() (r string, exists bool) {
+	v := m.subtitles_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtitlesPath returns the old "subtitles_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldSubtitlesPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitlesPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitlesPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitlesPath: %w", err)
+	}
+	return oldValue.SubtitlesPath, nil
+}
+
+// ClearSubtitlesPath clears the value of the "subtitles_path" field.
+func (m *ClipMutation) ClearSubtitlesPath() {
+	m.subtitles_path = nil
+	m.clearedFields[clip.FieldSubtitlesPath] = struct{}{}
+}
+
+// SubtitlesPathCleared returns if the "subtitles_path" field was cleared in this mutation.
+func (m *ClipMutation) SubtitlesPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldSubtitlesPath]
+	return ok
+}
+
+// ResetSubtitlesPath resets all changes to the "subtitles_path" field.
+func (m *ClipMutation) ResetSubtitlesPath() {
+	m.subtitles_path = nil
+	delete(m.clearedFields, clip.FieldSubtitlesPath)
+}
+
+// SetLyricsPath sets the "lyrics_path" field.
+func (m *ClipMutation) SetLyricsPath(s string) {
+	m.lyrics_path = &s
+}
+
+// LyricsPath returns the value of the "lyrics_path" field in the mutation.
+func (m *ClipMutation) LyricsPath() (r string, exists bool) {
+	v := m.lyrics_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLyricsPath returns the old "lyrics_path" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldLyricsPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLyricsPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLyricsPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLyricsPath: %w", err)
+	}
+	return oldValue.LyricsPath, nil
+}
+
+// ClearLyricsPath clears the value of the "lyrics_path" field.
+func (m *ClipMutation) ClearLyricsPath() {
+	m.lyrics_path = nil
+	m.clearedFields[clip.FieldLyricsPath] = struct{}{}
+}
+
+// LyricsPathCleared returns if the "lyrics_path" field was cleared in this mutation.
+func (m *ClipMutation) LyricsPathCleared() bool {
+	_, ok := m.clearedFields[clip.FieldLyricsPath]
+	return ok
+}
+
+// ResetLyricsPath resets all changes to the "lyrics_path" field.
+func (m *ClipMutation) ResetLyricsPath() {
+	m.lyrics_path = nil
+	delete(m.clearedFields, clip.FieldLyricsPath)
+}
+
+// SetLyricsAlignment sets the "lyrics_alignment" field.
+func (m *ClipMutation) SetLyricsAlignment(ma *model.LyricsAlignment) {
+	m.lyrics_alignment = &ma
+}
+
+// LyricsAlignment returns the value of the "lyrics_alignment" field in the mutation.
+func (m *ClipMutation) LyricsAlignment() (r *model.LyricsAlignment, exists bool) {
+	v := m.lyrics_alignment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLyricsAlignment returns the old "lyrics_alignment" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldLyricsAlignment(ctx context.Context) (v *model.LyricsAlignment, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLyricsAlignment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLyricsAlignment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLyricsAlignment: %w", err)
+	}
+	return oldValue.LyricsAlignment, nil
+}
+
+// ClearLyricsAlignment clears the value of the "lyrics_alignment" field.
+func (m *ClipMutation) ClearLyricsAlignment() {
+	m.lyrics_alignment = nil
+	m.clearedFields[clip.FieldLyricsAlignment] = struct{}{}
+}
+
+// LyricsAlignmentCleared returns if the "lyrics_alignment" field was cleared in this mutation.
+func (m *ClipMutation) LyricsAlignmentCleared() bool {
+	_, ok := m.clearedFields[clip.FieldLyricsAlignment]
+	return ok
+}
+
+// ResetLyricsAlignment resets all changes to the "lyrics_alignment" field.
+func (m *ClipMutation) ResetLyricsAlignment() {
+	m.lyrics_alignment = nil
+	delete(m.clearedFields, clip.FieldLyricsAlignment)
+}
+
+// SetCensorLog sets the "censor_log" field.
+func (m *ClipMutation) SetCensorLog(me []model.CensorEvent) {
+	m.censor_log = &me
+	m.appendcensor_log = nil
+}
+
+// CensorLog returns the value of the "censor_log" field in the mutation.
+func (m *ClipMutation) CensorLog() (r []model.CensorEvent, exists bool) {
+	v := m.censor_log
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCensorLog returns the old "censor_log" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldCensorLog(ctx context.Context) (v []model.CensorEvent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCensorLog is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCensorLog requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCensorLog: %w", err)
+	}
+	return oldValue.CensorLog, nil
+}
+
+// AppendCensorLog adds me to the "censor_log" field.
+func (m *ClipMutation) AppendCensorLog(me []model.CensorEvent) {
+	m.appendcensor_log = append(m.appendcensor_log, me...)
+}
+
+// AppendedCensorLog returns the list of values that were appended to the "censor_log" field in this mutation.
+func (m *ClipMutation) AppendedCensorLog() ([]model.CensorEvent, bool) {
+	if len(m.appendcensor_log) == 0 {
+		return nil, false
+	}
+	return m.appendcensor_log, true
+}
+
+// ClearCensorLog clears the value of the "censor_log" field.
+func (m *ClipMutation) ClearCensorLog() {
+	m.censor_log = nil
+	m.appendcensor_log = nil
+	m.clearedFields[clip.FieldCensorLog] = struct{}{}
+}
+
+// CensorLogCleared returns if the "censor_log" field was cleared in this mutation.
+func (m *ClipMutation) CensorLogCleared() bool {
+	_, ok := m.clearedFields[clip.FieldCensorLog]
+	return ok
+}
+
+// ResetCensorLog resets all changes to the "censor_log" field.
+func (m *ClipMutation) ResetCensorLog() {
+	m.censor_log = nil
+	m.appendcensor_log = nil
+	delete(m.clearedFields, clip.FieldCensorLog)
+}
+
+// SetCaptionsReviewedAt sets the "captions_reviewed_at" field.
+func (m *ClipMutation) SetCaptionsReviewedAt(t time.Time) {
+	m.captions_reviewed_at = &t
+}
+
+// CaptionsReviewedAt returns the value of the "captions_reviewed_at" field in the mutation.
+func (m *ClipMutation) CaptionsReviewedAt() (r time.Time, exists bool) {
+	v := m.captions_reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaptionsReviewedAt returns the old "captions_reviewed_at" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldCaptionsReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaptionsReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaptionsReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaptionsReviewedAt: %w", err)
+	}
+	return oldValue.CaptionsReviewedAt, nil
+}
+
+// ClearCaptionsReviewedAt clears the value of the "captions_reviewed_at" field.
+func (m *ClipMutation) ClearCaptionsReviewedAt() {
+	m.captions_reviewed_at = nil
+	m.clearedFields[clip.FieldCaptionsReviewedAt] = struct{}{}
+}
+
+// CaptionsReviewedAtCleared returns if the "captions_reviewed_at" field was cleared in this mutation.
+func (m *ClipMutation) CaptionsReviewedAtCleared() bool {
+	_, ok := m.clearedFields[clip.FieldCaptionsReviewedAt]
+	return ok
+}
+
+// ResetCaptionsReviewedAt resets all changes to the "captions_reviewed_at" field.
+func (m *ClipMutation) ResetCaptionsReviewedAt() {
+	m.captions_reviewed_at = nil
+	delete(m.clearedFields, clip.FieldCaptionsReviewedAt)
+}
+
+// SetCaptionStyle sets the "caption_style" field.
+func (m *ClipMutation) SetCaptionStyle(ms *model.CaptionStyle) {
+	m.caption_style = &ms
+}
+
+// CaptionStyle returns the value of the "caption_style" field in the mutation.
+func (m *ClipMutation) CaptionStyle() (r *model.CaptionStyle, exists bool) {
+	v := m.caption_style
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaptionStyle returns the old "caption_style" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldCaptionStyle(ctx context.Context) (v *model.CaptionStyle, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaptionStyle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaptionStyle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaptionStyle: %w", err)
+	}
+	return oldValue.CaptionStyle, nil
+}
+
+// ClearCaptionStyle clears the value of the "caption_style" field.
+func (m *ClipMutation) ClearCaptionStyle() {
+	m.caption_style = nil
+	m.clearedFields[clip.FieldCaptionStyle] = struct{}{}
+}
+
+// CaptionStyleCleared returns if the "caption_style" field was cleared in this mutation.
+func (m *ClipMutation) CaptionStyleCleared() bool {
+	_, ok := m.clearedFields[clip.FieldCaptionStyle]
+	return ok
+}
+
+// ResetCaptionStyle resets all changes to the "caption_style" field.
+func (m *ClipMutation) ResetCaptionStyle() {
+	m.caption_style = nil
+	delete(m.clearedFields, clip.FieldCaptionStyle)
+}
+
+// SetSegmentPlan sets the "segment_plan" field.
+func (m *ClipMutation) SetSegmentPlan(mp *model.SegmentPlan) {
+	m.segment_plan = &mp
+}
+
+// SegmentPlan returns the value of the "segment_plan" field in the mutation.
+func (m *ClipMutation) SegmentPlan() (r *model.SegmentPlan, exists bool) {
+	v := m.segment_plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSegmentPlan returns the old "segment_plan" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldSegmentPlan(ctx context.Context) (v *model.SegmentPlan, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSegmentPlan is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSegmentPlan requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSegmentPlan: %w", err)
+	}
+	return oldValue.SegmentPlan, nil
+}
+
+// ClearSegmentPlan clears the value of the "segment_plan" field.
+func (m *ClipMutation) ClearSegmentPlan() {
+	m.segment_plan = nil
+	m.clearedFields[clip.FieldSegmentPlan] = struct{}{}
+}
+
+// SegmentPlanCleared returns if the "segment_plan" field was cleared in this mutation.
+func (m *ClipMutation) SegmentPlanCleared() bool {
+	_, ok := m.clearedFields[clip.FieldSegmentPlan]
+	return ok
+}
+
+// ResetSegmentPlan resets all changes to the "segment_plan" field.
+func (m *ClipMutation) ResetSegmentPlan() {
+	m.segment_plan = nil
+	delete(m.clearedFields, clip.FieldSegmentPlan)
+}
+
+// SetSeed sets the "seed" field.
+func (m *ClipMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *ClipMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldSeed(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *ClipMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *ClipMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (m *ClipMutation) ClearSeed() {
+	m.seed = nil
+	m.addseed = nil
+	m.clearedFields[clip.FieldSeed] = struct{}{}
+}
+
+// SeedCleared returns if the "seed" field was cleared in this mutation.
+func (m *ClipMutation) SeedCleared() bool {
+	_, ok := m.clearedFields[clip.FieldSeed]
+	return ok
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *ClipMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+	delete(m.clearedFields, clip.FieldSeed)
+}
+
+// SetBackgroundStart sets the "background_start" field.
+func (m *ClipMutation) SetBackgroundStart(f float64) {
+	m.background_start = &f
+	m.addbackground_start = nil
+}
+
+// BackgroundStart returns the value of the "background_start" field in the mutation.
+func (m *ClipMutation) BackgroundStart() (r float64, exists bool) {
+	v := m.background_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackgroundStart returns the old "background_start" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldBackgroundStart(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackgroundStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackgroundStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackgroundStart: %w", err)
+	}
+	return oldValue.BackgroundStart, nil
+}
+
+// AddBackgroundStart adds f to the "background_start" field.
+func (m *ClipMutation) AddBackgroundStart(f float64) {
+	if m.addbackground_start != nil {
+		*m.addbackground_start += f
+	} else {
+		m.addbackground_start = &f
+	}
+}
+
+// AddedBackgroundStart returns the value that was added to the "background_start" field in this mutation.
+func (m *ClipMutation) AddedBackgroundStart() (r float64, exists bool) {
+	v := m.addbackground_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBackgroundStart clears the value of the "background_start" field.
+func (m *ClipMutation) ClearBackgroundStart() {
+	m.background_start = nil
+	m.addbackground_start = nil
+	m.clearedFields[clip.FieldBackgroundStart] = struct{}{}
+}
+
+// BackgroundStartCleared returns if the "background_start" field was cleared in this mutation.
+func (m *ClipMutation) BackgroundStartCleared() bool {
+	_, ok := m.clearedFields[clip.FieldBackgroundStart]
+	return ok
+}
+
+// ResetBackgroundStart resets all changes to the "background_start" field.
+func (m *ClipMutation) ResetBackgroundStart() {
+	m.background_start = nil
+	m.addbackground_start = nil
+	delete(m.clearedFields, clip.FieldBackgroundStart)
+}
+
+// SetWidth sets the "width" field.
+func (m *ClipMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *ClipMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldWidth(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *ClipMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *ClipMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWidth clears the value of the "width" field.
+func (m *ClipMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[clip.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *ClipMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[clip.FieldWidth]
+	return ok
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *ClipMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, clip.FieldWidth)
+}
+
+// SetHeight sets the "height" field.
+func (m *ClipMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *ClipMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldHeight(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *ClipMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *ClipMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeight clears the value of the "height" field.
+func (m *ClipMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[clip.FieldHeight] = struct{}{}
+}
+
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *ClipMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[clip.FieldHeight]
+	return ok
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *ClipMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, clip.FieldHeight)
+}
+
+// SetFadeDuration sets the "fade_duration" field.
+func (m *ClipMutation) SetFadeDuration(i int) {
+	m.fade_duration = &i
+	m.addfade_duration = nil
+}
+
+// FadeDuration returns the value of the "fade_duration" field in the mutation.
+func (m *ClipMutation) FadeDuration() (r int, exists bool) {
+	v := m.fade_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFadeDuration returns the old "fade_duration" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldFadeDuration(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFadeDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFadeDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFadeDuration: %w", err)
+	}
+	return oldValue.FadeDuration, nil
+}
+
+// AddFadeDuration adds i to the "fade_duration" field.
+func (m *ClipMutation) AddFadeDuration(i int) {
+	if m.addfade_duration != nil {
+		*m.addfade_duration += i
+	} else {
+		m.addfade_duration = &i
+	}
+}
+
+// AddedFadeDuration returns the value that was added to the "fade_duration" field in this mutation.
+func (m *ClipMutation) AddedFadeDuration() (r int, exists bool) {
+	v := m.addfade_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFadeDuration clears the value of the "fade_duration" field.
+func (m *ClipMutation) ClearFadeDuration() {
+	m.fade_duration = nil
+	m.addfade_duration = nil
+	m.clearedFields[clip.FieldFadeDuration] = struct{}{}
+}
+
+// FadeDurationCleared returns if the "fade_duration" field was cleared in this mutation.
+func (m *ClipMutation) FadeDurationCleared() bool {
+	_, ok := m.clearedFields[clip.FieldFadeDuration]
+	return ok
+}
+
+// ResetFadeDuration resets all changes to the "fade_duration" field.
+func (m *ClipMutation) ResetFadeDuration() {
+	m.fade_duration = nil
+	m.addfade_duration = nil
+	delete(m.clearedFields, clip.FieldFadeDuration)
+}
+
+// SetFadeInDuration sets the "fade_in_duration" field.
+func (m *ClipMutation) SetFadeInDuration(f float64) {
+	m.fade_in_duration = &f
+	m.addfade_in_duration = nil
+}
+
+// FadeInDuration returns the value of the "fade_in_duration" field in the mutation.
+func (m *ClipMutation) FadeInDuration() (r float64, exists bool) {
+	v := m.fade_in_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFadeInDuration returns the old "fade_in_duration" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldFadeInDuration(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFadeInDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFadeInDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFadeInDuration: %w", err)
+	}
+	return oldValue.FadeInDuration, nil
+}
+
+// AddFadeInDuration adds f to the "fade_in_duration" field.
+func (m *ClipMutation) AddFadeInDuration(f float64) {
+	if m.addfade_in_duration != nil {
+		*m.addfade_in_duration += f
+	} else {
+		m.addfade_in_duration = &f
+	}
+}
+
+// AddedFadeInDuration returns the value that was added to the "fade_in_duration" field in this mutation.
+func (m *ClipMutation) AddedFadeInDuration() (r float64, exists bool) {
+	v := m.addfade_in_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFadeInDuration clears the value of the "fade_in_duration" field.
+func (m *ClipMutation) ClearFadeInDuration() {
+	m.fade_in_duration = nil
+	m.addfade_in_duration = nil
+	m.clearedFields[clip.FieldFadeInDuration] = struct{}{}
+}
+
+// FadeInDurationCleared returns if the "fade_in_duration" field was cleared in this mutation.
+func (m *ClipMutation) FadeInDurationCleared() bool {
+	_, ok := m.clearedFields[clip.FieldFadeInDuration]
+	return ok
+}
+
+// ResetFadeInDuration resets all changes to the "fade_in_duration" field.
+func (m *ClipMutation) ResetFadeInDuration() {
+	m.fade_in_duration = nil
+	m.addfade_in_duration = nil
+	delete(m.clearedFields, clip.FieldFadeInDuration)
+}
+
+// SetAudioCensor sets the "audio_censor" field.
+func (m *ClipMutation) SetAudioCensor(mc *model.AudioCensor) {
+	m.audio_censor = &mc
+}
+
+// AudioCensor returns the value of the "audio_censor" field in the mutation.
+func (m *ClipMutation) AudioCensor() (r *model.AudioCensor, exists bool) {
+	v := m.audio_censor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioCensor returns the old "audio_censor" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldAudioCensor(ctx context.Context) (v *model.AudioCensor, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioCensor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioCensor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioCensor: %w", err)
+	}
+	return oldValue.AudioCensor, nil
+}
+
+// ClearAudioCensor clears the value of the "audio_censor" field.
+func (m *ClipMutation) ClearAudioCensor() {
+	m.audio_censor = nil
+	m.clearedFields[clip.FieldAudioCensor] = struct{}{}
+}
+
+// AudioCensorCleared returns if the "audio_censor" field was cleared in this mutation.
+func (m *ClipMutation) AudioCensorCleared() bool {
+	_, ok := m.clearedFields[clip.FieldAudioCensor]
+	return ok
+}
+
+// ResetAudioCensor resets all changes to the "audio_censor" field.
+func (m *ClipMutation) ResetAudioCensor() {
+	m.audio_censor = nil
+	delete(m.clearedFields, clip.FieldAudioCensor)
+}
+
+// SetLoudness sets the "loudness" field.
+func (m *ClipMutation) SetLoudness(ml *model.ClipLoudness) {
+	m.loudness = &ml
+}
+
+// Loudness returns the value of the "loudness" field in the mutation.
+func (m *ClipMutation) Loudness() (r *model.ClipLoudness, exists bool) {
+	v := m.loudness
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoudness returns the old "loudness" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldLoudness(ctx context.Context) (v *model.ClipLoudness, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoudness is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoudness requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoudness: %w", err)
+	}
+	return oldValue.Loudness, nil
+}
+
+// ClearLoudness clears the value of the "loudness" field.
+func (m *ClipMutation) ClearLoudness() {
+	m.loudness = nil
+	m.clearedFields[clip.FieldLoudness] = struct{}{}
+}
+
+// LoudnessCleared returns if the "loudness" field was cleared in this mutation.
+func (m *ClipMutation) LoudnessCleared() bool {
+	_, ok := m.clearedFields[clip.FieldLoudness]
+	return ok
+}
+
+// ResetLoudness resets all changes to the "loudness" field.
+func (m *ClipMutation) ResetLoudness() {
+	m.loudness = nil
+	delete(m.clearedFields, clip.FieldLoudness)
+}
+
+// SetStatus sets the "status" field.
+func (m *ClipMutation) SetStatus(ms model.ClipStatus) {
+	m.status = &ms
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ClipMutation) Status() (r model.ClipStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldStatus(ctx context.Context) (v model.ClipStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ClipMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStage sets the "stage" field.
+func (m *ClipMutation) SetStage(ms model.ClipStage) {
+	m.stage = &ms
+}
+
+// Stage returns the value of the "stage" field in the mutation.
+func (m *ClipMutation) Stage() (r model.ClipStage, exists bool) {
+	v := m.stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStage returns the old "stage" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldStage(ctx context.Context) (v model.ClipStage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+	}
+	return oldValue.Stage, nil
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *ClipMutation) ResetStage() {
+	m.stage = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *ClipMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *ClipMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *ClipMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[clip.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *ClipMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[clip.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *ClipMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, clip.FieldLastError)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ClipMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ClipMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *ClipMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ClipMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ClipMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetStageTimestamps sets the "stage_timestamps" field.
+func (m *ClipMutation) SetStageTimestamps(mst map[model.ClipStage]*model.StageTimestamps) {
+	m.stage_timestamps = &mst
+}
+
+// StageTimestamps returns the value of the "stage_timestamps" field in the mutation.
+func (m *ClipMutation) StageTimestamps() (r map[model.ClipStage]*model.StageTimestamps, exists bool) {
+	v := m.stage_timestamps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStageTimestamps returns the old "stage_timestamps" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldStageTimestamps(ctx context.Context) (v map[model.ClipStage]*model.StageTimestamps, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStageTimestamps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStageTimestamps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStageTimestamps: %w", err)
+	}
+	return oldValue.StageTimestamps, nil
+}
+
+// ClearStageTimestamps clears the value of the "stage_timestamps" field.
+func (m *ClipMutation) ClearStageTimestamps() {
+	m.stage_timestamps = nil
+	m.clearedFields[clip.FieldStageTimestamps] = struct{}{}
+}
+
+// StageTimestampsCleared returns if the "stage_timestamps" field was cleared in this mutation.
+func (m *ClipMutation) StageTimestampsCleared() bool {
+	_, ok := m.clearedFields[clip.FieldStageTimestamps]
+	return ok
+}
+
+// ResetStageTimestamps resets all changes to the "stage_timestamps" field.
+func (m *ClipMutation) ResetStageTimestamps() {
+	m.stage_timestamps = nil
+	delete(m.clearedFields, clip.FieldStageTimestamps)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ClipMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ClipMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ClipMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ClipMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ClipMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ClipMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ClipMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ClipMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Clip entity.
+// If the Clip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClipMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ClipMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[clip.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ClipMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[clip.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ClipMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, clip.FieldDeletedAt)
+}
+
+// ClearAudio clears the "audio" edge to the Audio entity.
+func (m *ClipMutation) ClearAudio() {
+	m.clearedaudio = true
+	m.clearedFields[clip.FieldAudioID] = struct{}{}
+}
+
+// AudioCleared reports if the "audio" edge to the Audio entity was cleared.
+func (m *ClipMutation) AudioCleared() bool {
+	return m.AudioIDCleared() || m.clearedaudio
+}
+
+// AudioIDs returns the "audio" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AudioID instead. It exists only for internal usage by the builders.
+func (m *ClipMutation) AudioIDs() (ids []int) {
+	if id := m.audio; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAudio resets all changes to the "audio" edge.
+func (m *ClipMutation) ResetAudio() {
+	m.audio = nil
+	m.clearedaudio = false
+}
+
+// ClearBackgroundVideo clears the "background_video" edge to the BackgroundVideo entity.
+func (m *ClipMutation) ClearBackgroundVideo() {
+	m.clearedbackground_video = true
+	m.clearedFields[clip.FieldBackgroundVideoID] = struct{}{}
+}
+
+// BackgroundVideoCleared reports if the "background_video" edge to the BackgroundVideo entity was cleared.
+func (m *ClipMutation) BackgroundVideoCleared() bool {
+	return m.BackgroundVideoIDCleared() || m.clearedbackground_video
+}
+
+// BackgroundVideoIDs returns the "background_video" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackgroundVideoID instead. It exists only for internal usage by the builders.
+func (m *ClipMutation) BackgroundVideoIDs() (ids []int) {
+	if id := m.background_video; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBackgroundVideo resets all changes to the "background_video" edge.
+func (m *ClipMutation) ResetBackgroundVideo() {
+	m.background_video = nil
+	m.clearedbackground_video = false
+}
+
+// Where appends a list predicates to the ClipMutation builder.
+func (m *ClipMutation) Where(ps ...predicate.Clip) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Clip, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClipMutation) SetOp(op Op) {
+	m.op = op
 }
 
 // Type returns the node type of this mutation (Clip).
@@ -556,7 +4789,7 @@ func (m *ClipMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ClipMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 40)
 	if m.hash != nil {
 		fields = append(fields, clip.FieldHash)
 	}
@@ -566,6 +4799,30 @@ func (m *ClipMutation) Fields() []string {
 	if m.video_path != nil {
 		fields = append(fields, clip.FieldVideoPath)
 	}
+	if m.artist != nil {
+		fields = append(fields, clip.FieldArtist)
+	}
+	if m.title != nil {
+		fields = append(fields, clip.FieldTitle)
+	}
+	if m.album != nil {
+		fields = append(fields, clip.FieldAlbum)
+	}
+	if m.bio != nil {
+		fields = append(fields, clip.FieldBio)
+	}
+	if m.bio_path != nil {
+		fields = append(fields, clip.FieldBioPath)
+	}
+	if m.hashtags != nil {
+		fields = append(fields, clip.FieldHashtags)
+	}
+	if m.audio != nil {
+		fields = append(fields, clip.FieldAudioID)
+	}
+	if m.background_video != nil {
+		fields = append(fields, clip.FieldBackgroundVideoID)
+	}
 	if m.gen_captions_path != nil {
 		fields = append(fields, clip.FieldGenCaptionsPath)
 	}
@@ -575,6 +4832,75 @@ func (m *ClipMutation) Fields() []string {
 	if m.gen_trimmed_video_path != nil {
 		fields = append(fields, clip.FieldGenTrimmedVideoPath)
 	}
+	if m.start_time != nil {
+		fields = append(fields, clip.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, clip.FieldEndTime)
+	}
+	if m.transcript_path != nil {
+		fields = append(fields, clip.FieldTranscriptPath)
+	}
+	if m.subtitles_path != nil {
+		fields = append(fields, clip.FieldSubtitlesPath)
+	}
+	if m.lyrics_path != nil {
+		fields = append(fields, clip.FieldLyricsPath)
+	}
+	if m.lyrics_alignment != nil {
+		fields = append(fields, clip.FieldLyricsAlignment)
+	}
+	if m.censor_log != nil {
+		fields = append(fields, clip.FieldCensorLog)
+	}
+	if m.captions_reviewed_at != nil {
+		fields = append(fields, clip.FieldCaptionsReviewedAt)
+	}
+	if m.caption_style != nil {
+		fields = append(fields, clip.FieldCaptionStyle)
+	}
+	if m.segment_plan != nil {
+		fields = append(fields, clip.FieldSegmentPlan)
+	}
+	if m.seed != nil {
+		fields = append(fields, clip.FieldSeed)
+	}
+	if m.background_start != nil {
+		fields = append(fields, clip.FieldBackgroundStart)
+	}
+	if m.width != nil {
+		fields = append(fields, clip.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, clip.FieldHeight)
+	}
+	if m.fade_duration != nil {
+		fields = append(fields, clip.FieldFadeDuration)
+	}
+	if m.fade_in_duration != nil {
+		fields = append(fields, clip.FieldFadeInDuration)
+	}
+	if m.audio_censor != nil {
+		fields = append(fields, clip.FieldAudioCensor)
+	}
+	if m.loudness != nil {
+		fields = append(fields, clip.FieldLoudness)
+	}
+	if m.status != nil {
+		fields = append(fields, clip.FieldStatus)
+	}
+	if m.stage != nil {
+		fields = append(fields, clip.FieldStage)
+	}
+	if m.last_error != nil {
+		fields = append(fields, clip.FieldLastError)
+	}
+	if m.attempts != nil {
+		fields = append(fields, clip.FieldAttempts)
+	}
+	if m.stage_timestamps != nil {
+		fields = append(fields, clip.FieldStageTimestamps)
+	}
 	if m.created_at != nil {
 		fields = append(fields, clip.FieldCreatedAt)
 	}
@@ -598,12 +4924,74 @@ func (m *ClipMutation) Field(name string) (ent.Value, bool) {
 		return m.AudioPath()
 	case clip.FieldVideoPath:
 		return m.VideoPath()
+	case clip.FieldArtist:
+		return m.Artist()
+	case clip.FieldTitle:
+		return m.Title()
+	case clip.FieldAlbum:
+		return m.Album()
+	case clip.FieldBio:
+		return m.Bio()
+	case clip.FieldBioPath:
+		return m.BioPath()
+	case clip.FieldHashtags:
+		return m.Hashtags()
+	case clip.FieldAudioID:
+		return m.AudioID()
+	case clip.FieldBackgroundVideoID:
+		return m.BackgroundVideoID()
 	case clip.FieldGenCaptionsPath:
 		return m.GenCaptionsPath()
 	case clip.FieldGenRawVideoPath:
 		return m.GenRawVideoPath()
 	case clip.FieldGenTrimmedVideoPath:
 		return m.GenTrimmedVideoPath()
+	case clip.FieldStartTime:
+		return m.StartTime()
+	case clip.FieldEndTime:
+		return m.EndTime()
+	case clip.FieldTranscriptPath:
+		return m.TranscriptPath()
+	case clip.FieldSubtitlesPath:
+		return m.SubtitlesPath()
+	case clip.FieldLyricsPath:
+		return m.LyricsPath()
+	case clip.FieldLyricsAlignment:
+		return m.LyricsAlignment()
+	case clip.FieldCensorLog:
+		return m.CensorLog()
+	case clip.FieldCaptionsReviewedAt:
+		return m.CaptionsReviewedAt()
+	case clip.FieldCaptionStyle:
+		return m.CaptionStyle()
+	case clip.FieldSegmentPlan:
+		return m.SegmentPlan()
+	case clip.FieldSeed:
+		return m.Seed()
+	case clip.FieldBackgroundStart:
+		return m.BackgroundStart()
+	case clip.FieldWidth:
+		return m.Width()
+	case clip.FieldHeight:
+		return m.Height()
+	case clip.FieldFadeDuration:
+		return m.FadeDuration()
+	case clip.FieldFadeInDuration:
+		return m.FadeInDuration()
+	case clip.FieldAudioCensor:
+		return m.AudioCensor()
+	case clip.FieldLoudness:
+		return m.Loudness()
+	case clip.FieldStatus:
+		return m.Status()
+	case clip.FieldStage:
+		return m.Stage()
+	case clip.FieldLastError:
+		return m.LastError()
+	case clip.FieldAttempts:
+		return m.Attempts()
+	case clip.FieldStageTimestamps:
+		return m.StageTimestamps()
 	case clip.FieldCreatedAt:
 		return m.CreatedAt()
 	case clip.FieldUpdatedAt:
@@ -625,12 +5013,74 @@ func (m *ClipMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldAudioPath(ctx)
 	case clip.FieldVideoPath:
 		return m.OldVideoPath(ctx)
+	case clip.FieldArtist:
+		return m.OldArtist(ctx)
+	case clip.FieldTitle:
+		return m.OldTitle(ctx)
+	case clip.FieldAlbum:
+		return m.OldAlbum(ctx)
+	case clip.FieldBio:
+		return m.OldBio(ctx)
+	case clip.FieldBioPath:
+		return m.OldBioPath(ctx)
+	case clip.FieldHashtags:
+		return m.OldHashtags(ctx)
+	case clip.FieldAudioID:
+		return m.OldAudioID(ctx)
+	case clip.FieldBackgroundVideoID:
+		return m.OldBackgroundVideoID(ctx)
 	case clip.FieldGenCaptionsPath:
 		return m.OldGenCaptionsPath(ctx)
 	case clip.FieldGenRawVideoPath:
 		return m.OldGenRawVideoPath(ctx)
 	case clip.FieldGenTrimmedVideoPath:
 		return m.OldGenTrimmedVideoPath(ctx)
+	case clip.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case clip.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case clip.FieldTranscriptPath:
+		return m.OldTranscriptPath(ctx)
+	case clip.FieldSubtitlesPath:
+		return m.OldSubtitlesPath(ctx)
+	case clip.FieldLyricsPath:
+		return m.OldLyricsPath(ctx)
+	case clip.FieldLyricsAlignment:
+		return m.OldLyricsAlignment(ctx)
+	case clip.FieldCensorLog:
+		return m.OldCensorLog(ctx)
+	case clip.FieldCaptionsReviewedAt:
+		return m.OldCaptionsReviewedAt(ctx)
+	case clip.FieldCaptionStyle:
+		return m.OldCaptionStyle(ctx)
+	case clip.FieldSegmentPlan:
+		return m.OldSegmentPlan(ctx)
+	case clip.FieldSeed:
+		return m.OldSeed(ctx)
+	case clip.FieldBackgroundStart:
+		return m.OldBackgroundStart(ctx)
+	case clip.FieldWidth:
+		return m.OldWidth(ctx)
+	case clip.FieldHeight:
+		return m.OldHeight(ctx)
+	case clip.FieldFadeDuration:
+		return m.OldFadeDuration(ctx)
+	case clip.FieldFadeInDuration:
+		return m.OldFadeInDuration(ctx)
+	case clip.FieldAudioCensor:
+		return m.OldAudioCensor(ctx)
+	case clip.FieldLoudness:
+		return m.OldLoudness(ctx)
+	case clip.FieldStatus:
+		return m.OldStatus(ctx)
+	case clip.FieldStage:
+		return m.OldStage(ctx)
+	case clip.FieldLastError:
+		return m.OldLastError(ctx)
+	case clip.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case clip.FieldStageTimestamps:
+		return m.OldStageTimestamps(ctx)
 	case clip.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case clip.FieldUpdatedAt:
@@ -651,21 +5101,77 @@ func (m *ClipMutation) SetField(name string, value ent.Value) error {
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHash(v)
+		m.SetHash(v)
+		return nil
+	case clip.FieldAudioPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioPath(v)
+		return nil
+	case clip.FieldVideoPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVideoPath(v)
+		return nil
+	case clip.FieldArtist:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtist(v)
+		return nil
+	case clip.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case clip.FieldAlbum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlbum(v)
+		return nil
+	case clip.FieldBio:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBio(v)
+		return nil
+	case clip.FieldBioPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBioPath(v)
 		return nil
-	case clip.FieldAudioPath:
-		v, ok := value.(string)
+	case clip.FieldHashtags:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAudioPath(v)
+		m.SetHashtags(v)
 		return nil
-	case clip.FieldVideoPath:
-		v, ok := value.(string)
+	case clip.FieldAudioID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVideoPath(v)
+		m.SetAudioID(v)
+		return nil
+	case clip.FieldBackgroundVideoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackgroundVideoID(v)
 		return nil
 	case clip.FieldGenCaptionsPath:
 		v, ok := value.(string)
@@ -688,6 +5194,167 @@ func (m *ClipMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetGenTrimmedVideoPath(v)
 		return nil
+	case clip.FieldStartTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case clip.FieldEndTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case clip.FieldTranscriptPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranscriptPath(v)
+		return nil
+	case clip.FieldSubtitlesPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitlesPath(v)
+		return nil
+	case clip.FieldLyricsPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLyricsPath(v)
+		return nil
+	case clip.FieldLyricsAlignment:
+		v, ok := value.(*model.LyricsAlignment)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLyricsAlignment(v)
+		return nil
+	case clip.FieldCensorLog:
+		v, ok := value.([]model.CensorEvent)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCensorLog(v)
+		return nil
+	case clip.FieldCaptionsReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaptionsReviewedAt(v)
+		return nil
+	case clip.FieldCaptionStyle:
+		v, ok := value.(*model.CaptionStyle)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaptionStyle(v)
+		return nil
+	case clip.FieldSegmentPlan:
+		v, ok := value.(*model.SegmentPlan)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSegmentPlan(v)
+		return nil
+	case clip.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case clip.FieldBackgroundStart:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackgroundStart(v)
+		return nil
+	case clip.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case clip.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case clip.FieldFadeDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFadeDuration(v)
+		return nil
+	case clip.FieldFadeInDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFadeInDuration(v)
+		return nil
+	case clip.FieldAudioCensor:
+		v, ok := value.(*model.AudioCensor)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioCensor(v)
+		return nil
+	case clip.FieldLoudness:
+		v, ok := value.(*model.ClipLoudness)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoudness(v)
+		return nil
+	case clip.FieldStatus:
+		v, ok := value.(model.ClipStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case clip.FieldStage:
+		v, ok := value.(model.ClipStage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case clip.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case clip.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case clip.FieldStageTimestamps:
+		v, ok := value.(map[model.ClipStage]*model.StageTimestamps)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStageTimestamps(v)
+		return nil
 	case clip.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -716,13 +5383,51 @@ func (m *ClipMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ClipMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addseed != nil {
+		fields = append(fields, clip.FieldSeed)
+	}
+	if m.addbackground_start != nil {
+		fields = append(fields, clip.FieldBackgroundStart)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, clip.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, clip.FieldHeight)
+	}
+	if m.addfade_duration != nil {
+		fields = append(fields, clip.FieldFadeDuration)
+	}
+	if m.addfade_in_duration != nil {
+		fields = append(fields, clip.FieldFadeInDuration)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, clip.FieldAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ClipMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case clip.FieldSeed:
+		return m.AddedSeed()
+	case clip.FieldBackgroundStart:
+		return m.AddedBackgroundStart()
+	case clip.FieldWidth:
+		return m.AddedWidth()
+	case clip.FieldHeight:
+		return m.AddedHeight()
+	case clip.FieldFadeDuration:
+		return m.AddedFadeDuration()
+	case clip.FieldFadeInDuration:
+		return m.AddedFadeInDuration()
+	case clip.FieldAttempts:
+		return m.AddedAttempts()
+	}
 	return nil, false
 }
 
@@ -731,6 +5436,55 @@ func (m *ClipMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ClipMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case clip.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case clip.FieldBackgroundStart:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBackgroundStart(v)
+		return nil
+	case clip.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case clip.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case clip.FieldFadeDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFadeDuration(v)
+		return nil
+	case clip.FieldFadeInDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFadeInDuration(v)
+		return nil
+	case clip.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Clip numeric field %s", name)
 }
@@ -739,6 +5493,30 @@ func (m *ClipMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *ClipMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(clip.FieldArtist) {
+		fields = append(fields, clip.FieldArtist)
+	}
+	if m.FieldCleared(clip.FieldTitle) {
+		fields = append(fields, clip.FieldTitle)
+	}
+	if m.FieldCleared(clip.FieldAlbum) {
+		fields = append(fields, clip.FieldAlbum)
+	}
+	if m.FieldCleared(clip.FieldBio) {
+		fields = append(fields, clip.FieldBio)
+	}
+	if m.FieldCleared(clip.FieldBioPath) {
+		fields = append(fields, clip.FieldBioPath)
+	}
+	if m.FieldCleared(clip.FieldHashtags) {
+		fields = append(fields, clip.FieldHashtags)
+	}
+	if m.FieldCleared(clip.FieldAudioID) {
+		fields = append(fields, clip.FieldAudioID)
+	}
+	if m.FieldCleared(clip.FieldBackgroundVideoID) {
+		fields = append(fields, clip.FieldBackgroundVideoID)
+	}
 	if m.FieldCleared(clip.FieldGenCaptionsPath) {
 		fields = append(fields, clip.FieldGenCaptionsPath)
 	}
@@ -748,6 +5526,66 @@ func (m *ClipMutation) ClearedFields() []string {
 	if m.FieldCleared(clip.FieldGenTrimmedVideoPath) {
 		fields = append(fields, clip.FieldGenTrimmedVideoPath)
 	}
+	if m.FieldCleared(clip.FieldStartTime) {
+		fields = append(fields, clip.FieldStartTime)
+	}
+	if m.FieldCleared(clip.FieldEndTime) {
+		fields = append(fields, clip.FieldEndTime)
+	}
+	if m.FieldCleared(clip.FieldTranscriptPath) {
+		fields = append(fields, clip.FieldTranscriptPath)
+	}
+	if m.FieldCleared(clip.FieldSubtitlesPath) {
+		fields = append(fields, clip.FieldSubtitlesPath)
+	}
+	if m.FieldCleared(clip.FieldLyricsPath) {
+		fields = append(fields, clip.FieldLyricsPath)
+	}
+	if m.FieldCleared(clip.FieldLyricsAlignment) {
+		fields = append(fields, clip.FieldLyricsAlignment)
+	}
+	if m.FieldCleared(clip.FieldCensorLog) {
+		fields = append(fields, clip.FieldCensorLog)
+	}
+	if m.FieldCleared(clip.FieldCaptionsReviewedAt) {
+		fields = append(fields, clip.FieldCaptionsReviewedAt)
+	}
+	if m.FieldCleared(clip.FieldCaptionStyle) {
+		fields = append(fields, clip.FieldCaptionStyle)
+	}
+	if m.FieldCleared(clip.FieldSegmentPlan) {
+		fields = append(fields, clip.FieldSegmentPlan)
+	}
+	if m.FieldCleared(clip.FieldSeed) {
+		fields = append(fields, clip.FieldSeed)
+	}
+	if m.FieldCleared(clip.FieldBackgroundStart) {
+		fields = append(fields, clip.FieldBackgroundStart)
+	}
+	if m.FieldCleared(clip.FieldWidth) {
+		fields = append(fields, clip.FieldWidth)
+	}
+	if m.FieldCleared(clip.FieldHeight) {
+		fields = append(fields, clip.FieldHeight)
+	}
+	if m.FieldCleared(clip.FieldFadeDuration) {
+		fields = append(fields, clip.FieldFadeDuration)
+	}
+	if m.FieldCleared(clip.FieldFadeInDuration) {
+		fields = append(fields, clip.FieldFadeInDuration)
+	}
+	if m.FieldCleared(clip.FieldAudioCensor) {
+		fields = append(fields, clip.FieldAudioCensor)
+	}
+	if m.FieldCleared(clip.FieldLoudness) {
+		fields = append(fields, clip.FieldLoudness)
+	}
+	if m.FieldCleared(clip.FieldLastError) {
+		fields = append(fields, clip.FieldLastError)
+	}
+	if m.FieldCleared(clip.FieldStageTimestamps) {
+		fields = append(fields, clip.FieldStageTimestamps)
+	}
 	if m.FieldCleared(clip.FieldDeletedAt) {
 		fields = append(fields, clip.FieldDeletedAt)
 	}
@@ -765,6 +5603,30 @@ func (m *ClipMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *ClipMutation) ClearField(name string) error {
 	switch name {
+	case clip.FieldArtist:
+		m.ClearArtist()
+		return nil
+	case clip.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case clip.FieldAlbum:
+		m.ClearAlbum()
+		return nil
+	case clip.FieldBio:
+		m.ClearBio()
+		return nil
+	case clip.FieldBioPath:
+		m.ClearBioPath()
+		return nil
+	case clip.FieldHashtags:
+		m.ClearHashtags()
+		return nil
+	case clip.FieldAudioID:
+		m.ClearAudioID()
+		return nil
+	case clip.FieldBackgroundVideoID:
+		m.ClearBackgroundVideoID()
+		return nil
 	case clip.FieldGenCaptionsPath:
 		m.ClearGenCaptionsPath()
 		return nil
@@ -774,6 +5636,66 @@ func (m *ClipMutation) ClearField(name string) error {
 	case clip.FieldGenTrimmedVideoPath:
 		m.ClearGenTrimmedVideoPath()
 		return nil
+	case clip.FieldStartTime:
+		m.ClearStartTime()
+		return nil
+	case clip.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case clip.FieldTranscriptPath:
+		m.ClearTranscriptPath()
+		return nil
+	case clip.FieldSubtitlesPath:
+		m.ClearSubtitlesPath()
+		return nil
+	case clip.FieldLyricsPath:
+		m.ClearLyricsPath()
+		return nil
+	case clip.FieldLyricsAlignment:
+		m.ClearLyricsAlignment()
+		return nil
+	case clip.FieldCensorLog:
+		m.ClearCensorLog()
+		return nil
+	case clip.FieldCaptionsReviewedAt:
+		m.ClearCaptionsReviewedAt()
+		return nil
+	case clip.FieldCaptionStyle:
+		m.ClearCaptionStyle()
+		return nil
+	case clip.FieldSegmentPlan:
+		m.ClearSegmentPlan()
+		return nil
+	case clip.FieldSeed:
+		m.ClearSeed()
+		return nil
+	case clip.FieldBackgroundStart:
+		m.ClearBackgroundStart()
+		return nil
+	case clip.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case clip.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case clip.FieldFadeDuration:
+		m.ClearFadeDuration()
+		return nil
+	case clip.FieldFadeInDuration:
+		m.ClearFadeInDuration()
+		return nil
+	case clip.FieldAudioCensor:
+		m.ClearAudioCensor()
+		return nil
+	case clip.FieldLoudness:
+		m.ClearLoudness()
+		return nil
+	case clip.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case clip.FieldStageTimestamps:
+		m.ClearStageTimestamps()
+		return nil
 	case clip.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
@@ -794,6 +5716,30 @@ func (m *ClipMutation) ResetField(name string) error {
 	case clip.FieldVideoPath:
 		m.ResetVideoPath()
 		return nil
+	case clip.FieldArtist:
+		m.ResetArtist()
+		return nil
+	case clip.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case clip.FieldAlbum:
+		m.ResetAlbum()
+		return nil
+	case clip.FieldBio:
+		m.ResetBio()
+		return nil
+	case clip.FieldBioPath:
+		m.ResetBioPath()
+		return nil
+	case clip.FieldHashtags:
+		m.ResetHashtags()
+		return nil
+	case clip.FieldAudioID:
+		m.ResetAudioID()
+		return nil
+	case clip.FieldBackgroundVideoID:
+		m.ResetBackgroundVideoID()
+		return nil
 	case clip.FieldGenCaptionsPath:
 		m.ResetGenCaptionsPath()
 		return nil
@@ -803,6 +5749,75 @@ func (m *ClipMutation) ResetField(name string) error {
 	case clip.FieldGenTrimmedVideoPath:
 		m.ResetGenTrimmedVideoPath()
 		return nil
+	case clip.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case clip.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case clip.FieldTranscriptPath:
+		m.ResetTranscriptPath()
+		return nil
+	case clip.FieldSubtitlesPath:
+		m.ResetSubtitlesPath()
+		return nil
+	case clip.FieldLyricsPath:
+		m.ResetLyricsPath()
+		return nil
+	case clip.FieldLyricsAlignment:
+		m.ResetLyricsAlignment()
+		return nil
+	case clip.FieldCensorLog:
+		m.ResetCensorLog()
+		return nil
+	case clip.FieldCaptionsReviewedAt:
+		m.ResetCaptionsReviewedAt()
+		return nil
+	case clip.FieldCaptionStyle:
+		m.ResetCaptionStyle()
+		return nil
+	case clip.FieldSegmentPlan:
+		m.ResetSegmentPlan()
+		return nil
+	case clip.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case clip.FieldBackgroundStart:
+		m.ResetBackgroundStart()
+		return nil
+	case clip.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case clip.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case clip.FieldFadeDuration:
+		m.ResetFadeDuration()
+		return nil
+	case clip.FieldFadeInDuration:
+		m.ResetFadeInDuration()
+		return nil
+	case clip.FieldAudioCensor:
+		m.ResetAudioCensor()
+		return nil
+	case clip.FieldLoudness:
+		m.ResetLoudness()
+		return nil
+	case clip.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case clip.FieldStage:
+		m.ResetStage()
+		return nil
+	case clip.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case clip.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case clip.FieldStageTimestamps:
+		m.ResetStageTimestamps()
+		return nil
 	case clip.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -818,19 +5833,35 @@ func (m *ClipMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ClipMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
+	if m.audio != nil {
+		edges = append(edges, clip.EdgeAudio)
+	}
+	if m.background_video != nil {
+		edges = append(edges, clip.EdgeBackgroundVideo)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *ClipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case clip.EdgeAudio:
+		if id := m.audio; id != nil {
+			return []ent.Value{*id}
+		}
+	case clip.EdgeBackgroundVideo:
+		if id := m.background_video; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ClipMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
 	return edges
 }
 
@@ -842,24 +5873,52 @@ func (m *ClipMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ClipMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
+	if m.clearedaudio {
+		edges = append(edges, clip.EdgeAudio)
+	}
+	if m.clearedbackground_video {
+		edges = append(edges, clip.EdgeBackgroundVideo)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *ClipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case clip.EdgeAudio:
+		return m.clearedaudio
+	case clip.EdgeBackgroundVideo:
+		return m.clearedbackground_video
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *ClipMutation) ClearEdge(name string) error {
+	switch name {
+	case clip.EdgeAudio:
+		m.ClearAudio()
+		return nil
+	case clip.EdgeBackgroundVideo:
+		m.ClearBackgroundVideo()
+		return nil
+	}
 	return fmt.Errorf("unknown Clip unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *ClipMutation) ResetEdge(name string) error {
+	switch name {
+	case clip.EdgeAudio:
+		m.ResetAudio()
+		return nil
+	case clip.EdgeBackgroundVideo:
+		m.ResetBackgroundVideo()
+		return nil
+	}
 	return fmt.Errorf("unknown Clip edge %s", name)
 }
@@ -6,5 +6,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Audio is the predicate function for audio builders.
+type Audio func(*sql.Selector)
+
+// BackgroundVideo is the predicate function for backgroundvideo builders.
+type BackgroundVideo func(*sql.Selector)
+
 // Clip is the predicate function for clip builders.
 type Clip func(*sql.Selector)
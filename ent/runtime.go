@@ -5,6 +5,8 @@ package ent
 import (
 	"time"
 
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
 	"github.com/sam-laister/tiktok-creator/ent/schema"
 )
@@ -13,14 +15,38 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	audioFields := schema.Audio{}.Fields()
+	_ = audioFields
+	// audioDescScannedAt is the schema descriptor for scanned_at field.
+	audioDescScannedAt := audioFields[8].Descriptor()
+	// audio.DefaultScannedAt holds the default value on creation for the scanned_at field.
+	audio.DefaultScannedAt = audioDescScannedAt.Default.(func() time.Time)
+	backgroundvideoFields := schema.BackgroundVideo{}.Fields()
+	_ = backgroundvideoFields
+	// backgroundvideoDescWeight is the schema descriptor for weight field.
+	backgroundvideoDescWeight := backgroundvideoFields[1].Descriptor()
+	// backgroundvideo.DefaultWeight holds the default value on creation for the weight field.
+	backgroundvideo.DefaultWeight = backgroundvideoDescWeight.Default.(float64)
+	// backgroundvideoDescUseCount is the schema descriptor for use_count field.
+	backgroundvideoDescUseCount := backgroundvideoFields[2].Descriptor()
+	// backgroundvideo.DefaultUseCount holds the default value on creation for the use_count field.
+	backgroundvideo.DefaultUseCount = backgroundvideoDescUseCount.Default.(int)
+	// backgroundvideoDescCreatedAt is the schema descriptor for created_at field.
+	backgroundvideoDescCreatedAt := backgroundvideoFields[12].Descriptor()
+	// backgroundvideo.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backgroundvideo.DefaultCreatedAt = backgroundvideoDescCreatedAt.Default.(func() time.Time)
 	clipFields := schema.Clip{}.Fields()
 	_ = clipFields
+	// clipDescAttempts is the schema descriptor for attempts field.
+	clipDescAttempts := clipFields[35].Descriptor()
+	// clip.DefaultAttempts holds the default value on creation for the attempts field.
+	clip.DefaultAttempts = clipDescAttempts.Default.(int)
 	// clipDescCreatedAt is the schema descriptor for created_at field.
-	clipDescCreatedAt := clipFields[6].Descriptor()
+	clipDescCreatedAt := clipFields[37].Descriptor()
 	// clip.DefaultCreatedAt holds the default value on creation for the created_at field.
 	clip.DefaultCreatedAt = clipDescCreatedAt.Default.(func() time.Time)
 	// clipDescUpdatedAt is the schema descriptor for updated_at field.
-	clipDescUpdatedAt := clipFields[7].Descriptor()
+	clipDescUpdatedAt := clipFields[38].Descriptor()
 	// clip.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	clip.DefaultUpdatedAt = clipDescUpdatedAt.Default.(func() time.Time)
 }
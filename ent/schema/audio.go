@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Audio holds the schema definition for the Audio entity.
+type Audio struct {
+	ent.Schema
+}
+
+// Fields of the Audio.
+func (Audio) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").
+			Unique(),
+		field.String("path"),
+		field.Float("duration"),
+		field.String("codec"),
+		field.Int("sample_rate"),
+		field.Int("channels"),
+		field.Float("loudness").
+			Optional().
+			Nillable(),
+		field.JSON("tags", map[string]string{}).
+			Optional(),
+		field.Time("scanned_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Audio.
+func (Audio) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("clips", Clip.Type),
+	}
+}
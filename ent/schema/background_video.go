@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// BackgroundVideo holds the schema definition for the BackgroundVideo entity.
+type BackgroundVideo struct {
+	ent.Schema
+}
+
+// Fields of the BackgroundVideo.
+func (BackgroundVideo) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("path").
+			Unique(),
+		field.Float("weight").
+			Default(1),
+		field.Int("use_count").
+			Default(0),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		// Media fields are filled in by assets scan, videos picked up by batch
+		// alone only have a path.
+		field.String("hash").
+			Optional().
+			Nillable(),
+		field.Float("duration").
+			Optional().
+			Nillable(),
+		field.String("codec").
+			Optional().
+			Nillable(),
+		field.Int("width").
+			Optional().
+			Nillable(),
+		field.Int("height").
+			Optional().
+			Nillable(),
+		field.Float("fps").
+			Optional().
+			Nillable(),
+		field.JSON("tags", map[string]string{}).
+			Optional(),
+		field.Time("scanned_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the BackgroundVideo.
+func (BackgroundVideo) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("clips", Clip.Type),
+	}
+}
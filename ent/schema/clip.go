@@ -4,7 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 // Clip holds the schema definition for the Clip entity.
@@ -18,6 +21,26 @@ func (Clip) Fields() []ent.Field {
 		field.String("hash"),
 		field.String("audio_path"),
 		field.String("video_path"),
+		field.String("artist").
+			Optional(),
+		field.String("title").
+			Optional(),
+		field.String("album").
+			Optional(),
+		field.Text("bio").
+			Optional().
+			Nillable(),
+		field.String("bio_path").
+			Optional().
+			Nillable(),
+		field.Strings("hashtags").
+			Optional(),
+		field.Int("audio_id").
+			Optional().
+			Nillable(),
+		field.Int("background_video_id").
+			Optional().
+			Nillable(),
 		field.String("gen_captions_path").
 			Optional().
 			Nillable(),
@@ -27,6 +50,65 @@ func (Clip) Fields() []ent.Field {
 		field.String("gen_trimmed_video_path").
 			Optional().
 			Nillable(),
+		field.String("start_time").
+			Optional(),
+		field.String("end_time").
+			Optional(),
+		field.String("transcript_path").
+			Optional().
+			Nillable(),
+		field.String("subtitles_path").
+			Optional().
+			Nillable(),
+		field.String("lyrics_path").
+			Optional().
+			Nillable(),
+		field.JSON("lyrics_alignment", &model.LyricsAlignment{}).
+			Optional(),
+		field.JSON("censor_log", []model.CensorEvent{}).
+			Optional(),
+		field.Time("captions_reviewed_at").
+			Optional().
+			Nillable(),
+		field.JSON("caption_style", &model.CaptionStyle{}).
+			Optional(),
+		field.JSON("segment_plan", &model.SegmentPlan{}).
+			Optional(),
+		field.Int64("seed").
+			Optional().
+			Nillable(),
+		field.Float("background_start").
+			Optional().
+			Nillable(),
+		field.Int("width").
+			Optional().
+			Nillable(),
+		field.Int("height").
+			Optional().
+			Nillable(),
+		field.Int("fade_duration").
+			Optional().
+			Nillable(),
+		field.Float("fade_in_duration").
+			Optional().
+			Nillable(),
+		field.JSON("audio_censor", &model.AudioCensor{}).
+			Optional(),
+		field.JSON("loudness", &model.ClipLoudness{}).
+			Optional(),
+		field.Enum("status").
+			GoType(model.ClipStatus("")).
+			Default(string(model.ClipStatusPending)),
+		field.Enum("stage").
+			GoType(model.ClipStage("")).
+			Default(string(model.ClipStageCaptions)),
+		field.String("last_error").
+			Optional().
+			Nillable(),
+		field.Int("attempts").
+			Default(0),
+		field.JSON("stage_timestamps", map[model.ClipStage]*model.StageTimestamps{}).
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -37,7 +119,23 @@ func (Clip) Fields() []ent.Field {
 	}
 }
 
+// Indexes of the Clip.
+func (Clip) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
+
 // Edges of the Clip.
 func (Clip) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("audio", Audio.Type).
+			Ref("clips").
+			Field("audio_id").
+			Unique(),
+		edge.From("background_video", BackgroundVideo.Type).
+			Ref("clips").
+			Field("background_video_id").
+			Unique(),
+	}
 }
@@ -12,6 +12,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Audio is the client for interacting with the Audio builders.
+	Audio *AudioClient
+	// BackgroundVideo is the client for interacting with the BackgroundVideo builders.
+	BackgroundVideo *BackgroundVideoClient
 	// Clip is the client for interacting with the Clip builders.
 	Clip *ClipClient
 
@@ -145,6 +149,8 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Audio = NewAudioClient(tx.config)
+	tx.BackgroundVideo = NewBackgroundVideoClient(tx.config)
 	tx.Clip = NewClipClient(tx.config)
 }
 
@@ -155,7 +161,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Clip.QueryXXX(), the query will be executed
+// applies a query, for example: Audio.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,156 @@
+package analysis
+
+import (
+	"math"
+)
+
+// Tempos outside this range are taken to be half or double time.
+const (
+	minBPM = 70
+	maxBPM = 180
+	// Tempo estimates are biased towards this, where most rap sits once half
+	// time is folded in.
+	preferredBPM = 120
+	// How many times the beat grid is refitted to the hits it lands near.
+	gridFits = 2
+)
+
+// Beats is the tempo and beat grid of a stretch of audio.
+type Beats struct {
+	BPM float64
+	// Times are the beats in seconds from the start of the audio.
+	Times []float64
+}
+
+// DetectBeats estimates the tempo of samples from the periodicity of their
+// onsets, then lays a beat grid over the strongest onsets, letting each beat
+// drift slightly towards the nearest hit. Audio without a clear pulse yields
+// no beats.
+func DetectBeats(samples []float32) *Beats {
+	envelope := onsetEnvelope(samples)
+	frameSeconds := float64(hopSize) / SampleRate
+
+	minLag := int(math.Floor(60.0 / maxBPM / frameSeconds))
+	maxLag := int(math.Ceil(60.0 / minBPM / frameSeconds))
+	if len(envelope) < 2*maxLag {
+		return &Beats{}
+	}
+
+	// Tempo is the lag whose autocorrelation, weighted towards the
+	// preferred tempo, is highest.
+	scores := make([]float64, maxLag+2)
+	bestLag := 0
+	for lag := minLag - 1; lag <= maxLag+1; lag++ {
+		var correlation float64
+		for i := lag; i < len(envelope); i++ {
+			correlation += envelope[i] * envelope[i-lag]
+		}
+		bpm := 60 / (float64(lag) * frameSeconds)
+		weight := math.Exp(-0.5 * math.Pow(math.Log2(bpm/preferredBPM), 2))
+		scores[lag] = correlation / float64(len(envelope)-lag) * weight
+		if lag >= minLag && lag <= maxLag && scores[lag] > scores[bestLag] {
+			bestLag = lag
+		}
+	}
+	if bestLag == 0 {
+		return &Beats{}
+	}
+
+	// Frames are too coarse for the grid not to drift over a whole window, so
+	// the period is refined between lags by fitting a parabola to the peak.
+	period := float64(bestLag)
+	if curve := scores[bestLag-1] - 2*scores[bestLag] + scores[bestLag+1]; curve < 0 {
+		period += 0.5 * (scores[bestLag-1] - scores[bestLag+1]) / curve
+	}
+
+	// Phase is the offset whose grid lands on the most onset strength.
+	bestPhase, bestSum := 0, -1.0
+	for phase := range bestLag {
+		var sum float64
+		for beat := float64(phase); int(math.Round(beat)) < len(envelope); beat += period {
+			sum += envelope[int(math.Round(beat))]
+		}
+		if sum > bestSum {
+			bestPhase, bestSum = phase, sum
+		}
+	}
+
+	// Each beat of the grid is snapped to the nearest hit, and the grid is
+	// refitted to the hits so it follows the song rather than the estimate.
+	phase := float64(bestPhase)
+	drift := max(bestLag/8, 1)
+	for range gridFits {
+		var beats, peaks []float64
+		for beat := phase; int(math.Round(beat)) < len(envelope); beat += period {
+			i := int(math.Round(beat))
+			peak := i
+			for j := max(i-drift, 0); j <= min(i+drift, len(envelope)-1); j++ {
+				if envelope[j] > envelope[peak] {
+					peak = j
+				}
+			}
+			beats = append(beats, float64(len(beats)))
+			peaks = append(peaks, float64(peak))
+		}
+		if len(peaks) < 2 {
+			break
+		}
+		phase, period = fitLine(beats, peaks)
+	}
+
+	// A hit's flux peaks in the frame starting about half a frame and half a
+	// hop before it, since the window has to reach the hit before it counts
+	offset := float64(frameSize/2+hopSize/2) / SampleRate
+	beats := &Beats{BPM: 60 / (period * frameSeconds)}
+	for beat := phase; beat*frameSeconds+offset < float64(len(samples))/SampleRate; beat += period {
+		if beat >= 0 {
+			beats.Times = append(beats.Times, beat*frameSeconds+offset)
+		}
+	}
+	return beats
+}
+
+// fitLine returns the intercept and slope of the least squares line through
+// the points (x, y).
+func fitLine(x, y []float64) (float64, float64) {
+	var meanX, meanY float64
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+	meanX /= float64(len(x))
+	meanY /= float64(len(y))
+
+	var covariance, variance float64
+	for i := range x {
+		covariance += (x[i] - meanX) * (y[i] - meanY)
+		variance += (x[i] - meanX) * (x[i] - meanX)
+	}
+	slope := covariance / variance
+	return meanY - slope*meanX, slope
+}
+
+// onsetEnvelope returns the spectral flux of every frame less its local mean,
+// so only hits that stand out from their surroundings remain.
+func onsetEnvelope(samples []float32) []float64 {
+	var flux []float64
+	spectralFrames(samples, func(frame spectralFrame) {
+		flux = append(flux, frame.Flux)
+	})
+
+	// About a second either side
+	const meanRadius = SampleRate / hopSize
+	envelope := make([]float64, len(flux))
+	var sum float64
+	lo, hi := 0, 0
+	for i := range flux {
+		for ; hi < len(flux) && hi <= i+meanRadius; hi++ {
+			sum += flux[hi]
+		}
+		for ; lo < i-meanRadius; lo++ {
+			sum -= flux[lo]
+		}
+		envelope[i] = max(flux[i]-sum/float64(hi-lo), 0)
+	}
+	return envelope
+}
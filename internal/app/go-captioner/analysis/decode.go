@@ -0,0 +1,78 @@
+package analysis
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+)
+
+// SampleRate is the rate audio is decoded at for analysis. It keeps the
+// frequencies that matter for energy, onsets and harmony while keeping whole
+// tracks small enough to hold in memory.
+const SampleRate = 11025
+
+// Decode reads the whole of audioPath as mono float samples at SampleRate.
+// Commands run are written to output, along with ffmpeg's own output when
+// verbose is set.
+func Decode(ctx context.Context, audioPath string, output io.Writer, verbose bool) ([]float32, error) {
+	return decode(ctx, audioPath, nil, output, verbose)
+}
+
+// DecodeWindow reads the window of audioPath from start to end, in seconds,
+// as Decode does.
+func DecodeWindow(ctx context.Context, audioPath string, start, end float64, output io.Writer, verbose bool) ([]float32, error) {
+	if end <= start {
+		return nil, fmt.Errorf("end time %g must be greater than start time %g", end, start)
+	}
+
+	window := []string{
+		"-ss", strconv.FormatFloat(start, 'f', -1, 64),
+		"-t", strconv.FormatFloat(end-start, 'f', -1, 64),
+	}
+	return decode(ctx, audioPath, window, output, verbose)
+}
+
+func decode(ctx context.Context, audioPath string, window []string, output io.Writer, verbose bool) ([]float32, error) {
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+	}
+	args = append(args, window...)
+	args = append(args,
+		"-i", audioPath,
+		"-ac", "1",
+		"-ar", strconv.Itoa(SampleRate),
+		"-f", "f32le",
+		"-",
+	)
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	helper.PrepareCommand(cmd)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if output != nil {
+		_, _ = fmt.Fprintln(output, "Running: ", helper.GetCommandPrintable(cmd))
+		if verbose {
+			cmd.Stderr = output
+		}
+	}
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", audioPath, err)
+	}
+
+	data := stdout.Bytes()
+	samples := make([]float32, len(data)/4)
+	for i := range samples {
+		samples[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return samples, nil
+}
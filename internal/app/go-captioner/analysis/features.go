@@ -0,0 +1,151 @@
+package analysis
+
+import (
+	"math"
+)
+
+const (
+	frameSize = 2048
+	hopSize   = 512
+	// Only these frequencies count towards chroma; below them is mostly kick
+	// drum and above them mostly cymbals.
+	minChromaFrequency = 55
+	maxChromaFrequency = 4000
+)
+
+// Features summarise a track one second at a time.
+type Features struct {
+	// Energy is the RMS level of each second.
+	Energy []float64
+	// Onset is the mean spectral flux of each second, high where notes and
+	// drums hit.
+	Onset []float64
+	// Chroma is the pitch class profile of each second, normalised to unit
+	// length so seconds can be compared by their dot product.
+	Chroma [][12]float64
+}
+
+// Seconds is the number of whole seconds the features cover.
+func (f *Features) Seconds() int {
+	return len(f.Energy)
+}
+
+// Extract computes the features of samples decoded at SampleRate.
+func Extract(samples []float32) *Features {
+	seconds := len(samples) / SampleRate
+	features := &Features{
+		Energy: make([]float64, seconds),
+		Onset:  make([]float64, seconds),
+		Chroma: make([][12]float64, seconds),
+	}
+	if seconds == 0 {
+		return features
+	}
+
+	pitchClasses := make([]int, frameSize/2)
+	for bin := range pitchClasses {
+		frequency := float64(bin) * SampleRate / frameSize
+		if frequency < minChromaFrequency || frequency > maxChromaFrequency {
+			pitchClasses[bin] = -1
+			continue
+		}
+		midi := 69 + 12*math.Log2(frequency/440)
+		pitchClasses[bin] = (int(math.Round(midi))%12 + 12) % 12
+	}
+
+	frames := make([]int, seconds)
+	spectralFrames(samples, func(frame spectralFrame) {
+		second := (frame.Start + frameSize/2) / SampleRate
+		if second >= seconds {
+			return
+		}
+
+		for bin, magnitude := range frame.Magnitudes {
+			if class := pitchClasses[bin]; class >= 0 {
+				features.Chroma[second][class] += magnitude
+			}
+		}
+
+		features.Energy[second] += frame.Power
+		features.Onset[second] += frame.Flux
+		frames[second]++
+	})
+
+	for second, n := range frames {
+		if n == 0 {
+			continue
+		}
+		features.Energy[second] = math.Sqrt(features.Energy[second] / float64(n))
+		features.Onset[second] /= float64(n)
+
+		var norm float64
+		for _, v := range features.Chroma[second] {
+			norm += v * v
+		}
+		if norm = math.Sqrt(norm); norm > 0 {
+			for class := range features.Chroma[second] {
+				features.Chroma[second][class] /= norm
+			}
+		}
+	}
+
+	return features
+}
+
+// spectralFrame is one hop of the short time spectrum of a track.
+type spectralFrame struct {
+	// Start is the index of the frame's first sample.
+	Start int
+	// Power is the mean square of the frame's samples.
+	Power float64
+	// Magnitudes is the log magnitude spectrum, only valid during the call.
+	Magnitudes []float64
+	// Flux sums the increases in magnitude since the previous frame.
+	Flux float64
+}
+
+// spectralFrames calls fn for every frame of samples in order.
+func spectralFrames(samples []float32, fn func(frame spectralFrame)) {
+	window := hann(frameSize)
+	spectrum := make([]complex128, frameSize)
+	magnitudes := make([]float64, frameSize/2)
+	previous := make([]float64, frameSize/2)
+
+	for start := 0; start+frameSize <= len(samples); start += hopSize {
+		var sumSquares float64
+		for i := range spectrum {
+			sample := float64(samples[start+i])
+			sumSquares += sample * sample
+			spectrum[i] = complex(sample*window[i], 0)
+		}
+		fft(spectrum)
+
+		var flux float64
+		for bin := range magnitudes {
+			re, im := real(spectrum[bin]), imag(spectrum[bin])
+			magnitude := math.Log1p(10 * math.Sqrt(re*re+im*im))
+			if diff := magnitude - previous[bin]; diff > 0 && start > 0 {
+				flux += diff
+			}
+			magnitudes[bin] = magnitude
+		}
+
+		fn(spectralFrame{
+			Start:      start,
+			Power:      sumSquares / frameSize,
+			Magnitudes: magnitudes,
+			Flux:       flux,
+		})
+		magnitudes, previous = previous, magnitudes
+	}
+}
+
+// similarity compares the harmony of two seconds, from 0 for nothing in
+// common to 1 for the same chroma.
+func (f *Features) similarity(a, b int) float64 {
+	var dot float64
+	for class := range f.Chroma[a] {
+		dot += f.Chroma[a][class] * f.Chroma[b][class]
+	}
+	return dot
+}
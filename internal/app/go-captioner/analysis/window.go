@@ -0,0 +1,190 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+)
+
+// How much each feature counts towards a window's score. Repetition finds the
+// chorus, energy and onsets prefer the parts of it where the beat is in.
+const (
+	repetitionWeight = 0.45
+	energyWeight     = 0.35
+	onsetWeight      = 0.2
+)
+
+// Windows quieter on average than this RMS level, about -80 dBFS, are silent
+// and never picked.
+const silenceLevel = 1e-4
+
+// Candidate is a window of a track scored as a snippet. The feature scores are
+// scaled from 0 for the weakest window in the track to 1 for the strongest.
+type Candidate struct {
+	// Start and End are in seconds.
+	Start int
+	End   int
+	Score float64
+	// Repetition is how closely the window's harmony repeats elsewhere in the
+	// track, which is highest for the chorus.
+	Repetition float64
+	Energy     float64
+	Onset      float64
+}
+
+// FindWindows decodes audioPath and returns up to n non-overlapping windows
+// of length seconds, best first.
+func FindWindows(ctx context.Context, audioPath string, length, n int, output io.Writer, verbose bool) ([]Candidate, error) {
+	samples, err := Decode(ctx, audioPath, output, verbose)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := Candidates(Extract(samples), length, n)
+	if err != nil {
+		return nil, fmt.Errorf("picking a window of %s: %w", audioPath, err)
+	}
+	return candidates, nil
+}
+
+// Candidates scores every window of length seconds in the track and returns up
+// to n of the best that overlap each other by less than half their length.
+// Tracks no longer than length yield a single window covering all of them.
+// Silent windows are skipped, and it is an error if every window is silent
+// or the track is under a second long.
+func Candidates(f *Features, length, n int) ([]Candidate, error) {
+	switch {
+	case length <= 0:
+		return nil, fmt.Errorf("window length must be positive, got %d", length)
+	case n <= 0:
+		return nil, fmt.Errorf("window count must be positive, got %d", n)
+	}
+
+	total := f.Seconds()
+	if total == 0 {
+		return nil, errors.New("the audio is shorter than a second")
+	}
+	if total <= length {
+		if windowMeans(f.Energy, total)[0] < silenceLevel {
+			return nil, errors.New("the audio is silent")
+		}
+		return []Candidate{{Start: 0, End: total, Score: 1}}, nil
+	}
+
+	windows := make([]Candidate, total-length+1)
+	repetition := repetitionScores(f, length)
+	level := windowMeans(f.Energy, length)
+	energy := slices.Clone(level)
+	onset := windowMeans(f.Onset, length)
+	normalise(repetition)
+	normalise(energy)
+	normalise(onset)
+
+	for start := range windows {
+		windows[start] = Candidate{
+			Start:      start,
+			End:        start + length,
+			Repetition: repetition[start],
+			Energy:     energy[start],
+			Onset:      onset[start],
+			Score: repetitionWeight*repetition[start] +
+				energyWeight*energy[start] +
+				onsetWeight*onset[start],
+		}
+	}
+
+	slices.SortStableFunc(windows, func(a, b Candidate) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+
+	var picked []Candidate
+	for _, window := range windows {
+		if len(picked) == n {
+			break
+		}
+		if level[window.Start] < silenceLevel {
+			continue
+		}
+		overlaps := slices.ContainsFunc(picked, func(other Candidate) bool {
+			return min(window.End, other.End)-max(window.Start, other.Start) >= length/2
+		})
+		if !overlaps {
+			picked = append(picked, window)
+		}
+	}
+	if len(picked) == 0 {
+		return nil, errors.New("the audio is silent")
+	}
+	return picked, nil
+}
+
+// repetitionScores returns, for every window of length seconds, its best mean
+// similarity to the same span of seconds at some other point in the track.
+// Lags shorter than half a window are ignored so a sustained note doesn't
+// count as repeating itself.
+func repetitionScores(f *Features, length int) []float64 {
+	total := f.Seconds()
+	scores := make([]float64, total-length+1)
+	minLag := max(length/2, 1)
+
+	// prefix[t] sums the similarity of second i to second i+lag for i < t
+	prefix := make([]float64, total+1)
+	for lag := minLag; lag+length <= total; lag++ {
+		for t := 0; t+lag < total; t++ {
+			prefix[t+1] = prefix[t] + f.similarity(t, t+lag)
+		}
+
+		for start := range scores {
+			// Matching a later window
+			if start+lag+length <= total {
+				scores[start] = max(scores[start], (prefix[start+length]-prefix[start])/float64(length))
+			}
+			// Matching an earlier window
+			if start-lag >= 0 {
+				scores[start] = max(scores[start], (prefix[start-lag+length]-prefix[start-lag])/float64(length))
+			}
+		}
+	}
+	return scores
+}
+
+// windowMeans returns the mean of values over every window of length.
+func windowMeans(values []float64, length int) []float64 {
+	means := make([]float64, len(values)-length+1)
+	var sum float64
+	for i, value := range values {
+		sum += value
+		if i >= length {
+			sum -= values[i-length]
+		}
+		if i >= length-1 {
+			means[i-length+1] = sum / float64(length)
+		}
+	}
+	return means
+}
+
+// normalise scales values in place to between 0 and 1.
+func normalise(values []float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		lo = min(lo, value)
+		hi = max(hi, value)
+	}
+
+	for i := range values {
+		if hi > lo {
+			values[i] = (values[i] - lo) / (hi - lo)
+		} else {
+			values[i] = 0
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+// features builds features with the given energy per second and nothing else.
+func features(energy ...float64) *Features {
+	return &Features{
+		Energy: energy,
+		Onset:  make([]float64, len(energy)),
+		Chroma: make([][12]float64, len(energy)),
+	}
+}
+
+func TestCandidatesErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		features *Features
+		length   int
+		count    int
+		want     string
+	}{
+		{name: "empty", features: features(), length: 4, count: 3, want: "shorter than a second"},
+		{name: "silent short track", features: features(0, 0, 0), length: 4, count: 3, want: "silent"},
+		{name: "silent long track", features: features(0, 0, 0, 0, 0, 0, 0, 0), length: 4, count: 3, want: "silent"},
+		{name: "zero length", features: features(0.2, 0.3), length: 0, count: 3, want: "length must be positive, got 0"},
+		// Would index past the end of the energy means
+		{name: "negative length", features: features(0.2, 0.3), length: -5, count: 3, want: "length must be positive, got -5"},
+		{name: "no windows asked for", features: features(0.2, 0.3), length: 4, count: 0, want: "count must be positive, got 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Candidates(test.features, test.length, test.count)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Candidates error = %v, want one containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestCandidatesSkipsSilence(t *testing.T) {
+	// Only the last four seconds are audible
+	candidates, err := Candidates(features(0, 0, 0, 0, 0, 0, 0.2, 0.3, 0.2, 0.3), 4, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, candidate := range candidates {
+		if candidate.Start < 3 {
+			t.Errorf("picked %d-%d, which is silent on average", candidate.Start, candidate.End)
+		}
+	}
+}
+
+func TestCandidatesShortTrack(t *testing.T) {
+	candidates, err := Candidates(features(0.2, 0.3, 0), 4, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 1 || candidates[0].Start != 0 || candidates[0].End != 3 {
+		t.Errorf("candidates = %+v, want the whole track", candidates)
+	}
+}
@@ -0,0 +1,287 @@
+package captions
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// charWidthRatio is a conservative, monospace-like estimate of glyph width.
+const charWidthRatio = 0.5
+
+// highlight holds the ASS override colours used to mark the word being sung.
+type highlight struct {
+	primary string
+	colour  string
+}
+
+// WriteASS renders the transcript as ASS subtitles with words flowing onto
+// lines of at most opts.MaxChars characters. Lines are grouped into pages that
+// fill the frame and stay up until the next page starts. How words show up
+// within a page depends on opts.Mode; in single-word mode every word is a page
+// of its own.
+func WriteASS(w io.Writer, transcript *model.Transcript, opts Options) error {
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid caption style: %w", err)
+	}
+
+	header, err := assHeader(opts)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+
+	var hl highlight
+	if opts.Mode == model.CaptionModeKaraoke || opts.Mode == model.CaptionModePop {
+		if hl.primary, err = overrideColour(opts.PrimaryColour); err != nil {
+			return err
+		}
+		if hl.colour, err = overrideColour(opts.HighlightColour); err != nil {
+			return err
+		}
+	}
+
+	maxChars := opts.MaxChars
+	if opts.Mode == model.CaptionModeSingleWord {
+		// Every word overflows an empty line, so each gets a line of its own
+		maxChars = 0
+	}
+	lines := wrapLines(transcript.Words, maxChars)
+
+	lineHeight := int(float64(opts.FontSize) * opts.LineSpacing)
+	maxLinesPerPage := (opts.VideoHeight - 2*opts.MarginY) / lineHeight
+	if maxLinesPerPage < 1 || opts.Mode == model.CaptionModeSingleWord {
+		maxLinesPerPage = 1
+	}
+
+	var events []string
+	for pageIdx := 0; pageIdx < len(lines); pageIdx += maxLinesPerPage {
+		pageLines := lines[pageIdx:min(pageIdx+maxLinesPerPage, len(lines))]
+
+		// The page stays up until the first word of the next page
+		pageStart := pageLines[0][0].Start
+		pageEnd := pageLines[len(pageLines)-1][len(pageLines[len(pageLines)-1])-1].End
+		if next := pageIdx + maxLinesPerPage; next < len(lines) {
+			pageEnd = lines[next][0].Start
+		}
+
+		top := pageTop(len(pageLines), lineHeight, opts)
+		for lineIdx, lineWords := range pageLines {
+			yPos := top + lineIdx*lineHeight + lineHeight/2
+			events = append(events, lineEvents(lineWords, yPos, pageStart, pageEnd, opts, hl)...)
+		}
+	}
+
+	_, err = io.WriteString(w, strings.Join(events, "\n"))
+	return err
+}
+
+// pageTop returns the y coordinate of the top of a page of lineCount lines,
+// placed by the row of opts.Alignment.
+func pageTop(lineCount, lineHeight int, opts Options) int {
+	pageHeight := lineCount * lineHeight
+	switch {
+	case opts.Alignment <= 3:
+		return opts.VideoHeight - opts.MarginY - pageHeight
+	case opts.Alignment <= 6:
+		return (opts.VideoHeight - pageHeight) / 2
+	default:
+		return opts.MarginY
+	}
+}
+
+func assHeader(opts Options) (string, error) {
+	colours := []string{opts.PrimaryColour, opts.SecondaryColour, opts.OutlineColour, opts.BackColour}
+	for i, colour := range colours {
+		converted, err := assColour(colour)
+		if err != nil {
+			return "", err
+		}
+		colours[i] = converted
+	}
+
+	bold := 0
+	if opts.Bold {
+		bold = -1
+	}
+
+	title := ""
+	if opts.Title != "" {
+		// Header values end at the line
+		title = "Title: " + strings.Join(strings.Fields(opts.Title), " ") + "\n"
+	}
+
+	return fmt.Sprintf(
+		"[Script Info]\n%sPlayResX: %d\nPlayResY: %d\nScriptType: v4.00+\n\n"+
+			"[V4+ Styles]\n"+
+			"Format: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, "+
+			"OutlineColour, BackColour, Bold, Italic, Underline, StrikeOut, "+
+			"ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, "+
+			"Alignment, MarginL, MarginR, MarginV, Encoding\n"+
+			"Style: Default,%s,%d,%s,%s,%s,%s,"+
+			"%d,0,0,0,100,100,0,0,1,%g,%g,%d,10,10,10,1\n\n"+
+			"[Events]\n"+
+			"Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n",
+		title,
+		opts.VideoWidth,
+		opts.VideoHeight,
+		opts.Font,
+		opts.FontSize,
+		colours[0],
+		colours[1],
+		colours[2],
+		colours[3],
+		bold,
+		opts.Outline,
+		opts.Shadow,
+		opts.Alignment,
+	), nil
+}
+
+// lineEvents places a line horizontally by the column of opts.Alignment and
+// emits one positioned event per word.
+func lineEvents(lineWords []model.Word, yPos int, pageStart, pageEnd float64, opts Options, hl highlight) []string {
+	texts := make([]string, len(lineWords))
+	for i, word := range lineWords {
+		texts[i] = strings.TrimSpace(word.Word)
+	}
+
+	lineWidthChars := utf8.RuneCountInString(strings.Join(texts, " "))
+	charWidth := float64(opts.FontSize) * charWidthRatio
+	lineWidthPixels := float64(lineWidthChars) * charWidth
+
+	// Ensure line doesn't exceed screen width with margins
+	availableWidth := float64(opts.VideoWidth - 2*opts.MarginX)
+	if lineWidthPixels > availableWidth {
+		// Scale down character width to fit
+		charWidth = availableWidth / float64(lineWidthChars)
+		lineWidthPixels = availableWidth
+	}
+
+	var lineStartX float64
+	switch opts.Alignment % 3 {
+	case 1:
+		lineStartX = float64(opts.MarginX)
+	case 0:
+		lineStartX = float64(opts.VideoWidth-opts.MarginX) - lineWidthPixels
+	default:
+		lineStartX = math.Floor((float64(opts.VideoWidth) - lineWidthPixels) / 2)
+	}
+	lineStartX = math.Max(float64(opts.MarginX), lineStartX)
+
+	events := make([]string, 0, len(lineWords))
+	currentX := lineStartX
+	for i, word := range lineWords {
+		// Add space before word (except for first word in line)
+		if i > 0 {
+			currentX += charWidth
+		}
+
+		wordWidth := float64(utf8.RuneCountInString(texts[i])) * charWidth
+		wordCenterX := currentX + math.Floor(wordWidth/2)
+
+		// Words are anchored on their centre whatever the style alignment
+		tags := fmt.Sprintf("\\an5\\pos(%.0f,%d)", wordCenterX, yPos)
+		start := word.Start
+
+		switch opts.Mode {
+		case model.CaptionModeKaraoke:
+			// \k fills a syllable with \1c once its time comes, so the page is
+			// shown in the text colour with an empty syllable delaying the sweep
+			// until the word is sung.
+			start = pageStart
+			tags += fmt.Sprintf(
+				"\\1c%s\\2c%s\\k%d}{\\kf%d",
+				hl.colour,
+				hl.primary,
+				centiseconds(word.Start-pageStart),
+				max(centiseconds(word.End-word.Start), 1),
+			)
+		case model.CaptionModePop:
+			start = pageStart
+			from := milliseconds(word.Start - pageStart)
+			to := milliseconds(word.End - pageStart)
+			tags += fmt.Sprintf(
+				"\\t(%d,%d,\\1c%s\\fscx%d\\fscy%d)\\t(%d,%d,\\1c%s\\fscx100\\fscy100)",
+				from, from, hl.colour, opts.HighlightScale, opts.HighlightScale,
+				to, to, hl.primary,
+			)
+		}
+
+		events = append(events, fmt.Sprintf(
+			"Dialogue: 0,%s,%s,Default,,0,0,0,,{%s}%s",
+			assTime(start),
+			assTime(pageEnd),
+			tags,
+			texts[i],
+		))
+
+		currentX += wordWidth
+	}
+	return events
+}
+
+// wrapLines wraps the words into lines of at most maxChars characters. A
+// single word longer than maxChars gets a line of its own.
+func wrapLines(words []model.Word, maxChars int) [][]model.Word {
+	var lines [][]model.Word
+	var currentLine []model.Word
+	currentChars := 0
+
+	for _, word := range words {
+		text := strings.TrimSpace(word.Word)
+		if text == "" {
+			continue
+		}
+
+		length := utf8.RuneCountInString(text)
+		separator := 0
+		if len(currentLine) > 0 {
+			separator = 1
+		}
+
+		if currentChars+length+separator > maxChars && len(currentLine) > 0 {
+			lines = append(lines, currentLine)
+			currentLine = nil
+			currentChars = 0
+		}
+
+		word.Word = text
+		currentLine = append(currentLine, word)
+		// Every word is counted with a trailing space
+		currentChars += length + 1
+	}
+
+	if len(currentLine) > 0 {
+		lines = append(lines, currentLine)
+	}
+	return lines
+}
+
+func centiseconds(t float64) int {
+	return int(math.Round(t * 100))
+}
+
+func milliseconds(t float64) int {
+	return int(math.Round(t * 1000))
+}
+
+// assTime formats seconds as H:MM:SS.cc. It is rounded to centiseconds
+// first, so 59.999 carries over to 0:01:00.00 rather than 0:00:60.00.
+func assTime(t float64) string {
+	const centisecondsPerHour = 360000
+	const centisecondsPerMinute = 6000
+	const centisecondsPerSecond = 100
+
+	cs := centiseconds(t)
+	h := cs / centisecondsPerHour
+	m := cs % centisecondsPerHour / centisecondsPerMinute
+	s := cs % centisecondsPerMinute / centisecondsPerSecond
+	return fmt.Sprintf("%d:%02d:%02d.%02d", h, m, s, cs%centisecondsPerSecond)
+}
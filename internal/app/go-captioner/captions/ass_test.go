@@ -0,0 +1,305 @@
+package captions
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// lucidWords is a fixed transcript long enough for two pages at the default
+// style, with a word too wide for the frame.
+var lucidWords = []model.Word{
+	{Word: " Yeah", Start: 0.0, End: 0.4},
+	{Word: " I'm", Start: 0.4, End: 0.9},
+	{Word: " tryna", Start: 0.9, End: 1.3},
+	{Word: " get", Start: 1.3, End: 1.5},
+	{Word: " it", Start: 1.5, End: 1.7},
+	{Word: " all", Start: 1.7, End: 2.0},
+	{Word: " the", Start: 2.0, End: 2.1},
+	{Word: " way", Start: 2.1, End: 2.5},
+	{Word: " up", Start: 2.5, End: 2.8},
+	{Word: " tonight", Start: 2.8, End: 3.4},
+	{Word: " extraordinarily", Start: 3.5, End: 4.5},
+	{Word: " yeah", Start: 4.6, End: 5.0},
+	{Word: " baby", Start: 5.0, End: 5.6},
+	{Word: " we", Start: 5.7, End: 5.9},
+	{Word: " go", Start: 5.9, End: 6.3},
+}
+
+func words(texts ...string) []model.Word {
+	out := make([]model.Word, len(texts))
+	for i, text := range texts {
+		out[i] = model.Word{Word: text}
+	}
+	return out
+}
+
+func lineTexts(lines [][]model.Word) []string {
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		texts := make([]string, len(line))
+		for j, word := range line {
+			texts[j] = word.Word
+		}
+		out[i] = strings.Join(texts, " ")
+	}
+	return out
+}
+
+func TestWrapLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		words    []model.Word
+		maxChars int
+		want     []string
+	}{
+		{
+			name:     "fills lines up to max chars",
+			words:    words("Yeah", "I'm", "tryna", "get", "it"),
+			maxChars: 12,
+			want:     []string{"Yeah I'm", "tryna get", "it"},
+		},
+		{
+			name:     "long word gets its own line",
+			words:    words("all", "extraordinarily", "up"),
+			maxChars: 12,
+			want:     []string{"all", "extraordinarily", "up"},
+		},
+		{
+			name:     "long first word",
+			words:    words("extraordinarily", "up"),
+			maxChars: 12,
+			want:     []string{"extraordinarily", "up"},
+		},
+		{
+			name:     "spaces trimmed and blank words skipped",
+			words:    words(" Yeah", "  ", " go "),
+			maxChars: 12,
+			want:     []string{"Yeah go"},
+		},
+		{
+			name:     "zero max chars puts every word on a line",
+			words:    words("we", "go"),
+			maxChars: 0,
+			want:     []string{"we", "go"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := lineTexts(wrapLines(test.words, test.maxChars))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("wrapLines = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// TestWriteASSMatchesPython checks the layout against what write_dynamic_ass
+// in the old generate_captions.py wrote for the same transcript, which placed
+// pages from the top like alignment 8.
+func TestWriteASSMatchesPython(t *testing.T) {
+	want := []string{
+		`Dialogue: 0,0:00:00.00,0:00:03.50,Default,,0,0,0,,{\pos(340,380)}Yeah`,
+		`Dialogue: 0,0:00:00.40,0:00:03.50,Default,,0,0,0,,{\pos(790,380)}I'm`,
+		`Dialogue: 0,0:00:00.90,0:00:03.50,Default,,0,0,0,,{\pos(340,660)}tryna`,
+		`Dialogue: 0,0:00:01.30,0:00:03.50,Default,,0,0,0,,{\pos(840,660)}get`,
+		`Dialogue: 0,0:00:01.50,0:00:03.50,Default,,0,0,0,,{\pos(156,940)}it`,
+		`Dialogue: 0,0:00:01.70,0:00:03.50,Default,,0,0,0,,{\pos(492,940)}all`,
+		`Dialogue: 0,0:00:02.00,0:00:03.50,Default,,0,0,0,,{\pos(876,940)}the`,
+		`Dialogue: 0,0:00:02.10,0:00:03.50,Default,,0,0,0,,{\pos(390,1220)}way`,
+		`Dialogue: 0,0:00:02.50,0:00:03.50,Default,,0,0,0,,{\pos(740,1220)}up`,
+		`Dialogue: 0,0:00:02.80,0:00:03.50,Default,,0,0,0,,{\pos(540,1500)}tonight`,
+		`Dialogue: 0,0:00:03.50,0:00:06.30,Default,,0,0,0,,{\pos(540,380)}extraordinarily`,
+		`Dialogue: 0,0:00:04.60,0:00:06.30,Default,,0,0,0,,{\pos(290,660)}yeah`,
+		`Dialogue: 0,0:00:05.00,0:00:06.30,Default,,0,0,0,,{\pos(790,660)}baby`,
+		`Dialogue: 0,0:00:05.70,0:00:06.30,Default,,0,0,0,,{\pos(390,940)}we`,
+		`Dialogue: 0,0:00:05.90,0:00:06.30,Default,,0,0,0,,{\pos(690,940)}go`,
+	}
+
+	var out bytes.Buffer
+	if err := WriteASS(&out, &model.Transcript{Words: lucidWords}, *NewOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "Dialogue:") {
+			// Python relied on the style's alignment instead of \an5
+			got = append(got, strings.Replace(line, `\an5`, "", 1))
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWriteASSPages(t *testing.T) {
+	tests := []struct {
+		name        string
+		videoHeight int
+		// wantEnds is when each word's event ends, the end of its page. The
+		// words wrap to "Yeah I'm", "tryna get" and "it all".
+		wantEnds []string
+	}{
+		{
+			name:        "one line per page",
+			videoHeight: 480 + 280,
+			wantEnds:    []string{"0:00:00.90", "0:00:00.90", "0:00:01.50", "0:00:01.50", "0:00:02.00", "0:00:02.00"},
+		},
+		{
+			name:        "two lines per page",
+			videoHeight: 480 + 2*280,
+			wantEnds:    []string{"0:00:01.50", "0:00:01.50", "0:00:01.50", "0:00:01.50", "0:00:02.00", "0:00:02.00"},
+		},
+		{
+			name:        "everything on one page",
+			videoHeight: 1920,
+			wantEnds:    []string{"0:00:02.00", "0:00:02.00", "0:00:02.00", "0:00:02.00", "0:00:02.00", "0:00:02.00"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := NewOptions(func(o *Options) {
+				o.VideoHeight = test.videoHeight
+			})
+
+			var out bytes.Buffer
+			if err := WriteASS(&out, &model.Transcript{Words: lucidWords[:6]}, *opts); err != nil {
+				t.Fatal(err)
+			}
+
+			var ends []string
+			for _, line := range strings.Split(out.String(), "\n") {
+				if fields := strings.Split(line, ","); strings.HasPrefix(line, "Dialogue:") {
+					ends = append(ends, fields[2])
+				}
+			}
+			if !reflect.DeepEqual(ends, test.wantEnds) {
+				t.Errorf("event ends = %q, want %q", ends, test.wantEnds)
+			}
+		})
+	}
+}
+
+func TestPageTop(t *testing.T) {
+	tests := []struct {
+		alignment int
+		want      int
+	}{
+		{alignment: 2, want: 1920 - 240 - 560},
+		{alignment: 5, want: (1920 - 560) / 2},
+		{alignment: 8, want: 240},
+	}
+
+	for _, test := range tests {
+		opts := NewOptions(func(o *Options) {
+			o.Alignment = test.alignment
+		})
+		if got := pageTop(2, 280, *opts); got != test.want {
+			t.Errorf("pageTop with alignment %d = %d, want %d", test.alignment, got, test.want)
+		}
+	}
+}
+
+func TestLineEventsScalesDownWideLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []model.Word
+		want  []string
+	}{
+		{
+			name:  "fits",
+			words: words("tryna", "get"),
+			want:  []string{`\pos(340,100)`, `\pos(840,100)`},
+		},
+		{
+			// 15 characters at 100px overflow the 960px between the margins,
+			// so each is squeezed to 64px
+			name:  "too wide",
+			words: words("extraordinarily"),
+			want:  []string{`\pos(540,100)`},
+		},
+		{
+			name:  "too wide over several words",
+			words: words("it", "all", "the"),
+			want:  []string{`\pos(156,100)`, `\pos(492,100)`, `\pos(876,100)`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			events := lineEvents(test.words, 100, 0, 1, *NewOptions(), highlight{})
+			if len(events) != len(test.want) {
+				t.Fatalf("got %d events, want %d", len(events), len(test.want))
+			}
+			for i, event := range events {
+				if !strings.Contains(event, test.want[i]) {
+					t.Errorf("event %d = %s, want %s", i, event, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLineEventsHighlightTags(t *testing.T) {
+	hl := highlight{primary: "&H000000&", colour: "&H0000FF&"}
+	word := []model.Word{{Word: "tryna", Start: 1.25, End: 1.6}}
+
+	tests := []struct {
+		mode model.CaptionMode
+		want string
+	}{
+		{
+			mode: model.CaptionModeAppear,
+			want: `Dialogue: 0,0:00:01.25,0:00:03.00,Default,,0,0,0,,{\an5\pos(540,100)}tryna`,
+		},
+		{
+			mode: model.CaptionModeKaraoke,
+			want: `Dialogue: 0,0:00:01.00,0:00:03.00,Default,,0,0,0,,{\an5\pos(540,100)\1c&H0000FF&\2c&H000000&\k25}{\kf35}tryna`,
+		},
+		{
+			mode: model.CaptionModePop,
+			want: `Dialogue: 0,0:00:01.00,0:00:03.00,Default,,0,0,0,,{\an5\pos(540,100)` +
+				`\t(250,250,\1c&H0000FF&\fscx115\fscy115)\t(600,600,\1c&H000000&\fscx100\fscy100)}tryna`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.mode), func(t *testing.T) {
+			opts := NewOptions(func(o *Options) {
+				o.Mode = test.mode
+			})
+			events := lineEvents(word, 100, 1, 3, *opts, hl)
+			if len(events) != 1 || events[0] != test.want {
+				t.Errorf("events = %q, want %q", events, test.want)
+			}
+		})
+	}
+}
+
+func TestAssTime(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		want    string
+	}{
+		{seconds: 0, want: "0:00:00.00"},
+		{seconds: 3.5, want: "0:00:03.50"},
+		{seconds: 3.456, want: "0:00:03.46"},
+		{seconds: 3.454, want: "0:00:03.45"},
+		{seconds: 59.999, want: "0:01:00.00"},
+		{seconds: 61.23, want: "0:01:01.23"},
+		{seconds: 3599.996, want: "1:00:00.00"},
+		{seconds: 3725.5, want: "1:02:05.50"},
+	}
+
+	for _, test := range tests {
+		if got := assTime(test.seconds); got != test.want {
+			t.Errorf("assTime(%g) = %s, want %s", test.seconds, got, test.want)
+		}
+	}
+}
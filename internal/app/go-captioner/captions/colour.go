@@ -0,0 +1,49 @@
+package captions
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	hexColourPattern = regexp.MustCompile(`^#([0-9A-Fa-f]{6})([0-9A-Fa-f]{2})?$`)
+	assColourPattern = regexp.MustCompile(`^&H[0-9A-Fa-f]{8}$`)
+)
+
+// assColour converts "#RRGGBB" or "#RRGGBBAA" to ASS "&HAABBGGRR". ASS stores
+// transparency rather than opacity, so the alpha byte is inverted. Colours
+// already in ASS form are passed through.
+func assColour(colour string) (string, error) {
+	if assColourPattern.MatchString(colour) {
+		return strings.ToUpper(colour[:2]) + strings.ToUpper(colour[2:]), nil
+	}
+
+	match := hexColourPattern.FindStringSubmatch(colour)
+	if match == nil {
+		return "", fmt.Errorf("invalid colour %q, expected #RRGGBB, #RRGGBBAA or &HAABBGGRR", colour)
+	}
+
+	rgb := strings.ToUpper(match[1])
+	alpha := 0
+	if match[2] != "" {
+		opacity, err := strconv.ParseUint(match[2], 16, 8)
+		if err != nil {
+			return "", err
+		}
+		alpha = 0xFF - int(opacity)
+	}
+
+	return fmt.Sprintf("&H%02X%s%s%s", alpha, rgb[4:6], rgb[2:4], rgb[0:2]), nil
+}
+
+// overrideColour converts colour to the "&HBBGGRR&" form taken by override tags
+// such as \1c. Override colours carry no alpha, so any transparency is dropped.
+func overrideColour(colour string) (string, error) {
+	converted, err := assColour(colour)
+	if err != nil {
+		return "", err
+	}
+	return "&H" + converted[4:] + "&", nil
+}
@@ -0,0 +1,29 @@
+package captions
+
+import "github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+
+// Options controls the layout of the rendered captions. The defaults are tuned
+// for 1080x1920 portrait video.
+type Options struct {
+	model.CaptionStyle
+
+	VideoWidth  int
+	VideoHeight int
+	// Title names the track in the script's header, for players that show it.
+	Title string
+}
+
+func NewOptions(opts ...func(*Options)) *Options {
+	const defaultVideoWidth = 1080
+	const defaultVideoHeight = 1920
+
+	props := Options{
+		CaptionStyle: *model.NewCaptionStyle(),
+		VideoWidth:   defaultVideoWidth,
+		VideoHeight:  defaultVideoHeight,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
@@ -0,0 +1,214 @@
+package censor
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+const (
+	leadingPunctuation  = "([{\"'“‘"
+	trailingPunctuation = ")]}.!,?;:\"'”’…"
+)
+
+// Transcribers spell some letters with look-alikes, as in "$hit" or "b1tch",
+// or star them out, as in "sh*t". Look-alikes are read as the letter and a
+// star matches any letter of a rule's word.
+var lookAlikes = strings.NewReplacer("@", "a", "$", "s", "0", "o", "1", "i", "3", "e", "!", "i")
+
+const wildcard = '*'
+
+// Filter censors words by a resolved list's rules, the first matching rule
+// deciding how a word is replaced.
+type Filter struct {
+	rules []rule
+}
+
+type rule struct {
+	Rule
+	word    []rune
+	pattern *regexp.Regexp
+	emoji   string
+}
+
+// compile prepares r to be matched, masking it as style says when it has no
+// mask of its own.
+func compile(r Rule, style List) rule {
+	if r.Match == "" {
+		r.Match = MatchExact
+	}
+	if r.Mask == "" {
+		r.Mask = style.Mask
+	}
+	if r.Mask == "" {
+		r.Mask = MaskFirstLetter
+	}
+
+	compiled := rule{Rule: r, emoji: style.Emoji}
+	if compiled.emoji == "" {
+		compiled.emoji = defaultEmoji
+	}
+	if r.Match == MatchRegex {
+		// Patterns were checked when the lists were loaded
+		compiled.pattern = regexp.MustCompile(`^(?i:` + r.Pattern + `)$`)
+	} else {
+		compiled.word = []rune(strings.ToLower(strings.TrimSpace(r.Word)))
+	}
+	return compiled
+}
+
+// String describes the rule in the audit log, e.g. "stem fuck".
+func (r rule) String() string {
+	if r.Match == MatchRegex {
+		return fmt.Sprintf("%s %s", r.Match, r.Pattern)
+	}
+	return fmt.Sprintf("%s %s", r.Match, string(r.word))
+}
+
+// Apply censors word, ignoring surrounding punctuation and keeping simple
+// capitalisation such as "Shit" -> "S***". It returns the censored word and
+// the rule that matched, or word unchanged and "" if none did. Dropped words
+// lose their punctuation too, leaving "".
+func (f *Filter) Apply(word string) (string, string) {
+	core := strings.TrimLeft(word, leadingPunctuation)
+	leading := word[:len(word)-len(core)]
+
+	trimmed := strings.TrimRight(core, trailingPunctuation)
+	trailing := core[len(trimmed):]
+	core = trimmed
+
+	if core == "" || f == nil {
+		return word, ""
+	}
+
+	for _, r := range f.rules {
+		if !r.matches(core) {
+			continue
+		}
+		replacement := r.replace(core)
+		if replacement == "" {
+			return "", r.String()
+		}
+		return leading + replacement + trailing, r.String()
+	}
+	return word, ""
+}
+
+// Transcript returns transcript with its words censored, dropping those
+// masked with MaskDrop, along with a record of every word replaced.
+func (f *Filter) Transcript(transcript *model.Transcript) (*model.Transcript, []model.CensorEvent) {
+	censored := &model.Transcript{Language: transcript.Language}
+	var events []model.CensorEvent
+	for _, word := range transcript.Words {
+		text := strings.TrimSpace(word.Word)
+		replacement, matched := f.Apply(text)
+		if matched != "" {
+			events = append(events, model.CensorEvent{
+				Word:        text,
+				Replacement: replacement,
+				Rule:        matched,
+				Start:       word.Start,
+				End:         word.End,
+			})
+		}
+		if replacement == "" {
+			continue
+		}
+		word.Word = replacement
+		censored.Words = append(censored.Words, word)
+	}
+	return censored, events
+}
+
+func (r rule) matches(core string) bool {
+	if r.pattern != nil {
+		return r.pattern.MatchString(core)
+	}
+
+	key := []rune(lookAlikes.Replace(strings.ToLower(core)))
+	switch r.Match {
+	case MatchStem:
+		return len(key) >= len(r.word) && sameLetters(key[:len(r.word)], r.word)
+	default:
+		return len(key) == len(r.word) && sameLetters(key, r.word)
+	}
+}
+
+// sameLetters reports whether key spells word, with stars in key matching any
+// letter, as long as key isn't all stars.
+func sameLetters(key, word []rune) bool {
+	starred := 0
+	for i := range key {
+		if key[i] == wildcard {
+			starred++
+			continue
+		}
+		if key[i] != word[i] {
+			return false
+		}
+	}
+	return starred < len(key)
+}
+
+func (r rule) replace(core string) string {
+	replacement := r.Replacement
+	if replacement == "" {
+		switch r.Mask {
+		case MaskDrop:
+			return ""
+		case MaskEmoji:
+			return r.emoji
+		case MaskAsterisks:
+			return strings.Repeat("*", utf8.RuneCountInString(core))
+		default:
+			// The first letter keeps its case, so there is nothing to restore
+			first, size := utf8.DecodeRuneInString(core)
+			return string(first) + strings.Repeat("*", utf8.RuneCountInString(core[size:]))
+		}
+	}
+
+	// Preserve simple capitalization patterns
+	first, size := utf8.DecodeRuneInString(core)
+	switch {
+	case isUpper(core):
+		replacement = strings.ToUpper(replacement)
+	case unicode.IsUpper(first) && isLower(core[size:]):
+		r, n := utf8.DecodeRuneInString(replacement)
+		replacement = string(unicode.ToUpper(r)) + replacement[n:]
+	}
+	return replacement
+}
+
+// isUpper reports whether s has at least one cased letter and no lower case
+// ones, matching Python's str.isupper.
+func isUpper(s string) bool {
+	cased := false
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return false
+		}
+		if unicode.IsUpper(r) || unicode.IsTitle(r) {
+			cased = true
+		}
+	}
+	return cased
+}
+
+// isLower reports whether s has at least one cased letter and no upper case
+// ones, matching Python's str.islower.
+func isLower(s string) bool {
+	cased := false
+	for _, r := range s {
+		if unicode.IsUpper(r) || unicode.IsTitle(r) {
+			return false
+		}
+		if unicode.IsLower(r) {
+			cased = true
+		}
+	}
+	return cased
+}
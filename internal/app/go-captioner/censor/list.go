@@ -0,0 +1,232 @@
+// Package censor masks explicit words in transcripts by lists of exact,
+// stem and regex rules, recording every word it replaces.
+//
+// Lists may include others. Rules without a mask of their own are masked the
+// way of the list picked, so "clean" including "default" shows default's words
+// with clean's mask. An included list's mask only applies when no list
+// including it sets one.
+package censor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultList is the list used when none is named.
+const DefaultList = "default"
+
+// Words masked with MaskEmoji become this unless their list gives another.
+const defaultEmoji = "🤬"
+
+// Match is how a rule matches words.
+type Match string
+
+const (
+	// MatchExact matches the word itself, e.g. "shit".
+	MatchExact Match = "exact"
+	// MatchStem matches words starting with it, e.g. "fuck" matches "fuckin"
+	// and "fuckers".
+	MatchStem Match = "stem"
+	// MatchRegex matches words its pattern matches in full, ignoring case,
+	// e.g. `\w*fuck\w*` for compounds like "motherfucker".
+	MatchRegex Match = "regex"
+)
+
+var Matches = []Match{MatchExact, MatchStem, MatchRegex}
+
+// Mask is how a matched word is replaced.
+type Mask string
+
+const (
+	// MaskAsterisks replaces every letter, "****".
+	MaskAsterisks Mask = "asterisks"
+	// MaskFirstLetter keeps the first letter, "s***".
+	MaskFirstLetter Mask = "first-letter"
+	// MaskEmoji replaces the word with an emoji.
+	MaskEmoji Mask = "emoji"
+	// MaskDrop leaves the word out of the captions.
+	MaskDrop Mask = "drop"
+)
+
+var Masks = []Mask{MaskAsterisks, MaskFirstLetter, MaskEmoji, MaskDrop}
+
+// Rule censors the words it matches. Exact and stem rules give a Word and
+// regex rules a Pattern. Replacement, when set, is used in place of the mask.
+type Rule struct {
+	Word        string `yaml:"word,omitempty"`
+	Pattern     string `yaml:"pattern,omitempty"`
+	Match       Match  `yaml:"match,omitempty"`
+	Mask        Mask   `yaml:"mask,omitempty"`
+	Replacement string `yaml:"replacement,omitempty"`
+}
+
+// List is a named set of rules. Rules without a match are exact and those
+// without a mask take the list's, first-letter if it has none.
+type List struct {
+	// Include names other lists whose rules apply too, after the list's own.
+	// Their rules take this list's mask and emoji when it sets them.
+	Include []string `yaml:"include,omitempty"`
+	Mask    Mask     `yaml:"mask,omitempty"`
+	// Emoji replaces words masked with MaskEmoji.
+	Emoji string `yaml:"emoji,omitempty"`
+	Rules []Rule `yaml:"rules,omitempty"`
+}
+
+// Lists are the lists of a censor file by name.
+type Lists map[string]List
+
+type censorFile struct {
+	Lists Lists `yaml:"lists"`
+}
+
+// Load reads the censor lists in the YAML file at path. Files without a lists
+// key are read as the older JSON object of {"word": "replacement"}, becoming
+// a default list of exact rules. A missing file has no lists, so nothing is
+// censored.
+func Load(path string) (Lists, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var keys map[string]any
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("parsing censor lists %s: %w", path, err)
+	}
+	if _, ok := keys["lists"]; !ok {
+		return loadReplacements(path, data)
+	}
+
+	var file censorFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parsing censor lists %s: %w", path, err)
+	}
+	if err := file.Lists.validate(); err != nil {
+		return nil, fmt.Errorf("censor lists %s: %w", path, err)
+	}
+	return file.Lists, nil
+}
+
+// loadReplacements reads a JSON object of {"word": "replacement"}.
+func loadReplacements(path string, data []byte) (Lists, error) {
+	var replacements map[string]string
+	if err := yaml.Unmarshal(data, &replacements); err != nil {
+		return nil, fmt.Errorf("parsing censor map %s: %w", path, err)
+	}
+
+	list := List{}
+	for _, word := range slices.Sorted(maps.Keys(replacements)) {
+		list.Rules = append(list.Rules, Rule{Word: word, Replacement: replacements[word]})
+	}
+	return Lists{DefaultList: list}, nil
+}
+
+func (l Lists) validate() error {
+	for name, list := range l {
+		if list.Mask != "" && !slices.Contains(Masks, list.Mask) {
+			return fmt.Errorf("list %s has unknown mask %s, expected one of %s", name, list.Mask, joinNames(Masks))
+		}
+		for _, include := range list.Include {
+			if _, ok := l[include]; !ok {
+				return fmt.Errorf("list %s includes unknown list %s", name, include)
+			}
+		}
+		for i, rule := range list.Rules {
+			if err := rule.validate(); err != nil {
+				return fmt.Errorf("list %s rule %d: %w", name, i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r Rule) validate() error {
+	switch r.Match {
+	case "", MatchExact, MatchStem:
+		if strings.TrimSpace(r.Word) == "" {
+			return errors.New("exact and stem rules need a word")
+		}
+	case MatchRegex:
+		if r.Pattern == "" {
+			return errors.New("regex rules need a pattern")
+		}
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", r.Pattern, err)
+		}
+	default:
+		return fmt.Errorf("unknown match %s, expected one of %s", r.Match, joinNames(Matches))
+	}
+	if r.Mask != "" && !slices.Contains(Masks, r.Mask) {
+		return fmt.Errorf("unknown mask %s, expected one of %s", r.Mask, joinNames(Masks))
+	}
+	return nil
+}
+
+// Filter returns a filter applying the rules of the named list and those it
+// includes. Without any lists nothing is censored, whatever the name.
+func (l Lists) Filter(name string) (*Filter, error) {
+	if len(l) == 0 {
+		return &Filter{}, nil
+	}
+	if _, ok := l[name]; !ok {
+		return nil, fmt.Errorf("unknown censor list %s", name)
+	}
+
+	filter := &Filter{}
+	visited := map[string]bool{}
+	// style carries the mask and emoji of the lists including this one, which
+	// win over its own
+	var visit func(name string, path []string, style List) error
+	visit = func(name string, path []string, style List) error {
+		if slices.Contains(path, name) {
+			return fmt.Errorf("censor lists include each other: %s", strings.Join(append(path, name), " -> "))
+		}
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+
+		list := l[name]
+		if style.Mask == "" {
+			style.Mask = list.Mask
+		}
+		if style.Emoji == "" {
+			style.Emoji = list.Emoji
+		}
+		for _, rule := range list.Rules {
+			filter.rules = append(filter.rules, compile(rule, style))
+		}
+		for _, include := range list.Include {
+			if err := visit(include, append(path, name), style); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(name, nil, List{}); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func joinNames[T ~string](names []T) string {
+	s := make([]string, len(names))
+	for i, name := range names {
+		s[i] = string(name)
+	}
+	return strings.Join(s, ", ")
+}
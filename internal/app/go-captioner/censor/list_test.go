@@ -0,0 +1,252 @@
+package censor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func exact(words ...string) []Rule {
+	rules := make([]Rule, len(words))
+	for i, word := range words {
+		rules[i] = Rule{Word: word}
+	}
+	return rules
+}
+
+func TestFilterIncludes(t *testing.T) {
+	lists := Lists{
+		"strict":  {Include: []string{"clean", "default"}, Rules: exact("hoe")},
+		"clean":   {Include: []string{"default"}, Rules: exact("ass")},
+		"default": {Rules: exact("shit")},
+		"other":   {Rules: exact("damn")},
+	}
+
+	tests := []struct {
+		list     string
+		censored []string
+		kept     []string
+	}{
+		{list: "default", censored: []string{"shit"}, kept: []string{"ass", "hoe", "damn"}},
+		{list: "clean", censored: []string{"ass", "shit"}, kept: []string{"hoe", "damn"}},
+		// default is reached twice but only applied once
+		{list: "strict", censored: []string{"hoe", "ass", "shit"}, kept: []string{"damn"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.list, func(t *testing.T) {
+			filter, err := lists.Filter(test.list)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, word := range test.censored {
+				if _, matched := filter.Apply(word); matched == "" {
+					t.Errorf("%s left %s uncensored", test.list, word)
+				}
+			}
+			for _, word := range test.kept {
+				if got, matched := filter.Apply(word); matched != "" {
+					t.Errorf("%s censored %s to %s by %s", test.list, word, got, matched)
+				}
+			}
+		})
+	}
+
+	strict, _ := lists.Filter("strict")
+	if n := len(strict.rules); n != 3 {
+		t.Errorf("strict has %d rules, want 3 with default applied once", n)
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists Lists
+		list  string
+		want  string
+	}{
+		{
+			name:  "unknown list",
+			lists: Lists{"default": {Rules: exact("shit")}},
+			list:  "clean",
+			want:  "unknown censor list clean",
+		},
+		{
+			name:  "includes itself",
+			lists: Lists{"default": {Include: []string{"default"}}},
+			list:  "default",
+			want:  "default -> default",
+		},
+		{
+			name: "include cycle",
+			lists: Lists{
+				"clean":   {Include: []string{"default"}},
+				"default": {Include: []string{"strict"}},
+				"strict":  {Include: []string{"clean"}},
+			},
+			list: "clean",
+			want: "clean -> default -> strict -> clean",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.lists.Filter(test.list)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Filter(%s) error = %v, want one containing %q", test.list, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFilterWithoutLists(t *testing.T) {
+	filter, err := Lists(nil).Filter("anything")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, matched := filter.Apply("shit"); got != "shit" || matched != "" {
+		t.Errorf("Apply = %s, %s, want the word unchanged", got, matched)
+	}
+}
+
+func TestFilterMasks(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists Lists
+		list  string
+		word  string
+		want  string
+	}{
+		{
+			name:  "first letter when nothing sets a mask",
+			lists: Lists{"default": {Rules: exact("shit")}},
+			list:  "default",
+			word:  "Shit",
+			want:  "S***",
+		},
+		{
+			name:  "list mask",
+			lists: Lists{"default": {Mask: MaskAsterisks, Rules: exact("shit")}},
+			list:  "default",
+			word:  "shit!",
+			want:  "****!",
+		},
+		{
+			name:  "rule mask over list mask",
+			lists: Lists{"default": {Mask: MaskAsterisks, Rules: []Rule{{Word: "shit", Mask: MaskDrop}}}},
+			list:  "default",
+			word:  "shit",
+			want:  "",
+		},
+		{
+			name:  "replacement over masks",
+			lists: Lists{"default": {Mask: MaskAsterisks, Rules: []Rule{{Word: "damn", Replacement: "dang"}}}},
+			list:  "default",
+			word:  "Damn",
+			want:  "Dang",
+		},
+		{
+			name: "including list's mask over included list's",
+			lists: Lists{
+				"clean":   {Include: []string{"default"}, Mask: MaskAsterisks},
+				"default": {Mask: MaskFirstLetter, Rules: exact("shit")},
+			},
+			list: "clean",
+			word: "shit",
+			want: "****",
+		},
+		{
+			name: "included list's mask when the including list has none",
+			lists: Lists{
+				"clean":   {Include: []string{"default"}},
+				"default": {Mask: MaskAsterisks, Rules: exact("shit")},
+			},
+			list: "clean",
+			word: "shit",
+			want: "****",
+		},
+		{
+			name: "outermost mask through nested includes",
+			lists: Lists{
+				"strict":  {Include: []string{"clean"}, Mask: MaskEmoji, Emoji: "🙊"},
+				"clean":   {Include: []string{"default"}, Mask: MaskAsterisks},
+				"default": {Mask: MaskFirstLetter, Rules: exact("shit")},
+			},
+			list: "strict",
+			word: "shit",
+			want: "🙊",
+		},
+		{
+			name: "included rule's own mask kept",
+			lists: Lists{
+				"clean":   {Include: []string{"default"}, Mask: MaskAsterisks},
+				"default": {Rules: []Rule{{Word: "shit", Mask: MaskDrop}}},
+			},
+			list: "clean",
+			word: "shit",
+			want: "",
+		},
+		{
+			name:  "default emoji",
+			lists: Lists{"default": {Mask: MaskEmoji, Rules: exact("shit")}},
+			list:  "default",
+			word:  "shit",
+			want:  defaultEmoji,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := test.lists.Filter(test.list)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := filter.Apply(test.word); got != test.want {
+				t.Errorf("Apply(%s) = %q, want %q", test.word, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsUnknownInclude(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "censor.yaml")
+	data := "lists:\n  clean:\n    include: [defualt]\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Load(path)
+	if err == nil || !strings.Contains(err.Error(), "includes unknown list defualt") {
+		t.Errorf("Load error = %v, want the unknown include", err)
+	}
+}
+
+// TestShippedLists checks scripts/censor.yaml masks words as its comments say.
+func TestShippedLists(t *testing.T) {
+	lists, err := Load(filepath.Join("..", "..", "..", "..", "scripts", "censor.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		list string
+		word string
+		want string
+	}{
+		{list: "default", word: "Fuckin", want: "F*****"},
+		{list: "default", word: "ass", want: "ass"},
+		{list: "clean", word: "Fuckin", want: "******"},
+		{list: "clean", word: "ass", want: "***"},
+	}
+
+	for _, test := range tests {
+		filter, err := lists.Filter(test.list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := filter.Apply(test.word); got != test.want {
+			t.Errorf("%s: Apply(%s) = %q, want %q", test.list, test.word, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/ent"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func AudioToDTO(a *ent.Audio) *model.AudioDTO {
+	return &model.AudioDTO{
+		ID:         a.ID,
+		Hash:       a.Hash,
+		Path:       a.Path,
+		Duration:   a.Duration,
+		Codec:      a.Codec,
+		SampleRate: a.SampleRate,
+		Channels:   a.Channels,
+		Loudness:   a.Loudness,
+		Tags:       a.Tags,
+		ScannedAt:  a.ScannedAt,
+	}
+}
+
+// ValidateAudio checks audio is long enough for the window from startTime to
+// endTime, or, when the window is picked automatically, for its length.
+func ValidateAudio(audio *model.AudioDTO, startTime, endTime string, autoWindow bool) error {
+	start, end, err := windowSeconds(startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	if autoWindow {
+		if audio.Duration < float64(end-start) {
+			return fmt.Errorf("audio %s is %.1fs, shorter than the %ds window", audio.Path, audio.Duration, end-start)
+		}
+		return nil
+	}
+	if audio.Duration < float64(end) {
+		return fmt.Errorf("audio %s is %.1fs, ending before the window does at %ds", audio.Path, audio.Duration, end)
+	}
+	return nil
+}
+
+// ValidateBackground checks video is long enough to cut the window from
+// startTime to endTime from. Videos that haven't been scanned pass.
+func ValidateBackground(video *model.BackgroundVideoDTO, startTime, endTime string) error {
+	start, end, err := windowSeconds(startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	if video.Duration != nil && *video.Duration < float64(end-start) {
+		return fmt.Errorf("background %s is %.1fs, shorter than the %ds window", video.Path, *video.Duration, end-start)
+	}
+	return nil
+}
+
+func windowSeconds(startTime, endTime string) (int, int, error) {
+	length, err := SecondsFromStartAndEnd(startTime, endTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	// Already parsed by SecondsFromStartAndEnd
+	start, _ := strconv.Atoi(startTime)
+	return start, start + length, nil
+}
+
+// ValidateClipAssets checks the clip's catalogued audio and background video
+// can be rendered with opts, so a clip that can't be fails before any script
+// runs. Inputs that haven't been catalogued pass.
+func ValidateClipAssets(
+	clip *model.ClipDTO,
+	audio *model.AudioDTO,
+	video *model.BackgroundVideoDTO,
+	opts model.CommonOptions,
+) error {
+	startTime, endTime := clip.Window(opts.StartTime, opts.EndTime)
+
+	if audio != nil {
+		autoWindow := opts.AutoWindow && clip.StartTime == ""
+		if err := ValidateAudio(audio, startTime, endTime, autoWindow); err != nil {
+			return err
+		}
+	}
+
+	// Beat-synced backgrounds are cut from every video, only needing a beat
+	// or two from each
+	if video != nil && !opts.BeatSync && clip.SegmentPlan == nil {
+		if err := ValidateBackground(video, startTime, endTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
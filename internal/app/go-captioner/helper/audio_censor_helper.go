@@ -0,0 +1,38 @@
+package helper
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// AudioCensorSpans returns the stretches of audio to hide for the censored
+// words in events, each widened by padding seconds either side and kept
+// within the clip's duration. Spans that overlap or touch are merged, so
+// words sung back to back are hidden in one go.
+func AudioCensorSpans(events []model.CensorEvent, padding, duration float64) []model.TimeSpan {
+	spans := make([]model.TimeSpan, 0, len(events))
+	for _, event := range events {
+		span := model.TimeSpan{
+			Start: max(event.Start-padding, 0),
+			End:   min(event.End+padding, duration),
+		}
+		if span.End > span.Start {
+			spans = append(spans, span)
+		}
+	}
+	slices.SortFunc(spans, func(a, b model.TimeSpan) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	var merged []model.TimeSpan
+	for _, span := range spans {
+		if n := len(merged); n > 0 && span.Start <= merged[n-1].End {
+			merged[n-1].End = max(merged[n-1].End, span.End)
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/ent"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func BackgroundVideoToDTO(v *ent.BackgroundVideo) *model.BackgroundVideoDTO {
+	return &model.BackgroundVideoDTO{
+		ID:         v.ID,
+		Path:       v.Path,
+		Weight:     v.Weight,
+		UseCount:   v.UseCount,
+		LastUsedAt: v.LastUsedAt,
+		Hash:       v.Hash,
+		Duration:   v.Duration,
+		Codec:      v.Codec,
+		Width:      v.Width,
+		Height:     v.Height,
+		FPS:        v.Fps,
+		Tags:       v.Tags,
+		ScannedAt:  v.ScannedAt,
+	}
+}
+
+// PickBackground chooses one of videos with strategy. Ties are broken by path
+// so the deterministic strategies don't depend on the order videos are given.
+func PickBackground(
+	videos []*model.BackgroundVideoDTO,
+	strategy model.BackgroundStrategy,
+	rng *rand.Rand,
+) (*model.BackgroundVideoDTO, error) {
+	if len(videos) == 0 {
+		return nil, errors.New("no background videos to pick from")
+	}
+
+	videos = slices.Clone(videos)
+	slices.SortFunc(videos, func(a, b *model.BackgroundVideoDTO) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	switch strategy {
+	case model.BackgroundStrategyRoundRobin:
+		// Videos never used come first, then the one used longest ago
+		return slices.MinFunc(videos, func(a, b *model.BackgroundVideoDTO) int {
+			switch {
+			case a.LastUsedAt == nil && b.LastUsedAt == nil:
+				return 0
+			case a.LastUsedAt == nil:
+				return -1
+			case b.LastUsedAt == nil:
+				return 1
+			}
+			return a.LastUsedAt.Compare(*b.LastUsedAt)
+		}), nil
+	case model.BackgroundStrategyLeastUsed:
+		return slices.MinFunc(videos, func(a, b *model.BackgroundVideoDTO) int {
+			return a.UseCount - b.UseCount
+		}), nil
+	case model.BackgroundStrategyWeighted:
+		// Each use divides a video's chance, so heavily weighted videos still
+		// give way to the rest over a long run
+		weights := make([]float64, len(videos))
+		var total float64
+		for i, video := range videos {
+			weights[i] = max(video.Weight, 0) / float64(1+video.UseCount)
+			total += weights[i]
+		}
+		if total == 0 {
+			return nil, errors.New("every background video has a weight of 0")
+		}
+
+		target := rng.Float64() * total
+		for i, weight := range weights {
+			if target < weight {
+				return videos[i], nil
+			}
+			target -= weight
+		}
+		return videos[len(videos)-1], nil
+	default:
+		return videos[rng.Intn(len(videos))], nil
+	}
+}
@@ -0,0 +1,162 @@
+package helper
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// defaultBioTemplate is used for artists without a template of their own.
+const defaultBioTemplate = "default"
+
+var (
+	// Markdown headings, which title a template rather than being part of it.
+	// Hashtags have no space after the #.
+	bioHeadingPattern = regexp.MustCompile(`^#{1,6}\s`)
+	slugPattern       = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// ClipBioData returns what the clip's bio template can use.
+func ClipBioData(clip *model.ClipDTO, defaultStart, defaultEnd string) (model.BioData, error) {
+	track := ClipTrackInfo(clip)
+
+	startTime, endTime := clip.Window(defaultStart, defaultEnd)
+	start, err := strconv.Atoi(startTime)
+	if err != nil {
+		return model.BioData{}, fmt.Errorf("invalid start time %s: %w", startTime, err)
+	}
+	end, err := strconv.Atoi(endTime)
+	if err != nil {
+		return model.BioData{}, fmt.Errorf("invalid end time %s: %w", endTime, err)
+	}
+
+	return model.BioData{
+		Artist:   track.Artist,
+		Track:    track.Title,
+		Album:    track.Album,
+		Duration: end - start,
+		Start:    start,
+		End:      end,
+	}, nil
+}
+
+// FindBioTemplate returns the template in dir for artist, the one named after
+// them as in matchArtist, e.g. juice-wrld.md for Juice WRLD, or else
+// default.md.
+func FindBioTemplate(dir, artist string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("reading bio templates: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), ".md"))
+	}
+
+	switch name := matchArtist(names, artist); {
+	case name != "":
+		return filepath.Join(dir, name+".md"), nil
+	case slices.Contains(names, defaultBioTemplate):
+		return filepath.Join(dir, defaultBioTemplate+".md"), nil
+	case artist == "":
+		return "", fmt.Errorf("no %s.md bio template in %s for clips with no artist", defaultBioTemplate, dir)
+	default:
+		return "", fmt.Errorf("no bio template in %s for %s, add %s.md or %s.md", dir, artist, slug(artist), defaultBioTemplate)
+	}
+}
+
+// matchArtist returns which of names is meant for artist: the one that is
+// their name, e.g. juice-wrld for Juice WRLD, or else the longest one their
+// name starts with, e.g. lil-uzi for Lil Uzi Vert. It returns "" if none are.
+func matchArtist(names []string, artist string) string {
+	artistSlug := slug(artist)
+	if artistSlug == "" {
+		return ""
+	}
+
+	best, bestSlug := "", ""
+	for _, name := range names {
+		nameSlug := slug(name)
+		switch {
+		case nameSlug == "":
+		case nameSlug == artistSlug:
+			return name
+		case strings.HasPrefix(artistSlug, nameSlug+"-") && len(nameSlug) > len(bestSlug):
+			best, bestSlug = name, nameSlug
+		}
+	}
+	return best
+}
+
+// RenderBio renders the bio template at path with data. A leading markdown
+// heading naming the template is left out, as are blank lines around the bio.
+// Hashtags are only picked, with pickHashtags, if the template uses them, and
+// are returned along with the bio.
+func RenderBio(path string, data model.BioData, pickHashtags func() ([]string, error)) (string, []string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for len(lines) > 0 && (bioHeadingPattern.MatchString(lines[0]) || strings.TrimSpace(lines[0]) == "") {
+		lines = lines[1:]
+	}
+
+	var hashtags []string
+	tmpl, err := template.New(filepath.Base(path)).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"artist":   func() string { return data.Artist },
+			"track":    func() string { return data.Track },
+			"album":    func() string { return data.Album },
+			"duration": func() int { return data.Duration },
+			"start":    func() int { return data.Start },
+			"end":      func() int { return data.End },
+			"hashtags": func() (string, error) {
+				if hashtags == nil {
+					picked, err := pickHashtags()
+					if err != nil {
+						return "", err
+					}
+					hashtags = append([]string{}, picked...)
+				}
+				return strings.Join(hashtags, " "), nil
+			},
+		}).
+		Parse(strings.Join(lines, "\n"))
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing bio template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", nil, fmt.Errorf("rendering bio template: %w", err)
+	}
+	return strings.TrimSpace(b.String()), hashtags, nil
+}
+
+// CheckBioLength refuses bios TikTok would cut short.
+func CheckBioLength(bio string, maxLength int) error {
+	if n := utf8.RuneCountInString(bio); n > maxLength {
+		return fmt.Errorf("bio is %d characters, over the limit of %d", n, maxLength)
+	}
+	return nil
+}
+
+// slug lowercases s and joins its words with dashes.
+func slug(s string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
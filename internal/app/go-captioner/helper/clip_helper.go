@@ -26,8 +26,39 @@ func ClipToDTO(c *ent.Clip) *model.ClipDTO {
 		SRTCaptionPath:          c.GenCaptionsPath,
 		CaptionsVideoOutputPath: c.GenRawVideoPath,
 		TrimmedVideoOutputPath:  c.GenTrimmedVideoPath,
+		StartTime:               c.StartTime,
+		EndTime:                 c.EndTime,
+		TranscriptPath:          c.TranscriptPath,
+		CaptionsReviewedAt:      c.CaptionsReviewedAt,
+		CaptionStyle:            c.CaptionStyle,
+		SegmentPlan:             c.SegmentPlan,
+		SubtitlesPath:           c.SubtitlesPath,
+		LyricsPath:              c.LyricsPath,
+		LyricsAlignment:         c.LyricsAlignment,
+		CensorLog:               c.CensorLog,
+		Seed:                    c.Seed,
+		BackgroundStart:         c.BackgroundStart,
+		Width:                   c.Width,
+		Height:                  c.Height,
+		FadeDuration:            c.FadeDuration,
+		AudioCensor:             c.AudioCensor,
+		FadeInDuration:          c.FadeInDuration,
+		Loudness:                c.Loudness,
 		ID:                      id,
 		Hash:                    hash,
+		Artist:                  c.Artist,
+		Title:                   c.Title,
+		Album:                   c.Album,
+		Bio:                     c.Bio,
+		BioPath:                 c.BioPath,
+		Hashtags:                c.Hashtags,
+		AudioID:                 c.AudioID,
+		BackgroundVideoID:       c.BackgroundVideoID,
+		Status:                  c.Status,
+		Stage:                   c.Stage,
+		LastError:               c.LastError,
+		Attempts:                c.Attempts,
+		StageTimestamps:         c.StageTimestamps,
 	}
 }
 
@@ -50,5 +81,36 @@ func DTOToClip(dto *model.ClipDTO) *ent.Clip {
 		GenCaptionsPath:     dto.SRTCaptionPath,
 		GenRawVideoPath:     dto.CaptionsVideoOutputPath,
 		GenTrimmedVideoPath: dto.TrimmedVideoOutputPath,
+		StartTime:           dto.StartTime,
+		EndTime:             dto.EndTime,
+		TranscriptPath:      dto.TranscriptPath,
+		CaptionsReviewedAt:  dto.CaptionsReviewedAt,
+		CaptionStyle:        dto.CaptionStyle,
+		SegmentPlan:         dto.SegmentPlan,
+		SubtitlesPath:       dto.SubtitlesPath,
+		LyricsPath:          dto.LyricsPath,
+		LyricsAlignment:     dto.LyricsAlignment,
+		CensorLog:           dto.CensorLog,
+		Seed:                dto.Seed,
+		BackgroundStart:     dto.BackgroundStart,
+		Width:               dto.Width,
+		Height:              dto.Height,
+		FadeDuration:        dto.FadeDuration,
+		AudioCensor:         dto.AudioCensor,
+		FadeInDuration:      dto.FadeInDuration,
+		Loudness:            dto.Loudness,
+		Artist:              dto.Artist,
+		Title:               dto.Title,
+		Album:               dto.Album,
+		Bio:                 dto.Bio,
+		BioPath:             dto.BioPath,
+		Hashtags:            dto.Hashtags,
+		AudioID:             dto.AudioID,
+		BackgroundVideoID:   dto.BackgroundVideoID,
+		Status:              dto.Status,
+		Stage:               dto.Stage,
+		LastError:           dto.LastError,
+		Attempts:            dto.Attempts,
+		StageTimestamps:     dto.StageTimestamps,
 	}
 }
@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"gopkg.in/yaml.v3"
+)
+
+const configFileName = "tiktok-creator.yaml"
+
+// ConfigPaths returns the config files in the order they are applied, the user
+// config under ~/.config (or $XDG_CONFIG_HOME) followed by the project config
+// in the working directory.
+func ConfigPaths() []string {
+	var paths []string
+
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			configHome = filepath.Join(home, ".config")
+		}
+	}
+	if configHome != "" {
+		paths = append(paths, filepath.Join(configHome, "tiktok-creator", configFileName))
+	}
+
+	return append(paths, configFileName)
+}
+
+// LoadConfig merges the config files that exist, returning the paths that were
+// read. Missing files are skipped, so an empty config is returned without any.
+func LoadConfig() (*model.Config, []string, error) {
+	config := &model.Config{}
+	var loaded []string
+
+	for _, path := range ConfigPaths() {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var file model.Config
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, fmt.Errorf("parsing config %s: %w", path, err)
+		}
+
+		config.Merge(&file)
+		loaded = append(loaded, path)
+	}
+
+	return config, loaded, nil
+}
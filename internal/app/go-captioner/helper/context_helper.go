@@ -0,0 +1,15 @@
+package helper
+
+import (
+	"context"
+	"time"
+)
+
+// WithOptionalTimeout behaves like context.WithTimeout, except that a
+// non-positive timeout means no deadline.
+func WithOptionalTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
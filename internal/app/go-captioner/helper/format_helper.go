@@ -5,14 +5,22 @@ import (
 )
 
 func DurationFromStartAndEnd(startTime, endTime string) (string, error) {
-	iStartTime, err := strconv.Atoi(startTime)
+	seconds, err := SecondsFromStartAndEnd(startTime, endTime)
 	if err != nil {
 		return "", err
 	}
+	return strconv.Itoa(seconds), nil
+}
+
+func SecondsFromStartAndEnd(startTime, endTime string) (int, error) {
+	iStartTime, err := strconv.Atoi(startTime)
+	if err != nil {
+		return 0, err
+	}
 
 	iEndTime, err := strconv.Atoi(endTime)
 	if err != nil {
-		return "", err
+		return 0, err
 	}
-	return strconv.Itoa(iEndTime - iStartTime), nil
+	return iEndTime - iStartTime, nil
 }
@@ -0,0 +1,173 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadHashtagPools reads the pools in the YAML file at path. A missing file
+// has no pools, so bios get no hashtags.
+func LoadHashtagPools(path string) (model.HashtagPools, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var pools model.HashtagPools
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&pools); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parsing hashtag pools %s: %w", path, err)
+	}
+
+	for name, pool := range pools {
+		if pool.Max < 0 {
+			return nil, fmt.Errorf("hashtag pool %s has a max of %d, expected at least 0", name, pool.Max)
+		}
+		for _, include := range pool.Include {
+			if _, ok := pools[include]; !ok {
+				return nil, fmt.Errorf("hashtag pool %s includes unknown pool %s", name, include)
+			}
+		}
+	}
+	return pools, nil
+}
+
+// ResolveHashtagPool returns the pool for artist, matched like bio templates,
+// or else the default pool, merged with the pools it includes. Its own
+// hashtags come first, and a Max of 0 takes the first one set by an included
+// pool.
+func ResolveHashtagPool(pools model.HashtagPools, artist string) (model.HashtagPool, error) {
+	name := matchArtist(slices.Sorted(maps.Keys(pools)), artist)
+	if name == "" {
+		name = model.DefaultHashtagPool
+	}
+	if _, ok := pools[name]; !ok {
+		return model.HashtagPool{}, nil
+	}
+
+	var resolved model.HashtagPool
+	visited := map[string]bool{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		if slices.Contains(path, name) {
+			return fmt.Errorf("hashtag pools include each other: %s", strings.Join(append(path, name), " -> "))
+		}
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+
+		pool := pools[name]
+		resolved.Always = appendHashtags(resolved.Always, pool.Always...)
+		resolved.Tags = appendHashtags(resolved.Tags, pool.Tags...)
+		if resolved.Max == 0 {
+			resolved.Max = pool.Max
+		}
+		for _, include := range pool.Include {
+			if err := visit(include, append(path, name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(name, nil); err != nil {
+		return model.HashtagPool{}, err
+	}
+
+	// A hashtag given to every bio isn't also one to pick from
+	resolved.Tags = slices.DeleteFunc(resolved.Tags, func(tag string) bool {
+		return slices.Contains(resolved.Always, tag)
+	})
+	return resolved, nil
+}
+
+// PickHashtags chooses the hashtags for a bio from pool with strategy, given
+// how often each has been used. Bios get the pool's Always hashtags and then
+// as many of its Tags as its Max allows, or maxCount for pools without one,
+// listed in the order the pool gives them. A limit of 0 allows every hashtag.
+func PickHashtags(
+	pool model.HashtagPool,
+	usage []model.HashtagUsage,
+	strategy model.HashtagStrategy,
+	maxCount int,
+	rng *rand.Rand,
+) ([]string, error) {
+	limit := pool.Max
+	if limit == 0 {
+		limit = maxCount
+	}
+
+	count := len(pool.Tags)
+	if limit > 0 {
+		if len(pool.Always) > limit {
+			return nil, fmt.Errorf("%d hashtags are given to every bio, over the limit of %d", len(pool.Always), limit)
+		}
+		count = min(count, limit-len(pool.Always))
+	}
+
+	uses := make(map[string]model.HashtagUsage, len(usage))
+	for _, u := range usage {
+		uses[u.Hashtag] = u
+	}
+
+	candidates := slices.Clone(pool.Tags)
+	switch strategy {
+	case model.HashtagStrategyRoundRobin:
+		// Hashtags never used have no last clip, so they sort first
+		slices.SortStableFunc(candidates, func(a, b string) int {
+			return uses[a].LastClipID() - uses[b].LastClipID()
+		})
+	case model.HashtagStrategyLeastUsed:
+		rng.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+		slices.SortStableFunc(candidates, func(a, b string) int {
+			return len(uses[a].ClipIDs) - len(uses[b].ClipIDs)
+		})
+	default:
+		rng.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+	}
+	picked := candidates[:count]
+
+	hashtags := slices.Clone(pool.Always)
+	for _, tag := range pool.Tags {
+		if slices.Contains(picked, tag) {
+			hashtags = append(hashtags, tag)
+		}
+	}
+	return hashtags, nil
+}
+
+// appendHashtags adds the hashtags in tags that hashtags lacks, with a leading
+// # for those written without, as YAML would otherwise read it as a comment.
+func appendHashtags(hashtags []string, tags ...string) []string {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if !strings.HasPrefix(tag, "#") {
+			tag = "#" + tag
+		}
+		if !slices.Contains(hashtags, tag) {
+			hashtags = append(hashtags, tag)
+		}
+	}
+	return hashtags
+}
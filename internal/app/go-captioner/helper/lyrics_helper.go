@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// lyricsExtensions are tried in order, synced lyrics first.
+var lyricsExtensions = []string{".lrc", ".txt"}
+
+// FindLyrics returns the lyrics file in dir for the audio at audioPath with
+// hash, named after either, e.g. lyrics/<hash>.lrc or lyrics/Juice WRLD -
+// Lucid Dreams.txt for Juice WRLD - Lucid Dreams.mp3. It returns "" if there
+// is none.
+func FindLyrics(dir, audioPath, hash string) string {
+	return findTrackFile(dir, audioPath, hash, lyricsExtensions)
+}
+
+// findTrackFile returns the file in dir named after the hash of the audio at
+// audioPath, or else its name, with the first of extensions that exists.
+func findTrackFile(dir, audioPath, hash string, extensions []string) string {
+	stem := strings.TrimSuffix(filepath.Base(audioPath), filepath.Ext(audioPath))
+	for _, name := range []string{hash, stem} {
+		if name == "" {
+			continue
+		}
+		for _, ext := range extensions {
+			if path := filepath.Join(dir, name+ext); Exists(path) && !IsDirectory(path) {
+				return path
+			}
+		}
+	}
+	return ""
+}
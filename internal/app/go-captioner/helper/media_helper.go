@@ -0,0 +1,254 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// integratedLoudnessPattern matches the integrated loudness in the summary the
+// ebur128 filter prints once it has read the whole file.
+var integratedLoudnessPattern = regexp.MustCompile(`I:\s+(-?[\d.]+|-inf) LUFS`)
+
+// ProbeDuration returns the length of the media file at path in seconds.
+func ProbeDuration(ctx context.Context, path string) (float64, error) {
+	cmd := exec.CommandContext(
+		ctx,
+		"ffprobe",
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		path,
+	)
+	PrepareCommand(cmd)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return 0, fmt.Errorf("probing %s: %w", path, err)
+	}
+
+	duration, err := strconv.ParseFloat(strings.TrimSpace(stdout.String()), 64)
+	if err != nil {
+		return 0, fmt.Errorf("probing %s: invalid duration %q", path, stdout.String())
+	}
+	return duration, nil
+}
+
+// ffprobeOutput is the part of ffprobe's JSON output ProbeMedia reads.
+type ffprobeOutput struct {
+	Format struct {
+		Duration string            `json:"duration"`
+		Tags     map[string]string `json:"tags"`
+	} `json:"format"`
+	Streams []struct {
+		CodecType    string            `json:"codec_type"`
+		CodecName    string            `json:"codec_name"`
+		SampleRate   string            `json:"sample_rate"`
+		Channels     int               `json:"channels"`
+		Width        int               `json:"width"`
+		Height       int               `json:"height"`
+		AvgFrameRate string            `json:"avg_frame_rate"`
+		Tags         map[string]string `json:"tags"`
+		Disposition  struct {
+			AttachedPic int `json:"attached_pic"`
+		} `json:"disposition"`
+	} `json:"streams"`
+}
+
+// ProbeMedia returns the duration, first audio and video streams and tags of
+// the media file at path.
+func ProbeMedia(ctx context.Context, path string) (*model.MediaInfo, error) {
+	cmd := exec.CommandContext(
+		ctx,
+		"ffprobe",
+		"-v", "error",
+		"-print_format", "json",
+		"-show_format",
+		"-show_streams",
+		path,
+	)
+	PrepareCommand(cmd)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("probing %s: %w", path, err)
+	}
+
+	var probe ffprobeOutput
+	if err := json.Unmarshal(stdout.Bytes(), &probe); err != nil {
+		return nil, fmt.Errorf("probing %s: %w", path, err)
+	}
+
+	duration, err := strconv.ParseFloat(probe.Format.Duration, 64)
+	if err != nil {
+		return nil, fmt.Errorf("probing %s: invalid duration %q", path, probe.Format.Duration)
+	}
+
+	info := &model.MediaInfo{
+		Duration: duration,
+		Tags:     map[string]string{},
+	}
+	addTags := func(tags map[string]string) {
+		for key, value := range tags {
+			// Container tags win over stream tags of the same name
+			if _, ok := info.Tags[strings.ToLower(key)]; !ok {
+				info.Tags[strings.ToLower(key)] = value
+			}
+		}
+	}
+	addTags(probe.Format.Tags)
+
+	for _, stream := range probe.Streams {
+		switch {
+		case stream.CodecType == "audio" && !info.HasAudio():
+			info.AudioCodec = stream.CodecName
+			info.SampleRate, _ = strconv.Atoi(stream.SampleRate)
+			info.Channels = stream.Channels
+			// Ogg files keep their tags on the stream
+			addTags(stream.Tags)
+		case stream.CodecType == "video" && stream.Disposition.AttachedPic == 0 && !info.HasVideo():
+			info.VideoCodec = stream.CodecName
+			info.Width = stream.Width
+			info.Height = stream.Height
+			info.FPS = parseFrameRate(stream.AvgFrameRate)
+		}
+	}
+	return info, nil
+}
+
+// MeasureLoudness returns the integrated loudness of the media file at path in
+// LUFS. The whole file is decoded to measure it.
+func MeasureLoudness(ctx context.Context, path string) (float64, error) {
+	cmd := exec.CommandContext(
+		ctx,
+		"ffmpeg",
+		"-hide_banner",
+		"-nostats",
+		"-i", path,
+		"-map", "0:a:0",
+		"-af", "ebur128",
+		"-f", "null",
+		"-",
+	)
+	PrepareCommand(cmd)
+
+	// The filter logs its summary, so it is read from stderr
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return 0, fmt.Errorf("measuring loudness of %s: %w", path, err)
+	}
+
+	matches := integratedLoudnessPattern.FindAllStringSubmatch(stderr.String(), -1)
+	if len(matches) == 0 {
+		return 0, fmt.Errorf("measuring loudness of %s: no summary in ffmpeg output", path)
+	}
+	loudness, err := strconv.ParseFloat(matches[len(matches)-1][1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("measuring loudness of %s: %w", path, err)
+	}
+	if math.IsInf(loudness, 0) {
+		return 0, fmt.Errorf("measuring loudness of %s: audio is silent", path)
+	}
+	return loudness, nil
+}
+
+// loudnormOutput is the summary the loudnorm filter logs with print_format
+// set to json. Its values are strings, "-inf" for silence.
+type loudnormOutput struct {
+	InputI       string `json:"input_i"`
+	InputTP      string `json:"input_tp"`
+	InputLRA     string `json:"input_lra"`
+	InputThresh  string `json:"input_thresh"`
+	TargetOffset string `json:"target_offset"`
+}
+
+// AnalyseLoudness runs a first loudnorm pass over the audio of the media file
+// at path, measuring what a second pass needs to normalise it to target.
+func AnalyseLoudness(ctx context.Context, path string, target model.LoudnessTarget) (*model.Loudness, error) {
+	filter := fmt.Sprintf(
+		"loudnorm=I=%g:TP=%g:LRA=%g:print_format=json",
+		target.Integrated,
+		target.TruePeak,
+		target.Range,
+	)
+	cmd := exec.CommandContext(
+		ctx,
+		"ffmpeg",
+		"-hide_banner",
+		"-nostats",
+		"-i", path,
+		"-map", "0:a:0",
+		"-af", filter,
+		"-f", "null",
+		"-",
+	)
+	PrepareCommand(cmd)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("measuring loudness of %s: %w", path, err)
+	}
+
+	// The summary is the last thing logged, after any warnings
+	log := stderr.String()
+	start, end := strings.LastIndex(log, "{"), strings.LastIndex(log, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("measuring loudness of %s: no summary in ffmpeg output", path)
+	}
+	var summary loudnormOutput
+	if err := json.Unmarshal([]byte(log[start:end+1]), &summary); err != nil {
+		return nil, fmt.Errorf("measuring loudness of %s: %w", path, err)
+	}
+
+	loudness := &model.Loudness{}
+	for _, value := range []struct {
+		text string
+		dst  *float64
+	}{
+		{summary.InputI, &loudness.Integrated},
+		{summary.InputTP, &loudness.TruePeak},
+		{summary.InputLRA, &loudness.Range},
+		{summary.InputThresh, &loudness.Threshold},
+		{summary.TargetOffset, &loudness.Offset},
+	} {
+		v, err := strconv.ParseFloat(strings.TrimSpace(value.text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("measuring loudness of %s: invalid value %q", path, value.text)
+		}
+		if math.IsInf(v, 0) {
+			return nil, fmt.Errorf("measuring loudness of %s: audio is silent", path)
+		}
+		*value.dst = v
+	}
+	return loudness, nil
+}
+
+// parseFrameRate parses a rate such as 30000/1001, returning 0 when it is
+// unknown.
+func parseFrameRate(rate string) float64 {
+	numerator, denominator, found := strings.Cut(rate, "/")
+	n, err := strconv.ParseFloat(numerator, 64)
+	if err != nil {
+		return 0
+	}
+	if !found {
+		return n
+	}
+	d, err := strconv.ParseFloat(denominator, 64)
+	if err != nil || d == 0 {
+		return 0
+	}
+	return math.Round(n/d*100) / 100
+}
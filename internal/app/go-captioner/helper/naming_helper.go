@@ -0,0 +1,97 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+const unknownArtist = "unknown"
+
+var unsafeNameChars = strings.NewReplacer(
+	"/", "_", "\\", "_", ":", "_", "*", "_", "?", "_",
+	"\"", "_", "<", "_", ">", "_", "|", "_",
+)
+
+// ParseNamingTemplate parses an output naming template and checks it renders
+// to a path inside the output directory.
+func ParseNamingTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("naming").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing naming template: %w", err)
+	}
+
+	sample := model.OutputName{
+		Artist:    "artist",
+		Track:     "track",
+		AudioHash: "0123456789abcdef0123456789abcdef",
+		Hash8:     "01234567",
+		Stage:     model.ClipStageCaptions,
+	}
+	if _, err := RenderOutputPath(tmpl, "", sample, ""); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// RenderOutputPath renders name through tmpl into a path under outputDir with
+// ext appended. Every value is made safe to use as a single path element.
+func RenderOutputPath(tmpl *template.Template, outputDir string, name model.OutputName, ext string) (string, error) {
+	name.Artist = safeNameElement(name.Artist)
+	name.Track = safeNameElement(name.Track)
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, name); err != nil {
+		return "", fmt.Errorf("rendering naming template: %w", err)
+	}
+
+	rendered := filepath.Clean(b.String())
+	if !filepath.IsLocal(rendered) {
+		return "", fmt.Errorf("naming template renders %s, which is outside the output directory", rendered)
+	}
+	return filepath.Join(outputDir, rendered+ext), nil
+}
+
+// OutputKey returns a short digest identifying parts.
+func OutputKey(parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		// Length prefixes keep ("ab", "c") and ("a", "bc") apart
+		_, _ = fmt.Fprintf(hash, "%d:%s", len(part), part)
+	}
+	const keyLength = 8
+	return hex.EncodeToString(hash.Sum(nil))[:keyLength]
+}
+
+// CheckOverwrite refuses to let a stage replace an existing artifact unless
+// overwrite is set.
+func CheckOverwrite(path string, overwrite bool) error {
+	if overwrite {
+		return nil
+	}
+
+	_, err := os.Stat(path)
+	switch {
+	case err == nil:
+		return fmt.Errorf("refusing to overwrite %s, pass --overwrite to replace it", path)
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	default:
+		return err
+	}
+}
+
+func safeNameElement(s string) string {
+	s = strings.Trim(unsafeNameChars.Replace(s), " .")
+	if s == "" {
+		return unknownArtist
+	}
+	return s
+}
@@ -0,0 +1,17 @@
+//go:build !unix
+
+package helper
+
+import (
+	"os/exec"
+	"time"
+)
+
+const processKillGrace = 10 * time.Second
+
+// PrepareCommand bounds how long cmd may linger once its context is
+// cancelled. Process groups are unavailable here, so only the direct child is
+// killed.
+func PrepareCommand(cmd *exec.Cmd) {
+	cmd.WaitDelay = processKillGrace
+}
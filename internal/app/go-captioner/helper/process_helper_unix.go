@@ -0,0 +1,28 @@
+//go:build unix
+
+package helper
+
+import (
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// processKillGrace is how long a cancelled process group gets to exit after
+// SIGTERM before it is sent SIGKILL.
+const processKillGrace = 10 * time.Second
+
+// PrepareCommand runs cmd in its own process group so that cancelling its
+// context terminates the whole tree, e.g. the ffmpeg children spawned by the
+// Python scripts, instead of orphaning them.
+func PrepareCommand(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		pgid := -cmd.Process.Pid
+		time.AfterFunc(processKillGrace, func() {
+			_ = syscall.Kill(pgid, syscall.SIGKILL)
+		})
+		return syscall.Kill(pgid, syscall.SIGTERM)
+	}
+	cmd.WaitDelay = processKillGrace
+}
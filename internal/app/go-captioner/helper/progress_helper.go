@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ProgressPrinter serialises output from concurrent workers so that lines
+// belonging to different clips never interleave.
+type ProgressPrinter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func NewProgressPrinter(out io.Writer) *ProgressPrinter {
+	return &ProgressPrinter{
+		out: out,
+	}
+}
+
+func (p *ProgressPrinter) Println(prefix string, a ...any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, _ = fmt.Fprintf(p.out, "%s %s", prefix, fmt.Sprintln(a...))
+}
+
+func (p *ProgressPrinter) Printf(prefix, format string, a ...any) {
+	p.Println(prefix, fmt.Sprintf(format, a...))
+}
+
+// Exclusive runs fn while holding the output lock, for multi-line output such
+// as tables or interactive prompts that must not be broken up.
+func (p *ProgressPrinter) Exclusive(fn func(w io.Writer) error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return fn(p.out)
+}
+
+// Writer returns a line buffered writer that prefixes every complete line,
+// suitable as the stdout/stderr of a child process.
+func (p *ProgressPrinter) Writer(prefix string) io.Writer {
+	return &prefixWriter{
+		printer: p,
+		prefix:  prefix,
+	}
+}
+
+type prefixWriter struct {
+	printer *ProgressPrinter
+	prefix  string
+	buf     []byte
+}
+
+func (w *prefixWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+
+	for {
+		// ffmpeg redraws its progress line with carriage returns
+		i := bytes.IndexAny(w.buf, "\r\n")
+		if i < 0 {
+			break
+		}
+
+		if line := bytes.TrimSpace(w.buf[:i]); len(line) > 0 {
+			w.printer.Println(w.prefix, string(line))
+		}
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(b), nil
+}
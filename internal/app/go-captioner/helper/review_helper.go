@@ -0,0 +1,147 @@
+package helper
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// Lines starting with this are ignored when a review file is read back.
+const reviewComment = "#"
+
+// reviewProblem marks the comments listing what was wrong with the last save,
+// which are replaced rather than piling up when the file is written again.
+const reviewProblem = "# ! "
+
+const reviewHeader = `# Review the captions of %s. Each line is a word and when it is
+# sung, in seconds from the start of the %d second window:
+#
+#   start	end	word
+#
+# Fix words and times, split or join lines, or delete words that shouldn't be
+# captioned. Lines starting with # are ignored. Save and close the editor to
+# render the captions again, or delete every word to leave them as they are.
+`
+
+// WriteReviewFile writes the words of transcript to path as a plain list an
+// editor can open, one word per line with its start and end time.
+func WriteReviewFile(path, title string, window int, transcript *model.Transcript) error {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, reviewHeader, title, window)
+	for _, word := range transcript.Words {
+		_, _ = fmt.Fprintf(&b, "%.2f\t%.2f\t%s\n", word.Start, word.End, strings.TrimSpace(word.Word))
+	}
+	return os.WriteFile(path, []byte(b.String()), 0600)
+}
+
+// ReadReviewFile reads the words back from the review file at path, checking
+// each is within the window of the given length and in order. Every problem
+// found is returned, joined, along with the words that could be read. Problems
+// name the word rather than the line, as listing them shifts the lines.
+func ReadReviewFile(path string, window int, language string) (*model.Transcript, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	transcript := &model.Transcript{Language: language}
+	var errs []error
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, reviewComment) {
+			continue
+		}
+
+		word, err := parseReviewLine(line, window)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if words := transcript.Words; len(words) > 0 && word.Start < words[len(words)-1].Start {
+			errs = append(errs, fmt.Errorf("%s starts at %.2f, before the word above it", word.Word, word.Start))
+		}
+		transcript.Words = append(transcript.Words, word)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return transcript, errors.Join(errs...)
+}
+
+// MarkReviewProblems lists the problems in err at the top of the review file
+// at path, in place of those from an earlier save, keeping the user's edits.
+func MarkReviewProblems(path string, err error) error {
+	content, readErr := os.ReadFile(path)
+	if readErr != nil {
+		return readErr
+	}
+
+	var b strings.Builder
+	b.WriteString(reviewProblem + "The captions weren't saved, fix these and save again:\n")
+	for _, problem := range strings.Split(err.Error(), "\n") {
+		b.WriteString(reviewProblem + "  " + problem + "\n")
+	}
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		if !strings.HasPrefix(line, reviewProblem) {
+			b.WriteString(line)
+		}
+	}
+	return os.WriteFile(path, []byte(b.String()), 0600)
+}
+
+// SameWords reports whether a and b have the same words at the same times, as
+// far as a review file shows them.
+func SameWords(a, b *model.Transcript) bool {
+	if len(a.Words) != len(b.Words) {
+		return false
+	}
+	for i := range a.Words {
+		x, y := a.Words[i], b.Words[i]
+		if strings.TrimSpace(x.Word) != strings.TrimSpace(y.Word) ||
+			roundCentiseconds(x.Start) != roundCentiseconds(y.Start) ||
+			roundCentiseconds(x.End) != roundCentiseconds(y.End) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseReviewLine(line string, window int) (model.Word, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return model.Word{}, fmt.Errorf("expected a start time, end time and word, got %q", line)
+	}
+
+	start, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return model.Word{}, fmt.Errorf("invalid start time %s", fields[0])
+	}
+	end, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return model.Word{}, fmt.Errorf("invalid end time %s", fields[1])
+	}
+
+	word := strings.Join(fields[2:], " ")
+	switch {
+	case start < 0:
+		return model.Word{}, fmt.Errorf("%s starts at %.2f, before the window", word, start)
+	case end < start:
+		return model.Word{}, fmt.Errorf("%s ends at %.2f, before it starts at %.2f", word, end, start)
+	case end > float64(window):
+		return model.Word{}, fmt.Errorf("%s ends at %.2f, after the %d second window", word, end, window)
+	}
+	return model.Word{Word: word, Start: start, End: end}, nil
+}
+
+func roundCentiseconds(seconds float64) int {
+	return int(seconds*100 + 0.5)
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+)
+
+// The random choices made for a clip, each drawn from its own source.
+const (
+	RandVideo           = "video"
+	RandSegments        = "segments"
+	RandBackgroundStart = "background-start"
+	RandHashtags        = "hashtags"
+)
+
+// ClipSeed returns the seed for the clip whose audio has the given hash. A run
+// seed gives each clip a seed derived from it, so the same run seed makes the
+// same choices for the same audio; a run seed of 0 gives a random one.
+func ClipSeed(seed int64, audioHash string) int64 {
+	if seed == 0 {
+		return rand.Int63()
+	}
+	return hashSeed(fmt.Sprintf("%d:%s", seed, audioHash))
+}
+
+// SeededRand returns the source for one kind of choice made with seed, so
+// adding draws of one kind doesn't change the others.
+func SeededRand(seed int64, purpose string) *rand.Rand {
+	return rand.New(rand.NewSource(hashSeed(fmt.Sprintf("%d:%s", seed, purpose))))
+}
+
+func hashSeed(key string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(key))
+	return int64(hash.Sum64() >> 1)
+}
@@ -0,0 +1,97 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"slices"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// Cuts closer together than this look like glitches rather than edits.
+const minSegmentDuration = 0.5
+
+// PlanSegments cuts a window of duration seconds on every beatsPerCut-th beat,
+// with beats in seconds from the start of the window, and fills each cut with
+// a random stretch of one of videos. Consecutive segments come from different
+// videos when there is more than one to choose from.
+func PlanSegments(
+	beats []float64,
+	bpm float64,
+	duration float64,
+	beatsPerCut int,
+	videos []model.VideoSource,
+	rng *rand.Rand,
+) (*model.SegmentPlan, error) {
+	if beatsPerCut < 1 {
+		return nil, fmt.Errorf("beats per cut must be positive, got %d", beatsPerCut)
+	}
+	if len(videos) == 0 {
+		return nil, errors.New("no background videos to cut from")
+	}
+
+	cuts := []float64{0}
+	for i := 0; i < len(beats); i += beatsPerCut {
+		beat := beats[i]
+		if beat-cuts[len(cuts)-1] < minSegmentDuration || duration-beat < minSegmentDuration {
+			continue
+		}
+		cuts = append(cuts, roundMillis(beat))
+	}
+	cuts = append(cuts, roundMillis(duration))
+
+	plan := &model.SegmentPlan{
+		BPM:         bpm,
+		BeatsPerCut: beatsPerCut,
+	}
+
+	previous := ""
+	for i := range len(cuts) - 1 {
+		length := roundMillis(cuts[i+1] - cuts[i])
+
+		candidates := slices.DeleteFunc(slices.Clone(videos), func(video model.VideoSource) bool {
+			return video.Duration < length
+		})
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("no background video is at least %.3fs long", length)
+		}
+		if len(candidates) > 1 {
+			candidates = slices.DeleteFunc(candidates, func(video model.VideoSource) bool {
+				return video.Path == previous
+			})
+		}
+
+		video := candidates[rng.Intn(len(candidates))]
+		plan.Segments = append(plan.Segments, model.Segment{
+			Video:    video.Path,
+			Start:    RandomStart(rng, video.Duration, length),
+			Duration: length,
+		})
+		previous = video.Path
+	}
+
+	return plan, nil
+}
+
+// RandomStart returns a random point, to the millisecond, to cut length
+// seconds from a video of videoDuration seconds. Videos shorter than length
+// are cut from the start.
+func RandomStart(rng *rand.Rand, videoDuration, length float64) float64 {
+	return roundMillis(rng.Float64() * max(videoDuration-length, 0))
+}
+
+func WriteSegmentPlan(path string, plan *model.SegmentPlan) error {
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func roundMillis(seconds float64) float64 {
+	return math.Round(seconds*1000) / 1000
+}
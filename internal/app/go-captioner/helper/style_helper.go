@@ -0,0 +1,68 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"gopkg.in/yaml.v3"
+)
+
+const stylesDir = "./styles"
+const styleExt = ".yaml"
+
+// LoadCaptionStyle loads a style preset by name from the styles directory, or
+// from a path when name is a YAML file. Fields the preset leaves out keep their
+// defaults, and an empty name yields the default style.
+func LoadCaptionStyle(name string) (*model.CaptionStyle, error) {
+	style := model.NewCaptionStyle()
+	if name == "" {
+		return style, nil
+	}
+
+	path := name
+	if filepath.Ext(name) == "" {
+		path = filepath.Join(stylesDir, name+styleExt)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		presets, _ := ListCaptionStyles()
+		return nil, fmt.Errorf("unknown style %s, expected one of %s", name, strings.Join(presets, ","))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, style); err != nil {
+		return nil, fmt.Errorf("parsing style %s: %w", path, err)
+	}
+	if style.Name == "" {
+		style.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	if err := style.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid style %s: %w", path, err)
+	}
+	return style, nil
+}
+
+// ListCaptionStyles returns the names of the presets in the styles directory.
+func ListCaptionStyles() ([]string, error) {
+	entries, err := os.ReadDir(stylesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != styleExt {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), styleExt))
+	}
+	return names, nil
+}
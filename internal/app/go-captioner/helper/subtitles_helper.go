@@ -0,0 +1,15 @@
+package helper
+
+import "github.com/sam-laister/tiktok-creator/internal/app/go-captioner/subtitles"
+
+// FindSubtitles returns the subtitle file in dir for the audio at audioPath
+// with hash, named after either like lyrics, in any format subtitles reads,
+// e.g. subtitles/Juice WRLD - Lucid Dreams.srt. It returns "" if there is
+// none.
+func FindSubtitles(dir, audioPath, hash string) string {
+	extensions := make([]string, len(subtitles.Formats))
+	for i, format := range subtitles.Formats {
+		extensions[i] = "." + string(format)
+	}
+	return findTrackFile(dir, audioPath, hash, extensions)
+}
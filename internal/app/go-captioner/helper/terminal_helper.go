@@ -2,31 +2,33 @@ package helper
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
-	"time"
 )
 
+// defaultEditor is used when neither $VISUAL nor $EDITOR are set.
+const defaultEditor = "vi"
+
 func GetCommandPrintable(cmd *exec.Cmd) string {
 	return strings.Join(cmd.Args, " ")
 }
 
-// WaitForOptionalEdits pauses for up to 60 seconds asking the user if they want to make edits.
-// - If the user answers "n"/"no": it returns immediately (skip edits).
-// - If the user answers "y"/"yes": it waits until the user presses Enter again to continue.
-// - If no answer is provided within 60 seconds: it returns (skip edits).
-// It returns true if user chose to make edits, false otherwise, and an error on I/O issues.
-func WaitForOptionalEdits() (bool, error) {
-	reader := bufio.NewReader(os.Stdin)
+// Confirm asks question on w and waits for a yes or no on stdin, taking
+// anything other than "y" or "yes" as no. It returns early with an error if
+// ctx is cancelled while waiting.
+func Confirm(ctx context.Context, w io.Writer, question string) (bool, error) {
+	if _, err := fmt.Fprintf(w, "%s [y/N]: ", question); err != nil {
+		return false, err
+	}
 
-	fmt.Print("Pause 60s: Make edits now? [y/N]: ")
 	answerCh := make(chan string, 1)
 	errCh := make(chan error, 1)
-
 	go func() {
-		text, err := reader.ReadString('\n')
+		text, err := bufio.NewReader(os.Stdin).ReadString('\n')
 		if err != nil {
 			errCh <- err
 			return
@@ -34,24 +36,43 @@ func WaitForOptionalEdits() (bool, error) {
 		answerCh <- strings.TrimSpace(strings.ToLower(text))
 	}()
 
-	const secondsInMin = 60
-
 	select {
-	case <-time.After(secondsInMin * time.Second):
-		fmt.Println() // move to next line after timeout
-		return false, nil
+	case <-ctx.Done():
+		_, _ = fmt.Fprintln(w)
+		return false, ctx.Err()
 	case err := <-errCh:
 		return false, err
 	case ans := <-answerCh:
-		if ans == "y" || ans == "yes" {
-			fmt.Print("Editing... Press Enter to continue: ")
-			// Block until an empty line (Enter) is submitted.
-			_, err := reader.ReadString('\n')
-			if err != nil {
-				return true, err
-			}
-			return true, nil
+		return ans == "y" || ans == "yes", nil
+	}
+}
+
+// Editor returns the command line of the user's editor, from $VISUAL or
+// $EDITOR, e.g. "code --wait", or else vi.
+func Editor() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
 		}
-		return false, nil
 	}
+	return defaultEditor
+}
+
+// OpenEditor opens path in editor on the terminal and waits for it to close.
+// Unlike scripts it stays in the terminal's process group, so the editor can
+// take over the terminal.
+func OpenEditor(ctx context.Context, editor, path string) error {
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		return fmt.Errorf("no editor to open %s with", path)
+	}
+
+	cmd := exec.CommandContext(ctx, args[0], append(args[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running editor %s: %w", editor, err)
+	}
+	return nil
 }
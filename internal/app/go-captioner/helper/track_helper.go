@@ -0,0 +1,81 @@
+package helper
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/tags"
+)
+
+var (
+	// Trailing video IDs as left by yt-dlp, e.g. "7AM [ixDvq80wZps]"
+	videoIDPattern = regexp.MustCompile(`\s*\[[^\]]*\]$`)
+	// Leading track numbers, e.g. "01. " or "3) "
+	trackNumberPattern = regexp.MustCompile(`^\d{1,3}[.)]\s*`)
+	digitsPattern      = regexp.MustCompile(`^\d{1,3}$`)
+	trackSeparators    = []string{" - ", " – ", " — ", "_-_"}
+)
+
+// ReadTrackInfo names the track at audioPath from its embedded tags, falling
+// back to its file name for anything they don't set.
+func ReadTrackInfo(audioPath string) model.TrackInfo {
+	info := ParseTrackFileName(audioPath)
+
+	fileTags, err := tags.Read(audioPath)
+	if err != nil {
+		return info
+	}
+
+	if fileTags.Artist != "" {
+		info.Artist = fileTags.Artist
+	}
+	if fileTags.Title != "" {
+		info.Title = fileTags.Title
+	}
+	if fileTags.Album != "" {
+		info.Album = fileTags.Album
+	}
+	return info
+}
+
+// ParseTrackFileName guesses the track from an "Artist - Title" file name,
+// dropping any track number and trailing video ID. Names without an artist are
+// taken to be just the title.
+func ParseTrackFileName(audioPath string) model.TrackInfo {
+	base := strings.TrimSuffix(filepath.Base(audioPath), filepath.Ext(audioPath))
+	base = videoIDPattern.ReplaceAllString(base, "")
+	base = trackNumberPattern.ReplaceAllString(base, "")
+
+	artist, title, found := cutTrackName(base)
+	// "01 - Artist - Title"
+	if found && digitsPattern.MatchString(artist) {
+		artist, title, found = cutTrackName(title)
+	}
+	if !found {
+		return model.TrackInfo{Title: strings.TrimSpace(strings.ReplaceAll(base, "_", " "))}
+	}
+	return model.TrackInfo{
+		Artist: strings.TrimSpace(strings.ReplaceAll(artist, "_", " ")),
+		Title:  strings.TrimSpace(strings.ReplaceAll(title, "_", " ")),
+	}
+}
+
+// ClipTrackInfo returns the track recorded on the clip, or, for clips recorded
+// before tracks were, the one its file name suggests.
+func ClipTrackInfo(clip *model.ClipDTO) model.TrackInfo {
+	if clip.Title != "" {
+		return model.TrackInfo{Artist: clip.Artist, Title: clip.Title, Album: clip.Album}
+	}
+	return ParseTrackFileName(clip.AudioInputPath)
+}
+
+func cutTrackName(name string) (string, string, bool) {
+	for _, separator := range trackSeparators {
+		if artist, title, found := strings.Cut(name, separator); found {
+			return artist, title, true
+		}
+	}
+	return "", name, false
+}
@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func ReadTranscript(path string) (*model.Transcript, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var transcript model.Transcript
+	if err := json.Unmarshal(data, &transcript); err != nil {
+		return nil, err
+	}
+	return &transcript, nil
+}
+
+func WriteTranscript(path string, transcript *model.Transcript) error {
+	data, err := json.Marshal(transcript)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// TranscriptCachePath returns where the transcript of the window between
+// startTime and endTime of the audio with the given hash is cached in dir.
+// Source names what produced it, such as a transcriber and model.
+func TranscriptCachePath(dir, hash, source, startTime, endTime string) string {
+	key := strings.Join([]string{hash, source, startTime, endTime}, "-")
+	return filepath.Join(dir, cacheKeyReplacer.Replace(key)+".json")
+}
+
+// Models may be given as paths, so separators are replaced to keep the key a
+// single file name.
+var cacheKeyReplacer = strings.NewReplacer("/", "_", "\\", "_", " ", "_")
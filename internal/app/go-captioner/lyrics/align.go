@@ -0,0 +1,323 @@
+package lyrics
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// Scores of the alignment. Lyrics the window doesn't reach are skipped for
+// free at either end, so only the words around the transcript are placed.
+const (
+	matchScore    = 2
+	similarScore  = 1
+	mismatchScore = -1
+	gapScore      = -1
+)
+
+// Words whose spelling is at least this similar, by edit distance, are near
+// misses, e.g. "tryna" heard as "trying".
+const similarThreshold = 0.5
+
+// Lyrics sung where the transcript has no word are fitted into the silence
+// around them if it leaves each at least minWordDuration seconds, taking no
+// more than maxWordDuration each.
+const (
+	minWordDuration = 0.1
+	maxWordDuration = 0.5
+)
+
+// errNoMatch is returned when no lyric could be paired with a transcribed
+// word to time it from.
+var errNoMatch = errors.New("the lyrics don't match any transcribed words")
+
+// How a step of the alignment lines the two sequences up.
+type move byte
+
+const (
+	// moveBoth pairs a lyric with a transcribed word.
+	moveBoth move = iota + 1
+	// moveLyric places a lyric the transcript missed.
+	moveLyric
+	// moveWord drops a transcribed word missing from the lyrics.
+	moveWord
+)
+
+type token struct {
+	text string
+	key  string
+	line int
+}
+
+type step struct {
+	move  move
+	lyric int
+	word  int
+}
+
+// placed is a lyric in the output, timed once every lyric is placed.
+type placed struct {
+	token       token
+	start, end  float64
+	probability float64
+	timed       bool
+	score       float64
+}
+
+// Align fits the words of lyrics onto the timings of transcript by sequence
+// alignment, returning a transcript with the lyrics' words and the
+// transcript's timing along with how well each line matched. Lyrics paired
+// with a transcribed word take its times, those the transcript missed share
+// the time around them, and transcribed words missing from the lyrics are
+// dropped.
+func Align(lyrics *Lyrics, transcript *model.Transcript) (*model.Transcript, *model.LyricsAlignment, error) {
+	tokens := tokenize(lyrics)
+	if len(tokens) == 0 {
+		return nil, nil, errors.New("no words in the lyrics")
+	}
+	if len(transcript.Words) == 0 {
+		return nil, nil, errors.New("no transcribed words to align the lyrics to")
+	}
+
+	keys := make([]string, len(transcript.Words))
+	for i, word := range transcript.Words {
+		keys[i] = normalise(word.Word)
+	}
+
+	alignment := &model.LyricsAlignment{}
+	var out []placed
+	for _, s := range align(tokens, keys) {
+		switch s.move {
+		case moveBoth:
+			word := transcript.Words[s.word]
+			out = append(out, placed{
+				token:       tokens[s.lyric],
+				start:       word.Start,
+				end:         word.End,
+				probability: word.Probability,
+				timed:       true,
+				score:       pairQuality(tokens[s.lyric].key, keys[s.word]),
+			})
+		case moveLyric:
+			out = append(out, placed{token: tokens[s.lyric]})
+		case moveWord:
+			if keys[s.word] != "" {
+				alignment.Dropped++
+			}
+		}
+	}
+
+	if err := fillTimes(out); err != nil {
+		return nil, nil, err
+	}
+
+	aligned := &model.Transcript{Language: transcript.Language}
+	lineIndex := map[int]int{}
+	for _, p := range out {
+		aligned.Words = append(aligned.Words, model.Word{
+			Word:        p.token.text,
+			Start:       p.start,
+			End:         p.end,
+			Probability: p.probability,
+		})
+
+		i, ok := lineIndex[p.token.line]
+		if !ok {
+			i = len(alignment.Lines)
+			lineIndex[p.token.line] = i
+			alignment.Lines = append(alignment.Lines, model.AlignedLyricLine{Start: p.start})
+		}
+		line := &alignment.Lines[i]
+		if line.Text != "" {
+			line.Text += " "
+		}
+		line.Text += p.token.text
+		line.End = max(line.End, p.end)
+		line.Confidence += p.score
+		line.Words++
+	}
+	for i := range alignment.Lines {
+		alignment.Lines[i].Confidence /= float64(alignment.Lines[i].Words)
+	}
+
+	return aligned, alignment, nil
+}
+
+// align returns the best scoring alignment of the lyrics onto the transcribed
+// words with the given keys. Every transcribed word is either paired or
+// dropped, but lyrics before the first pair and after the last are skipped.
+func align(tokens []token, keys []string) []step {
+	n, m := len(tokens), len(keys)
+
+	scores := make([][]int, n+1)
+	moves := make([][]move, n+1)
+	for i := range scores {
+		scores[i] = make([]int, m+1)
+		moves[i] = make([]move, m+1)
+	}
+	for j := 1; j <= m; j++ {
+		scores[0][j] = j * gapScore
+		moves[0][j] = moveWord
+	}
+
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m; j++ {
+			best, bestMove := scores[i-1][j-1]+pairScore(tokens[i-1].key, keys[j-1]), moveBoth
+			if s := scores[i-1][j] + gapScore; s > best {
+				best, bestMove = s, moveLyric
+			}
+			if s := scores[i][j-1] + gapScore; s > best {
+				best, bestMove = s, moveWord
+			}
+			scores[i][j], moves[i][j] = best, bestMove
+		}
+	}
+
+	end := 0
+	for i := 1; i <= n; i++ {
+		if scores[i][m] > scores[end][m] {
+			end = i
+		}
+	}
+
+	var steps []step
+	for i, j := end, m; j > 0; {
+		switch moves[i][j] {
+		case moveBoth:
+			i, j = i-1, j-1
+			steps = append(steps, step{move: moveBoth, lyric: i, word: j})
+		case moveLyric:
+			i--
+			steps = append(steps, step{move: moveLyric, lyric: i})
+		default:
+			j--
+			steps = append(steps, step{move: moveWord, word: j})
+		}
+	}
+
+	for a, b := 0, len(steps)-1; a < b; a, b = a+1, b-1 {
+		steps[a], steps[b] = steps[b], steps[a]
+	}
+	return steps
+}
+
+// fillTimes times the lyrics the transcript missed from the words either side.
+// Each run goes into the silence before the next word if there's room, and
+// otherwise shares the time of the word before it, or after it at the start.
+// It is an error if no lyric was placed at all, as when every transcribed
+// word was dropped.
+func fillTimes(out []placed) error {
+	if len(out) == 0 {
+		return errNoMatch
+	}
+	for a := 0; a < len(out); {
+		if out[a].timed {
+			a++
+			continue
+		}
+		b := a
+		for b < len(out) && !out[b].timed {
+			b++
+		}
+		prev, next := a-1, b
+
+		switch {
+		case prev >= 0 && next < len(out) && out[next].start-out[prev].end >= minWordDuration*float64(b-a):
+			spread(out[a:b], out[prev].end, min(out[next].start, out[prev].end+maxWordDuration*float64(b-a)))
+		case prev >= 0:
+			end := out[prev].end
+			if next < len(out) {
+				end = max(end, out[next].start)
+			}
+			spread(out[prev:b], out[prev].start, end)
+		case next < len(out):
+			spread(out[a:next+1], out[next].start, out[next].end)
+		default:
+			return errNoMatch
+		}
+		a = b
+	}
+	return nil
+}
+
+// spread splits start to end evenly between words.
+func spread(words []placed, start, end float64) {
+	step := (end - start) / float64(len(words))
+	for i := range words {
+		words[i].start = start + step*float64(i)
+		words[i].end = start + step*float64(i+1)
+		words[i].timed = true
+	}
+}
+
+func tokenize(lyrics *Lyrics) []token {
+	var tokens []token
+	for i, line := range lyrics.Lines {
+		for _, text := range strings.Fields(line.Text) {
+			if key := normalise(text); key != "" {
+				tokens = append(tokens, token{text: text, key: key, line: i})
+			}
+		}
+	}
+	return tokens
+}
+
+// normalise lowercases word and keeps only its letters and digits, so
+// punctuation and apostrophes don't stop words matching.
+func normalise(word string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(word) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func pairScore(a, b string) int {
+	switch pairQuality(a, b) {
+	case 1:
+		return matchScore
+	case 0.5:
+		return similarScore
+	default:
+		return mismatchScore
+	}
+}
+
+// pairQuality is 1 for the same word, 0.5 for a near miss and otherwise 0.
+func pairQuality(a, b string) float64 {
+	switch {
+	case a == b && a != "":
+		return 1
+	case a == "" || b == "":
+		return 0
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	if 1-float64(editDistance(ra, rb))/float64(max(len(ra), len(rb))) >= similarThreshold {
+		return 0.5
+	}
+	return 0
+}
+
+func editDistance(a, b []rune) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		diagonal := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			diagonal, row[j] = row[j], min(row[j]+1, row[j-1]+1, diagonal+cost)
+		}
+	}
+	return row[len(b)]
+}
@@ -0,0 +1,222 @@
+package lyrics
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func plainLyrics(lines ...string) *Lyrics {
+	lyrics := &Lyrics{}
+	for _, line := range lines {
+		lyrics.Lines = append(lyrics.Lines, Line{Text: line})
+	}
+	return lyrics
+}
+
+// heard is a transcript of plainLyrics("Yeah I'm tryna get it", "all the way
+// up") with an ad-lib, a near miss, a missed word and a stray symbol.
+var heard = &model.Transcript{
+	Language: "en",
+	Words: []model.Word{
+		{Word: " yeah", Start: 0, End: 0.4, Probability: 0.9},
+		{Word: " uh", Start: 0.4, End: 0.5, Probability: 0.3},
+		{Word: " I'm", Start: 0.5, End: 0.9, Probability: 0.8},
+		{Word: " trying", Start: 0.9, End: 1.3, Probability: 0.7},
+		{Word: " get", Start: 1.3, End: 1.5, Probability: 0.9},
+		{Word: " all", Start: 2, End: 2.3, Probability: 0.9},
+		{Word: " the", Start: 2.3, End: 2.4, Probability: 0.9},
+		{Word: " way", Start: 2.4, End: 2.8, Probability: 0.9},
+		{Word: " up!", Start: 2.8, End: 3.2, Probability: 0.9},
+		{Word: " ♪", Start: 3.2, End: 3.5, Probability: 0.5},
+	},
+}
+
+// roundTimes rounds word times to the millisecond, as spreading them adds
+// float error.
+func roundTimes(words []model.Word) []model.Word {
+	for i := range words {
+		words[i].Start = math.Round(words[i].Start*1000) / 1000
+		words[i].End = math.Round(words[i].End*1000) / 1000
+	}
+	return words
+}
+
+func TestAlign(t *testing.T) {
+	wantWords := []model.Word{
+		{Word: "Yeah", Start: 0, End: 0.4, Probability: 0.9},
+		{Word: "I'm", Start: 0.5, End: 0.9, Probability: 0.8},
+		{Word: "tryna", Start: 0.9, End: 1.3, Probability: 0.7},
+		{Word: "get", Start: 1.3, End: 1.5, Probability: 0.9},
+		// Missed, so fitted into the silence before "all"
+		{Word: "it", Start: 1.5, End: 2},
+		{Word: "all", Start: 2, End: 2.3, Probability: 0.9},
+		{Word: "the", Start: 2.3, End: 2.4, Probability: 0.9},
+		{Word: "way", Start: 2.4, End: 2.8, Probability: 0.9},
+		{Word: "up", Start: 2.8, End: 3.2, Probability: 0.9},
+	}
+	wantAlignment := &model.LyricsAlignment{
+		Lines: []model.AlignedLyricLine{
+			// Four of five words matched, with "tryna" a near miss
+			{Text: "Yeah I'm tryna get it", Start: 0, End: 2, Words: 5, Confidence: 3.5 / 5},
+			{Text: "all the way up", Start: 2, End: 3.2, Words: 4, Confidence: 1},
+		},
+		// "uh", but not "♪", which has no letters to be a word
+		Dropped: 1,
+	}
+
+	tests := []struct {
+		name   string
+		lyrics *Lyrics
+	}{
+		{name: "whole lyrics", lyrics: plainLyrics("Yeah I'm tryna get it", "all the way up")},
+		{
+			// Lines outside the window are skipped for free at either end
+			name:   "window of longer lyrics",
+			lyrics: plainLyrics("Lucid dreams before", "Yeah I'm tryna get it", "all the way up", "and more after that"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aligned, alignment, err := Align(test.lyrics, heard)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if aligned.Language != "en" {
+				t.Errorf("language = %q, want en", aligned.Language)
+			}
+			if got := roundTimes(aligned.Words); !reflect.DeepEqual(got, wantWords) {
+				t.Errorf("words =\n%+v\nwant\n%+v", got, wantWords)
+			}
+			if !reflect.DeepEqual(alignment, wantAlignment) {
+				t.Errorf("alignment = %+v, want %+v", alignment, wantAlignment)
+			}
+		})
+	}
+}
+
+func TestAlignErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		lyrics     *Lyrics
+		transcript *model.Transcript
+		want       string
+	}{
+		{
+			name:       "no lyric words",
+			lyrics:     plainLyrics("...", "♪ ♪"),
+			transcript: heard,
+			want:       "no words in the lyrics",
+		},
+		{
+			name:       "no transcribed words",
+			lyrics:     plainLyrics("Yeah"),
+			transcript: &model.Transcript{},
+			want:       "no transcribed words",
+		},
+		{
+			name:   "every lyric misses",
+			lyrics: plainLyrics("Lucid dreams", "shadows in my room"),
+			transcript: &model.Transcript{Words: []model.Word{
+				{Word: "xyz", Start: 0, End: 1},
+				{Word: "qqq", Start: 1, End: 2},
+			}},
+			want: errNoMatch.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := Align(test.lyrics, test.transcript)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Align error = %v, want one containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestFillTimes(t *testing.T) {
+	timed := func(start, end float64) placed { return placed{start: start, end: end, timed: true} }
+	missed := placed{}
+
+	tests := []struct {
+		name string
+		out  []placed
+		want [][2]float64
+	}{
+		{
+			name: "into the silence before the next word",
+			out:  []placed{timed(0, 1), missed, timed(1.5, 2)},
+			want: [][2]float64{{0, 1}, {1, 1.5}, {1.5, 2}},
+		},
+		{
+			name: "no longer than the longest a word takes",
+			out:  []placed{timed(0, 1), missed, missed, timed(5, 6)},
+			want: [][2]float64{{0, 1}, {1, 1.5}, {1.5, 2}, {5, 6}},
+		},
+		{
+			name: "sharing the word before when there's no room",
+			out:  []placed{timed(0, 1), missed, missed, timed(1.1, 2)},
+			want: [][2]float64{{0, 0.367}, {0.367, 0.733}, {0.733, 1.1}, {1.1, 2}},
+		},
+		{
+			name: "at the end, sharing the last word",
+			out:  []placed{timed(0, 1), missed},
+			want: [][2]float64{{0, 0.5}, {0.5, 1}},
+		},
+		{
+			name: "at the start, sharing the first word",
+			out:  []placed{missed, timed(1, 2)},
+			want: [][2]float64{{1, 1.5}, {1.5, 2}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := fillTimes(test.out); err != nil {
+				t.Fatal(err)
+			}
+			got := make([][2]float64, len(test.out))
+			for i, p := range test.out {
+				if !p.timed {
+					t.Errorf("word %d left untimed", i)
+				}
+				got[i] = [2]float64{math.Round(p.start*1000) / 1000, math.Round(p.end*1000) / 1000}
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("times = %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	for _, out := range [][]placed{nil, {missed, missed}} {
+		if err := fillTimes(out); !errors.Is(err, errNoMatch) {
+			t.Errorf("fillTimes of %d untimed lyrics error = %v, want errNoMatch", len(out), err)
+		}
+	}
+}
+
+func TestPairQuality(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "tryna", b: "tryna", want: 1},
+		{a: "tryna", b: "trying", want: 0.5},
+		{a: "im", b: "i", want: 0.5},
+		{a: "dreams", b: "room", want: 0},
+		{a: "", b: "", want: 0},
+		{a: "yeah", b: "", want: 0},
+	}
+
+	for _, test := range tests {
+		if got := pairQuality(test.a, test.b); got != test.want {
+			t.Errorf("pairQuality(%q, %q) = %g, want %g", test.a, test.b, got, test.want)
+		}
+	}
+}
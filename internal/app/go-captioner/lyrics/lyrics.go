@@ -0,0 +1,148 @@
+// Package lyrics reads user supplied lyrics and aligns them onto transcribed
+// word timestamps.
+package lyrics
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Line is a line of lyrics. Time is when it starts in seconds into the track,
+// if the lyrics were synced.
+type Line struct {
+	Text string
+	Time *float64
+}
+
+// Lyrics are the lines of a track in the order they are sung.
+type Lyrics struct {
+	Lines []Line
+}
+
+var (
+	// LRC timestamps, [mm:ss], [mm:ss.xx] or [mm:ss:xx], several of which may
+	// lead a line that repeats.
+	lrcTimePattern = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	// LRC ID tags such as [ar:Juice WRLD], and section headers such as
+	// [Chorus] or [Verse 1: Juice WRLD] in plain text lyrics.
+	bracketLinePattern = regexp.MustCompile(`^\[[^\]]*\]$`)
+)
+
+// Read parses the LRC or plain text lyrics at path.
+func Read(path string) (*Lyrics, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	lyrics, err := Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading lyrics %s: %w", path, err)
+	}
+	return lyrics, nil
+}
+
+// Parse reads LRC or plain text lyrics, telling them apart by whether any line
+// is timestamped. Synced lines are sorted by time, and lines that are only a
+// tag or section header are left out.
+func Parse(r io.Reader) (*Lyrics, error) {
+	lyrics := &Lyrics{}
+	synced := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+
+		var times []float64
+		for {
+			match := lrcTimePattern.FindStringSubmatch(line)
+			if match == nil {
+				break
+			}
+			times = append(times, lrcSeconds(match[1], match[2], match[3]))
+			line = strings.TrimSpace(line[len(match[0]):])
+		}
+
+		if line == "" || bracketLinePattern.MatchString(line) {
+			continue
+		}
+		if len(times) == 0 {
+			lyrics.Lines = append(lyrics.Lines, Line{Text: line})
+			continue
+		}
+
+		synced = true
+		for _, t := range times {
+			lyrics.Lines = append(lyrics.Lines, Line{Text: line, Time: &t})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if synced {
+		// Lines without a time can't be placed among the synced ones
+		synced := lyrics.Lines[:0]
+		for _, line := range lyrics.Lines {
+			if line.Time != nil {
+				synced = append(synced, line)
+			}
+		}
+		// Repeated lines stay in the order they were written
+		slices.SortStableFunc(synced, func(a, b Line) int {
+			return cmp.Compare(*a.Time, *b.Time)
+		})
+		lyrics.Lines = synced
+	}
+	if len(lyrics.Lines) == 0 {
+		return nil, errors.New("no lyrics found")
+	}
+	return lyrics, nil
+}
+
+// Synced reports whether the lines have times.
+func (l *Lyrics) Synced() bool {
+	return len(l.Lines) > 0 && l.Lines[0].Time != nil
+}
+
+// Window returns the lines of synced lyrics sung between start and end seconds
+// into the track, along with those either side within margin seconds, as
+// lines are timed by their start alone. Plain lyrics are returned whole.
+func (l *Lyrics) Window(start, end, margin float64) *Lyrics {
+	if !l.Synced() {
+		return l
+	}
+
+	window := &Lyrics{}
+	for i, line := range l.Lines {
+		lineEnd := end + margin
+		if i+1 < len(l.Lines) {
+			lineEnd = *l.Lines[i+1].Time
+		}
+		if lineEnd >= start-margin && *line.Time <= end+margin {
+			window.Lines = append(window.Lines, line)
+		}
+	}
+	return window
+}
+
+func lrcSeconds(minutes, seconds, fraction string) float64 {
+	m, _ := strconv.Atoi(minutes)
+	s, _ := strconv.Atoi(seconds)
+	total := float64(m*60 + s)
+	if fraction != "" {
+		f, _ := strconv.Atoi(fraction)
+		total += float64(f) / math.Pow10(len(fraction))
+	}
+	return total
+}
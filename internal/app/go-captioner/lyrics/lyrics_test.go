@@ -0,0 +1,127 @@
+package lyrics
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func at(seconds float64) *float64 {
+	return &seconds
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []Line
+	}{
+		{
+			name:   "plain text with section headers",
+			source: "\ufeff[Intro]\nYeah\n\n[Verse 1: Juice WRLD]\n  I still see your shadows  \n[Chorus]\nLucid dreams\n",
+			want:   []Line{{Text: "Yeah"}, {Text: "I still see your shadows"}, {Text: "Lucid dreams"}},
+		},
+		{
+			name:   "lrc with id tags",
+			source: "[ar:Juice WRLD]\n[ti:Lucid Dreams]\n[length: 03:59]\n[00:01.00]Yeah\n[00:02.50]Lucid dreams\n",
+			want:   []Line{{Text: "Yeah", Time: at(1)}, {Text: "Lucid dreams", Time: at(2.5)}},
+		},
+		{
+			name:   "repeated timestamps, sorted in the order sung",
+			source: "[00:10.00][00:30.00]Lucid dreams\n[00:20.00]Verse\n[00:30.00]After the chorus\n",
+			want: []Line{
+				{Text: "Lucid dreams", Time: at(10)},
+				{Text: "Verse", Time: at(20)},
+				{Text: "Lucid dreams", Time: at(30)},
+				{Text: "After the chorus", Time: at(30)},
+			},
+		},
+		{
+			name:   "timestamp forms",
+			source: "[00:01]one\n[00:02.5]two\n[00:03:25]three\n[01:04.125]four\n",
+			want: []Line{
+				{Text: "one", Time: at(1)},
+				{Text: "two", Time: at(2.5)},
+				{Text: "three", Time: at(3.25)},
+				{Text: "four", Time: at(64.125)},
+			},
+		},
+		{
+			name:   "untimed lines and empty timed lines among synced ones",
+			source: "[Chorus]\n[00:01.00]Yeah\nnot timed\n[00:02.00]\n[00:03.00]baby\n",
+			want:   []Line{{Text: "Yeah", Time: at(1)}, {Text: "baby", Time: at(3)}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lyrics, err := Parse(strings.NewReader(test.source))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(lyrics.Lines, test.want) {
+				t.Errorf("lines = %s, want %s", describe(lyrics.Lines), describe(test.want))
+			}
+			if synced := test.want[0].Time != nil; lyrics.Synced() != synced {
+				t.Errorf("Synced = %t, want %t", lyrics.Synced(), synced)
+			}
+		})
+	}
+}
+
+func TestParseWithoutLyrics(t *testing.T) {
+	for _, source := range []string{"", "\n\n", "[Intro]\n[Chorus]\n", "[ar:Juice WRLD]\n[00:01.00]\n"} {
+		if _, err := Parse(strings.NewReader(source)); err == nil || err.Error() != "no lyrics found" {
+			t.Errorf("Parse(%q) error = %v, want no lyrics found", source, err)
+		}
+	}
+}
+
+func TestWindow(t *testing.T) {
+	lyrics := &Lyrics{}
+	for i, text := range []string{"zero", "ten", "twenty", "thirty", "forty"} {
+		lyrics.Lines = append(lyrics.Lines, Line{Text: text, Time: at(float64(i * 10))})
+	}
+
+	tests := []struct {
+		name               string
+		start, end, margin float64
+		want               []string
+	}{
+		// "ten" is still being sung at 12
+		{name: "lines overlapping the window", start: 12, end: 25, margin: 1, want: []string{"ten", "twenty"}},
+		{name: "margin reaching neighbours", start: 12, end: 25, margin: 5, want: []string{"zero", "ten", "twenty", "thirty"}},
+		{name: "last line runs past the window", start: 45, end: 60, margin: 0, want: []string{"forty"}},
+		{name: "before the lyrics", start: -20, end: -5, margin: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			for _, line := range lyrics.Window(test.start, test.end, test.margin).Lines {
+				got = append(got, line.Text)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Window = %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	plain := plainLyrics("Yeah", "baby")
+	if got := plain.Window(12, 25, 1); got != plain {
+		t.Errorf("Window of plain lyrics = %+v, want them whole", got)
+	}
+}
+
+// describe shows lines with their times rather than pointers.
+func describe(lines []Line) string {
+	var b strings.Builder
+	for _, line := range lines {
+		if line.Time != nil {
+			fmt.Fprintf(&b, "[%g]", *line.Time)
+		}
+		fmt.Fprintf(&b, "%s; ", line.Text)
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AudioCensorMode is how censored words are hidden in a clip's audio.
+type AudioCensorMode string
+
+const (
+	// AudioCensorOff leaves the audio as it is.
+	AudioCensorOff AudioCensorMode = "off"
+	// AudioCensorMute silences the words.
+	AudioCensorMute AudioCensorMode = "mute"
+	// AudioCensorBleep silences the words under a tone.
+	AudioCensorBleep AudioCensorMode = "bleep"
+	// AudioCensorReverse plays the words backwards.
+	AudioCensorReverse AudioCensorMode = "reverse"
+)
+
+var AudioCensorModes = []AudioCensorMode{
+	AudioCensorOff,
+	AudioCensorMute,
+	AudioCensorBleep,
+	AudioCensorReverse,
+}
+
+func ParseAudioCensorMode(name string) (AudioCensorMode, error) {
+	mode := AudioCensorMode(name)
+	if !slices.Contains(AudioCensorModes, mode) {
+		return "", fmt.Errorf("unknown audio censor mode %s, expected one of %s", name, joinNames(AudioCensorModes))
+	}
+	return mode, nil
+}
+
+// AudioCensor is how the words censored in a clip's captions were hidden in
+// its audio, recorded so the clip can be rendered again the same way.
+type AudioCensor struct {
+	Mode AudioCensorMode `json:"mode"`
+	// Padding is how many seconds either side of each word are hidden too,
+	// covering timestamps that start a little late or end a little early.
+	Padding float64 `json:"padding"`
+}
+
+// TimeSpan is a stretch of a clip between Start and End seconds.
+type TimeSpan struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// AudioDTO is an audio file catalogued by assets scan.
+type AudioDTO struct {
+	ID         int
+	Hash       string
+	Path       string
+	Duration   float64
+	Codec      string
+	SampleRate int
+	Channels   int
+	// Loudness is the integrated loudness in LUFS, if it was measured.
+	Loudness  *float64
+	Tags      map[string]string
+	ScannedAt time.Time
+}
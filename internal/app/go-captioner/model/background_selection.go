@@ -0,0 +1,91 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BackgroundStrategy decides which background video a new clip gets.
+type BackgroundStrategy string
+
+const (
+	// BackgroundStrategyRandom picks any video with equal chance.
+	BackgroundStrategyRandom BackgroundStrategy = "random"
+	// BackgroundStrategyRoundRobin picks videos never used first, then the
+	// one used longest ago.
+	BackgroundStrategyRoundRobin BackgroundStrategy = "round-robin"
+	// BackgroundStrategyLeastUsed picks the video used the fewest times.
+	BackgroundStrategyLeastUsed BackgroundStrategy = "least-used"
+	// BackgroundStrategyWeighted picks at random, favouring videos with a
+	// higher weight and fewer uses.
+	BackgroundStrategyWeighted BackgroundStrategy = "weighted"
+)
+
+var BackgroundStrategies = []BackgroundStrategy{
+	BackgroundStrategyRandom,
+	BackgroundStrategyRoundRobin,
+	BackgroundStrategyLeastUsed,
+	BackgroundStrategyWeighted,
+}
+
+// BackgroundRule excludes backgrounds a new clip must not use.
+type BackgroundRule string
+
+const (
+	// BackgroundRuleBackToBack never gives a clip the video used last.
+	BackgroundRuleBackToBack BackgroundRule = "back-to-back"
+	// BackgroundRuleArtistVideo never reuses a video for the same artist.
+	BackgroundRuleArtistVideo BackgroundRule = "artist-video"
+	// BackgroundRuleArtistSegment never reuses the same stretch of a video
+	// for the same artist.
+	BackgroundRuleArtistSegment BackgroundRule = "artist-segment"
+)
+
+var BackgroundRules = []BackgroundRule{
+	BackgroundRuleBackToBack,
+	BackgroundRuleArtistVideo,
+	BackgroundRuleArtistSegment,
+}
+
+func ParseBackgroundStrategy(name string) (BackgroundStrategy, error) {
+	strategy := BackgroundStrategy(name)
+	if !slices.Contains(BackgroundStrategies, strategy) {
+		return "", fmt.Errorf("unknown background strategy %s, expected one of %s", name, joinNames(BackgroundStrategies))
+	}
+	return strategy, nil
+}
+
+func ParseBackgroundRules(names []string) ([]BackgroundRule, error) {
+	var rules []BackgroundRule
+	var errs []error
+	for _, name := range names {
+		rule := BackgroundRule(name)
+		if !slices.Contains(BackgroundRules, rule) {
+			errs = append(errs, fmt.Errorf("unknown background rule %s, expected one of %s", name, joinNames(BackgroundRules)))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, errors.Join(errs...)
+}
+
+// VideoRange is a stretch of a background video, in seconds.
+type VideoRange struct {
+	Video string
+	Start float64
+	End   float64
+}
+
+func (r VideoRange) Overlaps(other VideoRange) bool {
+	return r.Video == other.Video && r.Start < other.End && other.Start < r.End
+}
+
+func joinNames[T ~string](values []T) string {
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, string(value))
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// BackgroundVideoDTO is a background video tracked so batch can spread clips
+// across them. Its media fields are only known once assets scan has probed it.
+type BackgroundVideoDTO struct {
+	ID   int
+	Path string
+	// Weight scales how often the weighted strategy picks the video.
+	Weight     float64
+	UseCount   int
+	LastUsedAt *time.Time
+
+	Hash      *string
+	Duration  *float64
+	Codec     *string
+	Width     *int
+	Height    *int
+	FPS       *float64
+	Tags      map[string]string
+	ScannedAt *time.Time
+}
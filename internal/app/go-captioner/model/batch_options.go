@@ -1,34 +1,33 @@
 package model
 
 type BatchOptions struct {
-	AudioPath       string
-	VideoPath       string
-	OutputDir       string
-	WhisperModel    string
-	Verbose         bool
-	StartTime       string
-	EndTime         string
-	NoInteract      bool
-	Height          int
-	Width           int
-	FadeDuration    int
-	SkipCaptionsGen bool
-	SkipVideoGen    bool
+	CommonOptions
+	SkipCaptionsGen   bool
+	SkipVideoGen      bool
+	RetryFailed       bool
+	Workers           int
+	TranscribeWorkers int
+	// BackgroundStrategy names how new clips pick their background video, see
+	// BackgroundStrategies.
+	BackgroundStrategy string
+	// BackgroundExclude names the BackgroundRules backgrounds are picked under.
+	BackgroundExclude []string
 }
 
 func NewBatchOptions(opts ...func(*BatchOptions)) *BatchOptions {
-	const defaultHeight = 1920
-	const defaultWidth = 1080
-	const defaultFadeDuration = 5
 	const defaultSkipCaptionsGen = false
 	const defaultSkipVideoGen = false
+	const defaultWorkers = 1
+	const defaultTranscribeWorkers = 1
+	const defaultBackgroundStrategy = BackgroundStrategyRandom
 
 	props := BatchOptions{
-		Height:          defaultHeight,
-		Width:           defaultWidth,
-		FadeDuration:    defaultFadeDuration,
-		SkipCaptionsGen: defaultSkipCaptionsGen,
-		SkipVideoGen:    defaultSkipVideoGen,
+		CommonOptions:      *NewCommonOptions(),
+		SkipCaptionsGen:    defaultSkipCaptionsGen,
+		SkipVideoGen:       defaultSkipVideoGen,
+		Workers:            defaultWorkers,
+		TranscribeWorkers:  defaultTranscribeWorkers,
+		BackgroundStrategy: string(defaultBackgroundStrategy),
 	}
 	for _, opt := range opts {
 		opt(&props)
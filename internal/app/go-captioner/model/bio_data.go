@@ -0,0 +1,16 @@
+package model
+
+// TikTokCaptionLimit is the most characters TikTok accepts in a post caption.
+const TikTokCaptionLimit = 2200
+
+// BioData is what a bio template can use, both as fields, e.g. {{.Track}},
+// and as functions, e.g. {{track}}. Times are in whole seconds. Templates can
+// also use {{hashtags}}, those picked for the clip joined by spaces.
+type BioData struct {
+	Artist   string
+	Track    string
+	Album    string
+	Duration int
+	Start    int
+	End      int
+}
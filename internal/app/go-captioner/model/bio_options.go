@@ -0,0 +1,27 @@
+package model
+
+type BioOptions struct {
+	BiosDir      string
+	BioMaxLength int
+	Hashtags     HashtagOptions
+	// NewHashtags picks the clip's hashtags again rather than keeping those
+	// its bio was first rendered with.
+	NewHashtags bool
+	DryRun      bool
+	Overwrite   bool
+}
+
+func NewBioOptions(opts ...func(*BioOptions)) *BioOptions {
+	const defaultBiosDir = "bios"
+	const defaultBioMaxLength = TikTokCaptionLimit
+
+	props := BioOptions{
+		BiosDir:      defaultBiosDir,
+		BioMaxLength: defaultBioMaxLength,
+		Hashtags:     *NewHashtagOptions(),
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
@@ -0,0 +1,142 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CaptionMode controls how words are animated as they are sung.
+type CaptionMode string
+
+const (
+	// CaptionModeAppear shows each word as it is sung and keeps it until the
+	// page is replaced.
+	CaptionModeAppear CaptionMode = "appear"
+	// CaptionModeKaraoke shows the whole page and sweeps the highlight colour
+	// across each word as it is sung.
+	CaptionModeKaraoke CaptionMode = "karaoke"
+	// CaptionModePop shows the whole page and colours and enlarges only the
+	// word being sung.
+	CaptionModePop CaptionMode = "pop"
+	// CaptionModeSingleWord shows only the word being sung.
+	CaptionModeSingleWord CaptionMode = "single-word"
+)
+
+var CaptionModes = []CaptionMode{
+	CaptionModeAppear,
+	CaptionModeKaraoke,
+	CaptionModePop,
+	CaptionModeSingleWord,
+}
+
+func CaptionModeNames() []string {
+	names := make([]string, 0, len(CaptionModes))
+	for _, mode := range CaptionModes {
+		names = append(names, string(mode))
+	}
+	return names
+}
+
+// CaptionStyle controls how captions look and where they sit on screen. Styles
+// are loaded from YAML presets and recorded on clips as JSON, so re-renders
+// reproduce the original look.
+//
+// Colours are either "#RRGGBB" / "#RRGGBBAA" hex or raw ASS "&HAABBGGRR".
+type CaptionStyle struct {
+	// Name is the preset the style was loaded from, if any.
+	Name            string      `yaml:"name,omitempty" json:"name,omitempty"`
+	Font            string      `yaml:"font" json:"font"`
+	FontSize        int         `yaml:"font_size" json:"font_size"`
+	Bold            bool        `yaml:"bold" json:"bold"`
+	PrimaryColour   string      `yaml:"primary_colour" json:"primary_colour"`
+	SecondaryColour string      `yaml:"secondary_colour" json:"secondary_colour"`
+	OutlineColour   string      `yaml:"outline_colour" json:"outline_colour"`
+	BackColour      string      `yaml:"back_colour" json:"back_colour"`
+	Outline         float64     `yaml:"outline" json:"outline"`
+	Shadow          float64     `yaml:"shadow" json:"shadow"`
+	Mode            CaptionMode `yaml:"mode" json:"mode"`
+	// HighlightColour and HighlightScale, a percentage, mark the word being
+	// sung in the karaoke and pop modes.
+	HighlightColour string `yaml:"highlight_colour" json:"highlight_colour"`
+	HighlightScale  int    `yaml:"highlight_scale" json:"highlight_scale"`
+	// Alignment places the caption block using the numpad layout ASS uses,
+	// e.g. 8 is top centre and 2 is bottom centre.
+	Alignment int `yaml:"alignment" json:"alignment"`
+	// MaxChars is the number of characters allowed on a single line.
+	MaxChars int `yaml:"max_chars" json:"max_chars"`
+	// LineSpacing is the line height as a multiple of the font size.
+	LineSpacing float64 `yaml:"line_spacing" json:"line_spacing"`
+	MarginX     int     `yaml:"margin_x" json:"margin_x"`
+	MarginY     int     `yaml:"margin_y" json:"margin_y"`
+}
+
+func NewCaptionStyle(opts ...func(*CaptionStyle)) *CaptionStyle {
+	const defaultFont = "Ubuntu"
+	const defaultFontSize = 200
+	const defaultPrimaryColour = "#000000"
+	const defaultSecondaryColour = "#FF0000"
+	const defaultOutlineColour = "#FFFFFF"
+	const defaultBackColour = "#0000009B"
+	const defaultOutline = 3
+	const defaultShadow = 2
+	const defaultMode = CaptionModeAppear
+	const defaultHighlightColour = "#FF0000"
+	const defaultHighlightScale = 115
+	const defaultAlignment = 8
+	const defaultMaxChars = 12
+	const defaultLineSpacing = 1.4
+	const defaultMarginX = 60
+	const defaultMarginY = 240
+
+	props := CaptionStyle{
+		Font:            defaultFont,
+		FontSize:        defaultFontSize,
+		PrimaryColour:   defaultPrimaryColour,
+		SecondaryColour: defaultSecondaryColour,
+		OutlineColour:   defaultOutlineColour,
+		BackColour:      defaultBackColour,
+		Outline:         defaultOutline,
+		Shadow:          defaultShadow,
+		Mode:            defaultMode,
+		HighlightColour: defaultHighlightColour,
+		HighlightScale:  defaultHighlightScale,
+		Alignment:       defaultAlignment,
+		MaxChars:        defaultMaxChars,
+		LineSpacing:     defaultLineSpacing,
+		MarginX:         defaultMarginX,
+		MarginY:         defaultMarginY,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
+
+func (s *CaptionStyle) Validate() error {
+	var errs []error
+	if s.Font == "" {
+		errs = append(errs, errors.New("font must be set"))
+	}
+	if s.FontSize <= 0 {
+		errs = append(errs, fmt.Errorf("font_size must be positive, got %d", s.FontSize))
+	}
+	if s.MaxChars <= 0 {
+		errs = append(errs, fmt.Errorf("max_chars must be positive, got %d", s.MaxChars))
+	}
+	if s.LineSpacing <= 0 {
+		errs = append(errs, fmt.Errorf("line_spacing must be positive, got %g", s.LineSpacing))
+	}
+	// Styles recorded before modes existed have no mode and render as appear
+	if s.Mode != "" && !slices.Contains(CaptionModes, s.Mode) {
+		errs = append(errs, fmt.Errorf("unknown mode %s, expected one of %s", s.Mode, strings.Join(CaptionModeNames(), ",")))
+	}
+	if s.Mode == CaptionModePop && s.HighlightScale <= 0 {
+		errs = append(errs, fmt.Errorf("highlight_scale must be positive, got %d", s.HighlightScale))
+	}
+	if s.Alignment < 1 || s.Alignment > 9 {
+		errs = append(errs, fmt.Errorf("alignment must be between 1 and 9, got %d", s.Alignment))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,14 @@
+package model
+
+// CensorEvent records a word censored in a clip's captions. Times are in
+// seconds from the start of the window, like the transcript's.
+type CensorEvent struct {
+	Word string `json:"word"`
+	// Replacement is what the captions show instead, "" if the word was
+	// dropped.
+	Replacement string `json:"replacement"`
+	// Rule is the rule that matched, e.g. "stem fuck".
+	Rule  string  `json:"rule"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
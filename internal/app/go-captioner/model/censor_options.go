@@ -0,0 +1,20 @@
+package model
+
+type CensorOptions struct {
+	CensorPath string
+	CensorList string
+}
+
+func NewCensorOptions(opts ...func(*CensorOptions)) *CensorOptions {
+	const defaultCensorPath = "./scripts/censor.yaml"
+	const defaultCensorList = "default"
+
+	props := CensorOptions{
+		CensorPath: defaultCensorPath,
+		CensorList: defaultCensorList,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
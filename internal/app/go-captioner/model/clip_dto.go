@@ -2,18 +2,76 @@ package model
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"text/tabwriter"
+	"time"
 )
 
 type ClipDTO struct {
-	AudioInputPath          string  `json:"AudioInputPath"`
-	VideoInputPath          string  `json:"VideoInputPath"`
-	SRTCaptionPath          *string `json:"SRTCaptionPath"`
-	CaptionsVideoOutputPath *string `json:"CaptionsVideoOutputPath"`
-	TrimmedVideoOutputPath  *string `json:"TrimmedVideoOutputPath"`
-	ID                      *int    `json:"ID"`
-	Hash                    *string `json:"Hash"`
+	AudioInputPath          string        `json:"AudioInputPath"`
+	VideoInputPath          string        `json:"VideoInputPath"`
+	SRTCaptionPath          *string       `json:"SRTCaptionPath"`
+	CaptionsVideoOutputPath *string       `json:"CaptionsVideoOutputPath"`
+	TrimmedVideoOutputPath  *string       `json:"TrimmedVideoOutputPath"`
+	StartTime               string        `json:"StartTime"`
+	EndTime                 string        `json:"EndTime"`
+	TranscriptPath          *string       `json:"TranscriptPath"`
+	CaptionStyle            *CaptionStyle `json:"CaptionStyle"`
+	SegmentPlan             *SegmentPlan  `json:"SegmentPlan"`
+	// SubtitlesPath is the subtitle file the captions were taken from in place
+	// of a transcript.
+	SubtitlesPath *string `json:"SubtitlesPath"`
+	// LyricsPath is the lyrics file the captions' words were taken from, and
+	// LyricsAlignment how well it matched the transcript.
+	LyricsPath      *string          `json:"LyricsPath"`
+	LyricsAlignment *LyricsAlignment `json:"LyricsAlignment"`
+	// CensorLog records every word censored in the captions.
+	CensorLog []CensorEvent `json:"CensorLog"`
+	// CaptionsReviewedAt is when someone last reviewed the clip's captions
+	// word by word, nil if nobody has.
+	CaptionsReviewedAt *time.Time `json:"CaptionsReviewedAt"`
+	// Seed drives every random choice made for the clip, so its renders can
+	// be reproduced.
+	Seed *int64 `json:"Seed"`
+	// BackgroundStart is where the background video was cut from, in seconds,
+	// when it isn't cut into segments.
+	BackgroundStart *float64 `json:"BackgroundStart"`
+	Width           *int     `json:"Width"`
+	Height          *int     `json:"Height"`
+	FadeDuration    *int     `json:"FadeDuration"`
+	ID              *int     `json:"ID"`
+	Hash            *string  `json:"Hash"`
+	// Artist, Title and Album name the track, from the audio's tags or else
+	// its file name. Title is empty for clips recorded before tracks were.
+	Artist string `json:"Artist"`
+	Title  string `json:"Title"`
+	Album  string `json:"Album"`
+	// Bio is the TikTok caption rendered for the clip, written to BioPath
+	// next to the final video.
+	Bio     *string `json:"Bio"`
+	BioPath *string `json:"BioPath"`
+	// Hashtags are those picked for the bio, kept when it is rendered again.
+	Hashtags []string `json:"Hashtags"`
+	// AudioID and BackgroundVideoID link the clip to its catalogued inputs.
+	AudioID           *int `json:"AudioID"`
+	BackgroundVideoID *int `json:"BackgroundVideoID"`
+	// AudioCensor is how the censored words were hidden in the trimmed
+	// video's audio, nil if they weren't.
+	AudioCensor *AudioCensor `json:"AudioCensor"`
+	// FadeInDuration is how many seconds the trimmed video fades in over, nil
+	// if it doesn't.
+	FadeInDuration *float64 `json:"FadeInDuration"`
+	// Loudness is how loud the clip's audio was and what it was normalised
+	// to, nil if it wasn't.
+	Loudness *ClipLoudness `json:"Loudness"`
+
+	Status          ClipStatus                     `json:"Status"`
+	Stage           ClipStage                      `json:"Stage"`
+	LastError       *string                        `json:"LastError"`
+	Attempts        int                            `json:"Attempts"`
+	StageTimestamps map[ClipStage]*StageTimestamps `json:"StageTimestamps"`
 }
 
 func NewClipDTO(
@@ -27,13 +85,15 @@ func NewClipDTO(
 
 ) *ClipDTO {
 	return &ClipDTO{
-		audioInputPath,
-		videoInputPath,
-		srtCaptionPath,
-		captionsVideoOutputPath,
-		trimmedVideoOutputPath,
-		id,
-		hash,
+		AudioInputPath:          audioInputPath,
+		VideoInputPath:          videoInputPath,
+		SRTCaptionPath:          srtCaptionPath,
+		CaptionsVideoOutputPath: captionsVideoOutputPath,
+		TrimmedVideoOutputPath:  trimmedVideoOutputPath,
+		ID:                      id,
+		Hash:                    hash,
+		Status:                  ClipStatusPending,
+		Stage:                   ClipStageCaptions,
 	}
 }
 
@@ -53,13 +113,66 @@ func (clip *ClipDTO) IsValidSRTCaptionPath() bool {
 	return clip.SRTCaptionPath != nil && *clip.SRTCaptionPath != ""
 }
 
+func (clip *ClipDTO) IsValidTranscriptPath() bool {
+	return clip.TranscriptPath != nil && *clip.TranscriptPath != ""
+}
+
 func (clip *ClipDTO) IsValidTrimmedVideoOutputPath() bool {
 	return clip.TrimmedVideoOutputPath != nil && *clip.TrimmedVideoOutputPath != ""
 }
 
+// Window returns the window recorded on the clip, or the defaults if the clip
+// hasn't been captioned yet.
+func (clip *ClipDTO) Window(defaultStart, defaultEnd string) (string, string) {
+	if clip.StartTime == "" || clip.EndTime == "" {
+		return defaultStart, defaultEnd
+	}
+	return clip.StartTime, clip.EndTime
+}
+
+func (clip *ClipDTO) SetTrackInfo(track TrackInfo) {
+	clip.Artist = track.Artist
+	clip.Title = track.Title
+	clip.Album = track.Album
+}
+
+// BackgroundRanges returns the stretches of background video the clip was
+// rendered from, if it has been.
+func (clip *ClipDTO) BackgroundRanges() []VideoRange {
+	if clip.SegmentPlan != nil {
+		ranges := make([]VideoRange, 0, len(clip.SegmentPlan.Segments))
+		for _, segment := range clip.SegmentPlan.Segments {
+			ranges = append(ranges, VideoRange{
+				Video: segment.Video,
+				Start: segment.Start,
+				End:   segment.Start + segment.Duration,
+			})
+		}
+		return ranges
+	}
+
+	if clip.BackgroundStart == nil {
+		return nil
+	}
+	start, errStart := strconv.Atoi(clip.StartTime)
+	end, errEnd := strconv.Atoi(clip.EndTime)
+	if errStart != nil || errEnd != nil {
+		return nil
+	}
+	return []VideoRange{{
+		Video: clip.VideoInputPath,
+		Start: *clip.BackgroundStart,
+		End:   *clip.BackgroundStart + float64(end-start),
+	}}
+}
+
 func (clip *ClipDTO) PrintTable() error {
+	return clip.FprintTable(os.Stdout)
+}
+
+func (clip *ClipDTO) FprintTable(out io.Writer) error {
 	const tablePadding = 2
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+	w := tabwriter.NewWriter(out, 0, 0, tablePadding, ' ', 0)
 
 	printRow := func(field, val string) error {
 		_, err := fmt.Fprintf(w, "%s\t%s\n", field, val)
@@ -80,21 +193,92 @@ func (clip *ClipDTO) PrintTable() error {
 		return err
 	}
 
+	if clip.Title != "" {
+		track := TrackInfo{Artist: clip.Artist, Title: clip.Title, Album: clip.Album}
+		if err := printRow("Track", track.Name()); err != nil {
+			return err
+		}
+		if clip.Album != "" {
+			if err := printRow("Album", clip.Album); err != nil {
+				return err
+			}
+		}
+	}
+	if err := printRow("TranscriptPath", get(clip.TranscriptPath)); err != nil {
+		return err
+	}
 	if err := printRow("SRTCaptionPath", get(clip.SRTCaptionPath)); err != nil {
 		return err
 	}
+	if clip.SubtitlesPath != nil {
+		if err := printRow("SubtitlesPath", *clip.SubtitlesPath); err != nil {
+			return err
+		}
+	}
+	if clip.LyricsPath != nil && clip.LyricsAlignment != nil {
+		lyrics := fmt.Sprintf("%s (%.0f%% confidence)", *clip.LyricsPath, clip.LyricsAlignment.Confidence()*100)
+		if err := printRow("LyricsPath", lyrics); err != nil {
+			return err
+		}
+	}
+	if len(clip.CensorLog) > 0 {
+		if err := printRow("Censored", fmt.Sprintf("%d word(s)", len(clip.CensorLog))); err != nil {
+			return err
+		}
+	}
+	if clip.Loudness != nil {
+		loudness := fmt.Sprintf("%.1f LUFS, normalised to %g LUFS", clip.Loudness.Measured.Integrated, clip.Loudness.Target.Integrated)
+		if err := printRow("Loudness", loudness); err != nil {
+			return err
+		}
+	}
+	if clip.AudioCensor != nil {
+		audio := fmt.Sprintf("%s (%gs padding)", clip.AudioCensor.Mode, clip.AudioCensor.Padding)
+		if err := printRow("AudioCensor", audio); err != nil {
+			return err
+		}
+	}
+	if clip.CaptionsReviewedAt != nil {
+		if err := printRow("CaptionsReviewedAt", clip.CaptionsReviewedAt.Format(time.DateTime)); err != nil {
+			return err
+		}
+	}
+	if clip.CaptionStyle != nil {
+		name := clip.CaptionStyle.Name
+		if name == "" {
+			name = "<default>"
+		}
+		if err := printRow("CaptionStyle", name); err != nil {
+			return err
+		}
+	}
 	if err := printRow("AudioInputPath", get(&clip.AudioInputPath)); err != nil {
 		return err
 	}
 	if err := printRow("VideoInputPath", get(&clip.VideoInputPath)); err != nil {
 		return err
 	}
+	if err := printRow("Window", fmt.Sprintf("%s-%s", clip.StartTime, clip.EndTime)); err != nil {
+		return err
+	}
+	if clip.SegmentPlan != nil {
+		plan := fmt.Sprintf("%d cuts at %.0f BPM", len(clip.SegmentPlan.Segments), clip.SegmentPlan.BPM)
+		if err := printRow("SegmentPlan", plan); err != nil {
+			return err
+		}
+	}
 	if err := printRow("CaptionsVideoOutputPath", get(clip.CaptionsVideoOutputPath)); err != nil {
 		return err
 	}
 	if err := printRow("TrimmedVideoOutputPath", get(clip.TrimmedVideoOutputPath)); err != nil {
 		return err
 	}
+	if err := printRow("Status", fmt.Sprintf("%s (%s, attempt %d)", clip.Status, clip.Stage, clip.Attempts)); err != nil {
+		return err
+	}
+	if err := printRow("LastError", get(clip.LastError)); err != nil {
+		return err
+	}
 
 	return w.Flush()
 }
@@ -0,0 +1,235 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// ClipStatus describes where a clip is within its current stage.
+type ClipStatus string
+
+const (
+	ClipStatusPending   ClipStatus = "pending"
+	ClipStatusRunning   ClipStatus = "running"
+	ClipStatusFailed    ClipStatus = "failed"
+	ClipStatusCompleted ClipStatus = "completed"
+)
+
+// Values implements ent's EnumValues so the type can back the schema enum.
+func (ClipStatus) Values() []string {
+	return []string{
+		string(ClipStatusPending),
+		string(ClipStatusRunning),
+		string(ClipStatusFailed),
+		string(ClipStatusCompleted),
+	}
+}
+
+func IsValidClipStatus(status string) bool {
+	return slices.Contains(ClipStatus("").Values(), status)
+}
+
+// ClipStage is a step of the generation pipeline, in the order they run.
+type ClipStage string
+
+const (
+	ClipStageCaptions ClipStage = "captions"
+	ClipStageBurn     ClipStage = "burn"
+	ClipStageTrim     ClipStage = "trim"
+	ClipStageBio      ClipStage = "bio"
+)
+
+var ClipStages = []ClipStage{
+	ClipStageCaptions,
+	ClipStageBurn,
+	ClipStageTrim,
+	ClipStageBio,
+}
+
+// Values implements ent's EnumValues so the type can back the schema enum.
+func (ClipStage) Values() []string {
+	values := make([]string, 0, len(ClipStages))
+	for _, stage := range ClipStages {
+		values = append(values, string(stage))
+	}
+	return values
+}
+
+// Next returns the stage following s, or false if s is the final stage.
+func (s ClipStage) Next() (ClipStage, bool) {
+	i := slices.Index(ClipStages, s)
+	if i < 0 || i+1 >= len(ClipStages) {
+		return "", false
+	}
+	return ClipStages[i+1], true
+}
+
+// Before reports whether s runs before other.
+func (s ClipStage) Before(other ClipStage) bool {
+	return slices.Index(ClipStages, s) < slices.Index(ClipStages, other)
+}
+
+type StageTimestamps struct {
+	StartedAt  *time.Time `json:"StartedAt,omitempty"`
+	FinishedAt *time.Time `json:"FinishedAt,omitempty"`
+	FailedAt   *time.Time `json:"FailedAt,omitempty"`
+}
+
+// StartStage marks stage as running and counts the attempt.
+func (clip *ClipDTO) StartStage(stage ClipStage) {
+	if clip.Stage != stage {
+		clip.Attempts = 0
+	}
+
+	now := time.Now()
+	ts := clip.timestamps(stage)
+	ts.StartedAt = &now
+	ts.FinishedAt = nil
+	ts.FailedAt = nil
+
+	clip.Stage = stage
+	clip.Status = ClipStatusRunning
+	clip.Attempts++
+}
+
+// CompleteStage records stage as done and moves the clip on to the next stage,
+// or marks the clip completed once the final stage has finished.
+func (clip *ClipDTO) CompleteStage(stage ClipStage) {
+	now := time.Now()
+	clip.timestamps(stage).FinishedAt = &now
+	clip.LastError = nil
+
+	next, ok := stage.Next()
+	if !ok {
+		clip.Stage = stage
+		clip.Status = ClipStatusCompleted
+		return
+	}
+
+	clip.Stage = next
+	clip.Status = ClipStatusPending
+	clip.Attempts = 0
+}
+
+// FailStage records err against stage. The clip stays on stage so it can be
+// retried later.
+func (clip *ClipDTO) FailStage(stage ClipStage, err error) {
+	now := time.Now()
+	clip.timestamps(stage).FailedAt = &now
+
+	msg := err.Error()
+	clip.Stage = stage
+	clip.Status = ClipStatusFailed
+	clip.LastError = &msg
+}
+
+// InterruptStage returns a stage that was cancelled part way through to
+// pending, so the next run picks it up again without counting as a failure.
+func (clip *ClipDTO) InterruptStage(stage ClipStage, err error) {
+	msg := err.Error()
+	clip.Stage = stage
+	clip.Status = ClipStatusPending
+	clip.LastError = &msg
+}
+
+// RewindTo moves the clip back to stage so that it and every later stage run
+// again, e.g. because one of its outputs disappeared from disk.
+func (clip *ClipDTO) RewindTo(stage ClipStage) {
+	if clip.Status != ClipStatusCompleted && !stage.Before(clip.Stage) {
+		return
+	}
+
+	clip.Stage = stage
+	clip.Status = ClipStatusPending
+	clip.Attempts = 0
+}
+
+// InvalidateFrom marks the outputs of stage and every later stage stale, e.g.
+// because an earlier output was regenerated, and rewinds the clip to stage.
+// The stale paths are kept so the stages may replace those files when they
+// run again.
+func (clip *ClipDTO) InvalidateFrom(stage ClipStage) {
+	for _, s := range ClipStages {
+		if s.Before(stage) {
+			continue
+		}
+		if ts, ok := clip.StageTimestamps[s]; ok {
+			ts.FinishedAt = nil
+		}
+	}
+
+	clip.RewindTo(stage)
+}
+
+// NeedsStage reports whether stage still has to run for this clip.
+func (clip *ClipDTO) NeedsStage(stage ClipStage) bool {
+	if clip.Status == ClipStatusCompleted {
+		return false
+	}
+	return !stage.Before(clip.Stage)
+}
+
+// ArtifactPath returns the output produced by stage, if any.
+func (clip *ClipDTO) ArtifactPath(stage ClipStage) *string {
+	switch stage {
+	case ClipStageCaptions:
+		return clip.SRTCaptionPath
+	case ClipStageBurn:
+		return clip.CaptionsVideoOutputPath
+	case ClipStageTrim:
+		return clip.TrimmedVideoOutputPath
+	case ClipStageBio:
+		return clip.BioPath
+	}
+	return nil
+}
+
+// OwnsArtifact reports whether the file at path was written by this clip's
+// stage, either as its recorded output or by an earlier attempt at the stage
+// now being retried, and so may be replaced without asking.
+func (clip *ClipDTO) OwnsArtifact(stage ClipStage, path string) bool {
+	if recorded := clip.ArtifactPath(stage); recorded != nil && *recorded == path {
+		return true
+	}
+	return clip.Stage == stage && clip.Attempts > 1
+}
+
+// ReconcileArtifacts rewinds the clip to the first finished stage whose output
+// no longer exists. Clips created before stages were tracked have no
+// timestamps, so their progress is inferred from the outputs alone.
+func (clip *ClipDTO) ReconcileArtifacts(exists func(path string) bool) {
+	untracked := len(clip.StageTimestamps) == 0
+
+	for _, stage := range ClipStages {
+		path := clip.ArtifactPath(stage)
+		present := path != nil && exists(*path)
+
+		if untracked {
+			if !present {
+				clip.Stage = stage
+				clip.Status = ClipStatusPending
+				return
+			}
+			clip.CompleteStage(stage)
+			continue
+		}
+
+		if !clip.NeedsStage(stage) && !present {
+			clip.RewindTo(stage)
+			return
+		}
+	}
+}
+
+func (clip *ClipDTO) timestamps(stage ClipStage) *StageTimestamps {
+	if clip.StageTimestamps == nil {
+		clip.StageTimestamps = map[ClipStage]*StageTimestamps{}
+	}
+
+	ts, ok := clip.StageTimestamps[stage]
+	if !ok {
+		ts = &StageTimestamps{}
+		clip.StageTimestamps[stage] = ts
+	}
+	return ts
+}
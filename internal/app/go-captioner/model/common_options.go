@@ -0,0 +1,96 @@
+package model
+
+import "time"
+
+// CommonOptions are the options shared by the caption and batch commands.
+type CommonOptions struct {
+	AudioPath         string
+	VideoPath         string
+	OutputDir         string
+	WhisperModel      string
+	Verbose           bool
+	StartTime         string
+	EndTime           string
+	AutoWindow        bool
+	BeatSync          bool
+	BeatsPerCut       int
+	Seed              int64
+	NoInteract        bool
+	Height            int
+	Width             int
+	FadeDuration      int
+	CensorPath        string
+	CensorList        string
+	TranscribeTimeout time.Duration
+	BurnTimeout       time.Duration
+	TrimTimeout       time.Duration
+	Transcriber       TranscriberOptions
+	StyleName         string
+	CaptionStyle      CaptionStyle
+	NamingTemplate    string
+	Overwrite         bool
+	// BiosDir holds the bio templates, see BioData.
+	BiosDir      string
+	BioMaxLength int
+	Hashtags     HashtagOptions
+	// SubtitlesDir holds subtitle files, named after the audio or its hash,
+	// captioned from instead of transcribing.
+	SubtitlesDir string
+	// LyricsDir holds lyrics files named after the audio or its hash.
+	LyricsDir           string
+	LyricsMinConfidence float64
+	// AudioCensor names how censored words are hidden in the trimmed video's
+	// audio, see AudioCensorModes, hiding AudioCensorPadding seconds either
+	// side of each.
+	AudioCensor        string
+	AudioCensorPadding float64
+	FadeInDuration     float64
+	Loudness           LoudnessOptions
+}
+
+func NewCommonOptions(opts ...func(*CommonOptions)) *CommonOptions {
+	const defaultOutputDir = "output"
+	const defaultWhisperModel = "base"
+	const defaultStartTime = "0"
+	const defaultEndTime = "30"
+	const defaultHeight = 1920
+	const defaultWidth = 1080
+	const defaultFadeDuration = 5
+	const defaultCensorPath = "./scripts/censor.yaml"
+	const defaultCensorList = "default"
+	const defaultBeatsPerCut = 4
+	const defaultBiosDir = "bios"
+	const defaultSubtitlesDir = "subtitles"
+	const defaultLyricsDir = "lyrics"
+	const defaultLyricsMinConfidence = 0.5
+	const defaultAudioCensorPadding = 0.1
+
+	props := CommonOptions{
+		OutputDir:           defaultOutputDir,
+		WhisperModel:        defaultWhisperModel,
+		StartTime:           defaultStartTime,
+		EndTime:             defaultEndTime,
+		Height:              defaultHeight,
+		Width:               defaultWidth,
+		FadeDuration:        defaultFadeDuration,
+		CensorPath:          defaultCensorPath,
+		CensorList:          defaultCensorList,
+		Transcriber:         *NewTranscriberOptions(),
+		CaptionStyle:        *NewCaptionStyle(),
+		BeatsPerCut:         defaultBeatsPerCut,
+		NamingTemplate:      DefaultNamingTemplate,
+		BiosDir:             defaultBiosDir,
+		BioMaxLength:        TikTokCaptionLimit,
+		Hashtags:            *NewHashtagOptions(),
+		SubtitlesDir:        defaultSubtitlesDir,
+		LyricsDir:           defaultLyricsDir,
+		LyricsMinConfidence: defaultLyricsMinConfidence,
+		AudioCensor:         string(AudioCensorOff),
+		AudioCensorPadding:  defaultAudioCensorPadding,
+		Loudness:            *NewLoudnessOptions(),
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
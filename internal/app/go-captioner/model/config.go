@@ -0,0 +1,157 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Config is the contents of a tiktok-creator.yaml file. Defaults apply to every
+// run, and a profile selected with --profile or DefaultProfile is layered on
+// top of them.
+type Config struct {
+	DefaultProfile string                   `yaml:"default_profile,omitempty"`
+	Defaults       ConfigProfile            `yaml:"defaults,omitempty"`
+	Profiles       map[string]ConfigProfile `yaml:"profiles,omitempty"`
+}
+
+// ConfigProfile holds settings for the caption and batch commands. Values are
+// kept as strings and parsed by the flag they set, and empty values are unset.
+type ConfigProfile struct {
+	AudioPath    string `yaml:"audio_path,omitempty"`
+	VideoPath    string `yaml:"video_path,omitempty"`
+	Output       string `yaml:"output,omitempty"`
+	Model        string `yaml:"model,omitempty"`
+	Transcriber  string `yaml:"transcriber,omitempty"`
+	StartTime    string `yaml:"start_time,omitempty"`
+	EndTime      string `yaml:"end_time,omitempty"`
+	AutoWindow   string `yaml:"auto_window,omitempty"`
+	Width        string `yaml:"width,omitempty"`
+	Height       string `yaml:"height,omitempty"`
+	FadeDuration string `yaml:"fade_duration,omitempty"`
+	BeatSync     string `yaml:"beat_sync,omitempty"`
+	BeatsPerCut  string `yaml:"beats_per_cut,omitempty"`
+	Seed         string `yaml:"seed,omitempty"`
+	// Background settings only apply to batch.
+	BackgroundStrategy  string `yaml:"background_strategy,omitempty"`
+	BackgroundExclude   string `yaml:"background_exclude,omitempty"`
+	Style               string `yaml:"style,omitempty"`
+	Censor              string `yaml:"censor,omitempty"`
+	CensorList          string `yaml:"censor_list,omitempty"`
+	AudioCensor         string `yaml:"audio_censor,omitempty"`
+	AudioCensorPadding  string `yaml:"audio_censor_padding,omitempty"`
+	FadeIn              string `yaml:"fade_in,omitempty"`
+	Normalise           string `yaml:"normalise,omitempty"`
+	LoudnessTarget      string `yaml:"loudness_target,omitempty"`
+	TruePeak            string `yaml:"true_peak,omitempty"`
+	LoudnessRange       string `yaml:"loudness_range,omitempty"`
+	Subtitles           string `yaml:"subtitles,omitempty"`
+	Lyrics              string `yaml:"lyrics,omitempty"`
+	LyricsMinConfidence string `yaml:"lyrics_min_confidence,omitempty"`
+	Naming              string `yaml:"naming,omitempty"`
+	Bios                string `yaml:"bios,omitempty"`
+	BioMaxLength        string `yaml:"bio_max_length,omitempty"`
+	Hashtags            string `yaml:"hashtags,omitempty"`
+	HashtagStrategy     string `yaml:"hashtag_strategy,omitempty"`
+	HashtagMax          string `yaml:"hashtag_max,omitempty"`
+}
+
+// ConfigField is a single profile setting and the flag it sets.
+type ConfigField struct {
+	Key   string
+	Flag  string
+	Value *string
+}
+
+func (p *ConfigProfile) Fields() []ConfigField {
+	return []ConfigField{
+		{Key: "audio_path", Flag: "audioPath", Value: &p.AudioPath},
+		{Key: "video_path", Flag: "videoPath", Value: &p.VideoPath},
+		{Key: "output", Flag: "output", Value: &p.Output},
+		{Key: "model", Flag: "model", Value: &p.Model},
+		{Key: "transcriber", Flag: "transcriber", Value: &p.Transcriber},
+		{Key: "start_time", Flag: "startTime", Value: &p.StartTime},
+		{Key: "end_time", Flag: "endTime", Value: &p.EndTime},
+		{Key: "auto_window", Flag: "auto-window", Value: &p.AutoWindow},
+		{Key: "width", Flag: "width", Value: &p.Width},
+		{Key: "height", Flag: "height", Value: &p.Height},
+		{Key: "fade_duration", Flag: "fade-duration", Value: &p.FadeDuration},
+		{Key: "beat_sync", Flag: "beat-sync", Value: &p.BeatSync},
+		{Key: "beats_per_cut", Flag: "beats-per-cut", Value: &p.BeatsPerCut},
+		{Key: "seed", Flag: "seed", Value: &p.Seed},
+		{Key: "background_strategy", Flag: "background-strategy", Value: &p.BackgroundStrategy},
+		{Key: "background_exclude", Flag: "background-exclude", Value: &p.BackgroundExclude},
+		{Key: "style", Flag: "style", Value: &p.Style},
+		{Key: "censor", Flag: "censor", Value: &p.Censor},
+		{Key: "censor_list", Flag: "censor-list", Value: &p.CensorList},
+		{Key: "audio_censor", Flag: "audio-censor", Value: &p.AudioCensor},
+		{Key: "audio_censor_padding", Flag: "audio-censor-padding", Value: &p.AudioCensorPadding},
+		{Key: "fade_in", Flag: "fade-in", Value: &p.FadeIn},
+		{Key: "normalise", Flag: "normalise", Value: &p.Normalise},
+		{Key: "loudness_target", Flag: "loudness-target", Value: &p.LoudnessTarget},
+		{Key: "true_peak", Flag: "true-peak", Value: &p.TruePeak},
+		{Key: "loudness_range", Flag: "loudness-range", Value: &p.LoudnessRange},
+		{Key: "subtitles", Flag: "subtitles", Value: &p.Subtitles},
+		{Key: "lyrics", Flag: "lyrics", Value: &p.Lyrics},
+		{Key: "lyrics_min_confidence", Flag: "lyrics-min-confidence", Value: &p.LyricsMinConfidence},
+		{Key: "naming", Flag: "naming", Value: &p.Naming},
+		{Key: "bios", Flag: "bios", Value: &p.Bios},
+		{Key: "bio_max_length", Flag: "bio-max-length", Value: &p.BioMaxLength},
+		{Key: "hashtags", Flag: "hashtags", Value: &p.Hashtags},
+		{Key: "hashtag_strategy", Flag: "hashtag-strategy", Value: &p.HashtagStrategy},
+		{Key: "hashtag_max", Flag: "hashtag-max", Value: &p.HashtagMax},
+	}
+}
+
+// Merge overwrites the settings of p that are set in other.
+func (p *ConfigProfile) Merge(other ConfigProfile) {
+	overrides := other.Fields()
+	for i, field := range p.Fields() {
+		if value := *overrides[i].Value; value != "" {
+			*field.Value = value
+		}
+	}
+}
+
+// Merge layers other on top of c, e.g. a project config over the user config.
+func (c *Config) Merge(other *Config) {
+	if other.DefaultProfile != "" {
+		c.DefaultProfile = other.DefaultProfile
+	}
+	c.Defaults.Merge(other.Defaults)
+
+	if c.Profiles == nil {
+		c.Profiles = map[string]ConfigProfile{}
+	}
+	for name, profile := range other.Profiles {
+		merged := c.Profiles[name]
+		merged.Merge(profile)
+		c.Profiles[name] = merged
+	}
+}
+
+// Profile resolves the named profile over the defaults. An empty name selects
+// DefaultProfile, and with neither only the defaults apply.
+func (c *Config) Profile(name string) (ConfigProfile, error) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+
+	resolved := c.Defaults
+	if name == "" {
+		return resolved, nil
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return ConfigProfile{}, fmt.Errorf(
+			"unknown profile %s, expected one of %s",
+			name,
+			strings.Join(slices.Sorted(maps.Keys(c.Profiles)), ","),
+		)
+	}
+
+	resolved.Merge(profile)
+	return resolved, nil
+}
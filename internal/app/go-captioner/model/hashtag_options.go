@@ -0,0 +1,24 @@
+package model
+
+type HashtagOptions struct {
+	// PoolsPath is the YAML file of HashtagPools bios draw from.
+	PoolsPath string
+	Strategy  string
+	Max       int
+}
+
+func NewHashtagOptions(opts ...func(*HashtagOptions)) *HashtagOptions {
+	const defaultPoolsPath = "bios/hashtags.yaml"
+	const defaultStrategy = HashtagStrategyLeastUsed
+	const defaultMax = 5
+
+	props := HashtagOptions{
+		PoolsPath: defaultPoolsPath,
+		Strategy:  string(defaultStrategy),
+		Max:       defaultMax,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
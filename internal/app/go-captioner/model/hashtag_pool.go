@@ -0,0 +1,69 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DefaultHashtagPool is used for artists without a pool of their own.
+const DefaultHashtagPool = "default"
+
+// HashtagPool is a set of hashtags bios draw from.
+type HashtagPool struct {
+	// Include names other pools drawn from too, e.g. a genre shared by
+	// several artists.
+	Include []string `yaml:"include"`
+	// Always are given to every bio using the pool, ahead of Tags.
+	Always []string `yaml:"always"`
+	// Tags are picked from to fill the rest of the bio.
+	Tags []string `yaml:"tags"`
+	// Max caps how many hashtags a bio gets from the pool, 0 for no cap of
+	// its own.
+	Max int `yaml:"max"`
+}
+
+// HashtagPools are the pools by name. A clip uses the one named after its
+// artist, matched like bio templates, or else DefaultHashtagPool.
+type HashtagPools map[string]HashtagPool
+
+// HashtagStrategy decides which of a pool's hashtags a bio gets.
+type HashtagStrategy string
+
+const (
+	// HashtagStrategyRandom picks any hashtags with equal chance.
+	HashtagStrategyRandom HashtagStrategy = "random"
+	// HashtagStrategyRoundRobin picks hashtags never used first, then those
+	// used longest ago, in the order the pool lists them.
+	HashtagStrategyRoundRobin HashtagStrategy = "round-robin"
+	// HashtagStrategyLeastUsed picks the hashtags used the fewest times,
+	// breaking ties at random.
+	HashtagStrategyLeastUsed HashtagStrategy = "least-used"
+)
+
+var HashtagStrategies = []HashtagStrategy{
+	HashtagStrategyRandom,
+	HashtagStrategyRoundRobin,
+	HashtagStrategyLeastUsed,
+}
+
+func ParseHashtagStrategy(name string) (HashtagStrategy, error) {
+	strategy := HashtagStrategy(name)
+	if !slices.Contains(HashtagStrategies, strategy) {
+		return "", fmt.Errorf("unknown hashtag strategy %s, expected one of %s", name, joinNames(HashtagStrategies))
+	}
+	return strategy, nil
+}
+
+// HashtagUsage is a hashtag and the clips whose bios used it, oldest first.
+type HashtagUsage struct {
+	Hashtag string
+	ClipIDs []int
+}
+
+// LastClipID returns the newest clip to use the hashtag, or 0 if none have.
+func (u HashtagUsage) LastClipID() int {
+	if len(u.ClipIDs) == 0 {
+		return 0
+	}
+	return u.ClipIDs[len(u.ClipIDs)-1]
+}
@@ -0,0 +1,31 @@
+package model
+
+// LoudnessTarget is what audio is normalised to, following EBU R128.
+type LoudnessTarget struct {
+	// Integrated is the loudness over the whole clip in LUFS.
+	Integrated float64 `json:"integrated"`
+	// TruePeak is the ceiling peaks are limited to in dBTP.
+	TruePeak float64 `json:"true_peak"`
+	// Range is the loudness range allowed in LU, beyond which quiet and loud
+	// parts are compressed together.
+	Range float64 `json:"range"`
+}
+
+// Loudness is what a first loudnorm pass measured of some audio, which the
+// second pass needs to normalise it in one linear gain change.
+type Loudness struct {
+	Integrated float64 `json:"integrated"`
+	TruePeak   float64 `json:"true_peak"`
+	Range      float64 `json:"range"`
+	Threshold  float64 `json:"threshold"`
+	// Offset is the gain in LU the first pass expects the second to need on
+	// top of its own after limiting.
+	Offset float64 `json:"offset"`
+}
+
+// ClipLoudness is how loud a clip's audio was when burned and the target it
+// was normalised to when trimmed.
+type ClipLoudness struct {
+	Measured Loudness       `json:"measured"`
+	Target   LoudnessTarget `json:"target"`
+}
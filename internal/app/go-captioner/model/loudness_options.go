@@ -0,0 +1,27 @@
+package model
+
+type LoudnessOptions struct {
+	// Normalise turns loudness normalisation on, off by default so clips
+	// keep their tracks' mastering.
+	Normalise bool
+	Target    LoudnessTarget
+}
+
+func NewLoudnessOptions(opts ...func(*LoudnessOptions)) *LoudnessOptions {
+	// TikTok plays audio back at around -14 LUFS
+	const defaultIntegrated = -14
+	const defaultTruePeak = -1
+	const defaultRange = 11
+
+	props := LoudnessOptions{
+		Target: LoudnessTarget{
+			Integrated: defaultIntegrated,
+			TruePeak:   defaultTruePeak,
+			Range:      defaultRange,
+		},
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
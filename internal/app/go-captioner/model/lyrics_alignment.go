@@ -0,0 +1,35 @@
+package model
+
+// LyricsAlignment records how well a clip's lyrics matched its transcript.
+type LyricsAlignment struct {
+	Lines []AlignedLyricLine `json:"lines"`
+	// Dropped counts the transcribed words missing from the lyrics, such as
+	// ad-libs or words misheard into the gaps, which the captions leave out.
+	Dropped int `json:"dropped"`
+}
+
+// AlignedLyricLine is a line of lyrics as it was placed on the transcript,
+// Start and End in seconds from the start of the window. Confidence is the
+// share of its words that matched a transcribed word, with near misses
+// counting half.
+type AlignedLyricLine struct {
+	Text       string  `json:"text"`
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Words      int     `json:"words"`
+	Confidence float64 `json:"confidence"`
+}
+
+// Confidence is the confidence of every line, weighted by their words.
+func (a *LyricsAlignment) Confidence() float64 {
+	var total float64
+	words := 0
+	for _, line := range a.Lines {
+		total += line.Confidence * float64(line.Words)
+		words += line.Words
+	}
+	if words == 0 {
+		return 0
+	}
+	return total / float64(words)
+}
@@ -0,0 +1,28 @@
+package model
+
+// MediaInfo is what ffprobe reports about a media file.
+type MediaInfo struct {
+	Duration float64
+
+	AudioCodec string
+	SampleRate int
+	Channels   int
+
+	// Video fields are empty for audio, cover art embedded in audio files
+	// doesn't count as video.
+	VideoCodec string
+	Width      int
+	Height     int
+	FPS        float64
+
+	// Tags are the container's metadata tags with lowercase keys.
+	Tags map[string]string
+}
+
+func (m *MediaInfo) HasVideo() bool {
+	return m.VideoCodec != ""
+}
+
+func (m *MediaInfo) HasAudio() bool {
+	return m.AudioCodec != ""
+}
@@ -0,0 +1,19 @@
+package model
+
+// DefaultNamingTemplate names outputs by artist, with the track, its Hash8 and
+// the stage that wrote it.
+const DefaultNamingTemplate = "{{.Artist}}/{{.Track}}-{{.Hash8}}-{{.Stage}}"
+
+// OutputName is the data available to the output naming template.
+type OutputName struct {
+	// Artist and Track come from the audio's tags or else its file name.
+	Artist string
+	Track  string
+	// AudioHash is the MD5 of the audio file.
+	AudioHash string
+	// Hash8 is a short digest of everything that shapes the output: the audio,
+	// background video, time window, caption style and, for videos, any beat
+	// synced cuts.
+	Hash8 string
+	Stage ClipStage
+}
@@ -0,0 +1,27 @@
+package model
+
+type RecaptionOptions struct {
+	OutputDir      string
+	CensorPath     string
+	CensorList     string
+	StyleName      string
+	CaptionStyle   CaptionStyle
+	NamingTemplate string
+	Overwrite      bool
+}
+
+func NewRecaptionOptions(opts ...func(*RecaptionOptions)) *RecaptionOptions {
+	const defaultCensorPath = "./scripts/censor.yaml"
+	const defaultCensorList = "default"
+
+	props := RecaptionOptions{
+		CensorPath:     defaultCensorPath,
+		CensorList:     defaultCensorList,
+		CaptionStyle:   *NewCaptionStyle(),
+		NamingTemplate: DefaultNamingTemplate,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
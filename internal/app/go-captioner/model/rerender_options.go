@@ -0,0 +1,29 @@
+package model
+
+type RerenderOptions struct {
+	OutputDir      string
+	NamingTemplate string
+	BiosDir        string
+	BioMaxLength   int
+	Hashtags       HashtagOptions
+	Overwrite      bool
+	Verbose        bool
+}
+
+func NewRerenderOptions(opts ...func(*RerenderOptions)) *RerenderOptions {
+	const defaultOutputDir = "output"
+	const defaultBiosDir = "bios"
+	const defaultBioMaxLength = TikTokCaptionLimit
+
+	props := RerenderOptions{
+		OutputDir:      defaultOutputDir,
+		NamingTemplate: DefaultNamingTemplate,
+		BiosDir:        defaultBiosDir,
+		BioMaxLength:   defaultBioMaxLength,
+		Hashtags:       *NewHashtagOptions(),
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
@@ -0,0 +1,26 @@
+package model
+
+type ReviewOptions struct {
+	OutputDir      string
+	CensorPath     string
+	CensorList     string
+	NamingTemplate string
+	Overwrite      bool
+}
+
+func NewReviewOptions(opts ...func(*ReviewOptions)) *ReviewOptions {
+	const defaultOutputDir = "output"
+	const defaultCensorPath = "./scripts/censor.yaml"
+	const defaultCensorList = "default"
+
+	props := ReviewOptions{
+		OutputDir:      defaultOutputDir,
+		CensorPath:     defaultCensorPath,
+		CensorList:     defaultCensorList,
+		NamingTemplate: DefaultNamingTemplate,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
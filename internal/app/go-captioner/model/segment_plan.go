@@ -0,0 +1,25 @@
+package model
+
+// SegmentPlan is a clip's background cut into segments on the beat. It is
+// recorded on the clip so the same background can be rendered again.
+type SegmentPlan struct {
+	BPM         float64 `json:"bpm"`
+	BeatsPerCut int     `json:"beats_per_cut"`
+	// Segments play one after another and together last the clip's window.
+	Segments []Segment `json:"segments"`
+}
+
+// Segment is a stretch of one background video.
+type Segment struct {
+	Video string `json:"video"`
+	// Start is where the segment begins in Video, in seconds.
+	Start    float64 `json:"start"`
+	Duration float64 `json:"duration"`
+}
+
+// VideoSource is a background video segments can be cut from.
+type VideoSource struct {
+	Path string
+	// Duration is the length of the video in seconds.
+	Duration float64
+}
@@ -0,0 +1,21 @@
+package model
+
+type SubsOptions struct {
+	// From and To override the formats told by the files' extensions.
+	From     string
+	To       string
+	MaxChars int
+}
+
+func NewSubsOptions(opts ...func(*SubsOptions)) *SubsOptions {
+	// Lines of exported subtitles stay within the usual limit for readability
+	const defaultMaxChars = 42
+
+	props := SubsOptions{
+		MaxChars: defaultMaxChars,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
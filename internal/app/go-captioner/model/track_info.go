@@ -0,0 +1,17 @@
+package model
+
+// TrackInfo names the song an audio file holds. Fields are empty when neither
+// the file's tags nor its name say.
+type TrackInfo struct {
+	Artist string
+	Title  string
+	Album  string
+}
+
+// Name is the track as it is usually written, "Artist - Title".
+func (t TrackInfo) Name() string {
+	if t.Artist == "" {
+		return t.Title
+	}
+	return t.Artist + " - " + t.Title
+}
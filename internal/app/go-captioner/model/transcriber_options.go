@@ -0,0 +1,27 @@
+package model
+
+type TranscriberOptions struct {
+	Backend          string
+	WhisperCppBin    string
+	WhisperCppModels string
+	URL              string
+	APIKey           string
+}
+
+func NewTranscriberOptions(opts ...func(*TranscriberOptions)) *TranscriberOptions {
+	const defaultBackend = "python"
+	const defaultWhisperCppBin = "whisper-cli"
+	const defaultWhisperCppModels = "models"
+	const defaultURL = "http://localhost:8000"
+
+	props := TranscriberOptions{
+		Backend:          defaultBackend,
+		WhisperCppBin:    defaultWhisperCppBin,
+		WhisperCppModels: defaultWhisperCppModels,
+		URL:              defaultURL,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
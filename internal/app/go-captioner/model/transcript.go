@@ -0,0 +1,16 @@
+package model
+
+// Word is a single transcribed word. Times are in seconds relative to the
+// start of the transcribed window, not the start of the track.
+type Word struct {
+	Word        string  `json:"word"`
+	Start       float64 `json:"start"`
+	End         float64 `json:"end"`
+	Probability float64 `json:"probability,omitempty"`
+}
+
+// Transcript holds the word level timestamps of a transcribed window.
+type Transcript struct {
+	Language string `json:"language,omitempty"`
+	Words    []Word `json:"words"`
+}
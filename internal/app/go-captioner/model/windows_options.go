@@ -0,0 +1,21 @@
+package model
+
+type WindowsOptions struct {
+	Length  int
+	Count   int
+	Verbose bool
+}
+
+func NewWindowsOptions(opts ...func(*WindowsOptions)) *WindowsOptions {
+	const defaultLength = 30
+	const defaultCount = 5
+
+	props := WindowsOptions{
+		Length: defaultLength,
+		Count:  defaultCount,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
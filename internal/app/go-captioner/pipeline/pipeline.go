@@ -0,0 +1,261 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// Pipeline runs clips through its stages. Each stage has its own pool of
+// workers, so a clip can be burned while the next one is transcribed.
+type Pipeline struct {
+	Stages  []StageConfig
+	Store   Store
+	Hooks   Hooks
+	Printer *helper.ProgressPrinter
+	// OutputDir is created before any stage runs.
+	OutputDir string
+	// RetryFailed runs clips that failed on a previous run again instead of
+	// skipping them.
+	RetryFailed bool
+}
+
+func New(opts ...func(*Pipeline)) *Pipeline {
+	props := Pipeline{
+		Store:   NewMemoryStore(),
+		Printer: helper.NewProgressPrinter(os.Stdout),
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
+}
+
+// Jobs returns a closed channel holding jobs, for runs whose clips are all
+// known up front.
+func Jobs(jobs ...*Job) <-chan *Job {
+	ch := make(chan *Job, len(jobs))
+	for _, job := range jobs {
+		ch <- job
+	}
+	close(ch)
+	return ch
+}
+
+// Run feeds every job received on jobs through the stages until jobs is closed
+// or ctx is cancelled. Clips that fail don't stop the others; Run reports how
+// many failed once all are done.
+func (p *Pipeline) Run(ctx context.Context, jobs <-chan *Job) error {
+	if p.OutputDir != "" {
+		if err := helper.CreateDirectoryIfNotExists(p.OutputDir); err != nil {
+			return fmt.Errorf("couldn't create output directory %s: %w", p.OutputDir, err)
+		}
+	}
+
+	var failed atomic.Int32
+	fail := func(job *Job, format string, a ...any) bool {
+		failed.Add(1)
+		p.Printer.Printf(job.Prefix, format, a...)
+		return false
+	}
+
+	out := fanOut(1, jobs, func(job *Job) bool {
+		return p.admit(job)
+	})
+	for _, config := range p.Stages {
+		out = fanOut(config.Workers, out, func(job *Job) bool {
+			return p.runStage(ctx, job, config, fail)
+		})
+	}
+
+	var errs []error
+	for job := range out {
+		if p.Hooks.OnDone != nil {
+			if err := p.Hooks.OnDone(job); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if ctx.Err() != nil {
+		errs = append(errs, fmt.Errorf("interrupted, unfinished clips were left pending: %w", ctx.Err()))
+	} else if n := failed.Load(); n > 0 {
+		errs = append(errs, fmt.Errorf("%d clip(s) failed", n))
+	}
+	return errors.Join(errs...)
+}
+
+// admit decides whether a job enters the pipeline at all. Clips whose outputs
+// went missing are rewound so the stages that made them run again.
+func (p *Pipeline) admit(job *Job) bool {
+	clip := job.Clip
+	if job.Output == nil {
+		job.Output = p.Printer.Writer(job.Prefix)
+	}
+
+	if clip.Status == model.ClipStatusFailed && !p.RetryFailed {
+		lastError := ""
+		if clip.LastError != nil {
+			lastError = *clip.LastError
+		}
+		p.Printer.Printf(
+			job.Prefix,
+			"Clip failed during %s after %d attempt(s): %s. Use --retry-failed to try again",
+			clip.Stage,
+			clip.Attempts,
+			lastError,
+		)
+		return false
+	}
+
+	clip.ReconcileArtifacts(helper.Exists)
+	if clip.Status == model.ClipStatusCompleted {
+		p.Printer.Printf(job.Prefix, "Clip already completed, skipping...")
+		return false
+	}
+
+	return true
+}
+
+// runStage runs the stage if the clip hasn't completed it yet, persisting each
+// state transition through the store. It reports whether the clip may move on
+// to the next stage.
+func (p *Pipeline) runStage(
+	ctx context.Context,
+	job *Job,
+	config StageConfig,
+	fail func(job *Job, format string, a ...any) bool,
+) bool {
+	clip := job.Clip
+	stage := config.Stage.Name()
+
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if !clip.NeedsStage(stage) {
+		p.Printer.Printf(job.Prefix, "Stage %s already completed, skipping...", stage)
+		return true
+	}
+
+	if config.Skip {
+		p.Printer.Printf(job.Prefix, "Skipping stage %s for file %s...", stage, clip.AudioInputPath)
+		return false
+	}
+
+	if err := p.Store.StartStage(ctx, clip, stage); err != nil {
+		return fail(job, "Failed updating clip for file %s %s", clip.AudioInputPath, err.Error())
+	}
+
+	p.Printer.Printf(job.Prefix, "Starting %s (attempt %d)...", stage, clip.Attempts)
+	stageCtx, cancel := helper.WithOptionalTimeout(ctx, config.Timeout)
+	err := config.Stage.Run(stageCtx, job)
+	cancel()
+
+	switch {
+	case err == nil:
+	case ctx.Err() != nil:
+		err = p.Store.InterruptStage(ctx, clip, stage, err)
+		p.Printer.Printf(job.Prefix, "Interrupted %s for file %s, left pending: %s", stage, clip.AudioInputPath, err.Error())
+		return false
+	case errors.Is(err, context.DeadlineExceeded):
+		err = p.Store.FailStage(ctx, clip, stage, fmt.Errorf("timed out after %s: %w", config.Timeout, err))
+		return fail(job, "Failed %s for file %s %s", stage, clip.AudioInputPath, err.Error())
+	default:
+		err = p.Store.FailStage(ctx, clip, stage, err)
+		return fail(job, "Failed %s for file %s %s", stage, clip.AudioInputPath, err.Error())
+	}
+
+	// The work is done, so record it even if we were interrupted meanwhile.
+	if err := p.Store.CompleteStage(context.WithoutCancel(ctx), clip, stage); err != nil {
+		return fail(job, "Failed updating clip for file %s %s", clip.AudioInputPath, err.Error())
+	}
+
+	if p.Hooks.AfterStage != nil {
+		if err := p.Hooks.AfterStage(ctx, job, stage); err != nil {
+			if ctx.Err() != nil {
+				return false
+			}
+			return fail(job, "Failed after %s for file %s %s", stage, clip.AudioInputPath, err.Error())
+		}
+	}
+
+	return true
+}
+
+// fanOut fans the jobs received on in out to n workers running fn and forwards
+// every job for which fn returns true on the returned channel. The returned
+// channel is buffered to n so a slow downstream stage applies back-pressure,
+// and it is closed once in is drained and every worker has returned.
+func fanOut(n int, in <-chan *Job, fn func(*Job) bool) <-chan *Job {
+	if n < 1 {
+		n = 1
+	}
+
+	out := make(chan *Job, n)
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for range n {
+		go func() {
+			defer wg.Done()
+			for job := range in {
+				if fn(job) {
+					out <- job
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Review returns an AfterStage hook that, after stage, prints the clip and
+// unless noInteract is set asks whether to review its outputs with review,
+// storing any changes it makes. The output lock is held for the whole review
+// so the prompt and editor aren't buried under progress lines from other
+// workers, review writing to w rather than the job's output.
+func (p *Pipeline) Review(
+	stage model.ClipStage,
+	noInteract bool,
+	review func(ctx context.Context, job *Job, w io.Writer) error,
+) func(ctx context.Context, job *Job, done model.ClipStage) error {
+	return func(ctx context.Context, job *Job, done model.ClipStage) error {
+		if done != stage {
+			return nil
+		}
+
+		return p.Printer.Exclusive(func(w io.Writer) error {
+			if _, err := fmt.Fprintln(w, job.Prefix); err != nil {
+				return err
+			}
+			if err := job.Clip.FprintTable(w); err != nil {
+				return err
+			}
+
+			if noInteract {
+				return nil
+			}
+			ok, err := helper.Confirm(ctx, w, fmt.Sprintf("Review %s now?", stage))
+			if err != nil || !ok {
+				return err
+			}
+			if err := review(ctx, job, w); err != nil {
+				return err
+			}
+			return p.Store.Update(ctx, job.Clip)
+		})
+	}
+}
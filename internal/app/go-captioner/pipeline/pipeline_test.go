@@ -0,0 +1,482 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// fakeStage runs run, or does nothing if it is nil.
+type fakeStage struct {
+	name model.ClipStage
+	run  func(ctx context.Context, job *Job) error
+}
+
+func (s fakeStage) Name() model.ClipStage {
+	return s.name
+}
+
+func (s fakeStage) Run(ctx context.Context, job *Job) error {
+	if s.run == nil {
+		return nil
+	}
+	return s.run(ctx, job)
+}
+
+// recordingStore is a MemoryStore that records each transition and the state
+// the clip was left in by it, by the clip's audio path.
+type recordingStore struct {
+	MemoryStore
+	mu  sync.Mutex
+	log map[string][]string
+}
+
+func newRecordingStore() *recordingStore {
+	return &recordingStore{log: map[string][]string{}}
+}
+
+func (s *recordingStore) record(clip *model.ClipDTO, event string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.log[clip.AudioInputPath] = append(s.log[clip.AudioInputPath], fmt.Sprintf("%s: %s %s", event, clip.Stage, clip.Status))
+}
+
+func (s *recordingStore) StartStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage) error {
+	err := s.MemoryStore.StartStage(ctx, clip, stage)
+	s.record(clip, "start")
+	return err
+}
+
+func (s *recordingStore) CompleteStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage) error {
+	err := s.MemoryStore.CompleteStage(ctx, clip, stage)
+	s.record(clip, "complete")
+	return err
+}
+
+func (s *recordingStore) FailStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage, err error) error {
+	err = s.MemoryStore.FailStage(ctx, clip, stage, err)
+	s.record(clip, "fail")
+	return err
+}
+
+func (s *recordingStore) InterruptStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage, err error) error {
+	err = s.MemoryStore.InterruptStage(ctx, clip, stage, err)
+	s.record(clip, "interrupt")
+	return err
+}
+
+// trace records the stages run on each clip in the order they ran.
+type trace struct {
+	mu  sync.Mutex
+	ran map[string][]model.ClipStage
+}
+
+func (t *trace) stage(name model.ClipStage, run func(ctx context.Context, job *Job) error) StageConfig {
+	return StageConfig{
+		Stage: fakeStage{name: name, run: func(ctx context.Context, job *Job) error {
+			t.mu.Lock()
+			if t.ran == nil {
+				t.ran = map[string][]model.ClipStage{}
+			}
+			t.ran[job.Clip.AudioInputPath] = append(t.ran[job.Clip.AudioInputPath], name)
+			t.mu.Unlock()
+
+			if run == nil {
+				return nil
+			}
+			return run(ctx, job)
+		}},
+		Workers: 2,
+	}
+}
+
+func (t *trace) stages() []StageConfig {
+	configs := make([]StageConfig, len(model.ClipStages))
+	for i, stage := range model.ClipStages {
+		configs[i] = t.stage(stage, nil)
+	}
+	return configs
+}
+
+func newClip(audio string) *model.ClipDTO {
+	return model.NewClipDTO(audio, "background.mp4", nil, nil, nil, nil, nil)
+}
+
+func testPipeline(store Store, stages []StageConfig) (*Pipeline, *[]string) {
+	var (
+		mu   sync.Mutex
+		done []string
+	)
+	p := New(func(p *Pipeline) {
+		p.Stages = stages
+		p.Store = store
+		p.Printer = helper.NewProgressPrinter(io.Discard)
+	})
+	p.Hooks.OnDone = func(job *Job) error {
+		mu.Lock()
+		defer mu.Unlock()
+		done = append(done, job.Clip.AudioInputPath)
+		return nil
+	}
+	return p, &done
+}
+
+func run(p *Pipeline, ctx context.Context, clips ...*model.ClipDTO) error {
+	jobs := make([]*Job, len(clips))
+	for i, clip := range clips {
+		jobs[i] = &Job{Prefix: clip.AudioInputPath, Clip: clip}
+	}
+	return p.Run(ctx, Jobs(jobs...))
+}
+
+func TestRunStageOrder(t *testing.T) {
+	var tr trace
+	store := newRecordingStore()
+	p, done := testPipeline(store, tr.stages())
+
+	clips := []*model.ClipDTO{newClip("a.mp3"), newClip("b.mp3"), newClip("c.mp3")}
+	if err := run(p, context.Background(), clips...); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, clip := range clips {
+		if got := tr.ran[clip.AudioInputPath]; !reflect.DeepEqual(got, model.ClipStages) {
+			t.Errorf("%s ran %v, want %v", clip.AudioInputPath, got, model.ClipStages)
+		}
+		if clip.Status != model.ClipStatusCompleted {
+			t.Errorf("%s is %s, want completed", clip.AudioInputPath, clip.Status)
+		}
+	}
+
+	wantLog := []string{
+		"start: captions running", "complete: burn pending",
+		"start: burn running", "complete: trim pending",
+		"start: trim running", "complete: bio pending",
+		"start: bio running", "complete: bio completed",
+	}
+	if got := store.log["a.mp3"]; !reflect.DeepEqual(got, wantLog) {
+		t.Errorf("store saw %q, want %q", got, wantLog)
+	}
+
+	slices.Sort(*done)
+	if want := []string{"a.mp3", "b.mp3", "c.mp3"}; !reflect.DeepEqual(*done, want) {
+		t.Errorf("done = %v, want %v", *done, want)
+	}
+}
+
+func TestRunSkip(t *testing.T) {
+	var tr trace
+	stages := tr.stages()
+	stages[1].Skip = true
+	p, done := testPipeline(newRecordingStore(), stages)
+
+	clip := newClip("a.mp3")
+	if err := run(p, context.Background(), clip); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := tr.ran["a.mp3"], []model.ClipStage{model.ClipStageCaptions}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ran %v, want %v", got, want)
+	}
+	// Left where it stopped so a later run without the skip picks it up
+	if clip.Stage != model.ClipStageBurn || clip.Status != model.ClipStatusPending {
+		t.Errorf("clip is %s %s, want burn pending", clip.Stage, clip.Status)
+	}
+	if len(*done) != 0 {
+		t.Errorf("done = %v, want no clips done", *done)
+	}
+}
+
+func TestRunFailureStopsLaterStages(t *testing.T) {
+	var tr trace
+	stages := tr.stages()
+	stages[1] = tr.stage(model.ClipStageBurn, func(_ context.Context, job *Job) error {
+		if job.Clip.AudioInputPath == "bad.mp3" {
+			return errors.New("ffmpeg exited 1")
+		}
+		return nil
+	})
+	store := newRecordingStore()
+	p, done := testPipeline(store, stages)
+
+	bad, good := newClip("bad.mp3"), newClip("good.mp3")
+	err := run(p, context.Background(), bad, good)
+	if err == nil || err.Error() != "1 clip(s) failed" {
+		t.Errorf("Run error = %v, want 1 clip(s) failed", err)
+	}
+
+	if got, want := tr.ran["bad.mp3"], model.ClipStages[:2]; !reflect.DeepEqual(got, want) {
+		t.Errorf("bad.mp3 ran %v, want %v", got, want)
+	}
+	if bad.Status != model.ClipStatusFailed || bad.Stage != model.ClipStageBurn || bad.LastError == nil || *bad.LastError != "ffmpeg exited 1" {
+		t.Errorf("bad.mp3 is %s %s with error %v, want failed on burn", bad.Stage, bad.Status, bad.LastError)
+	}
+	if got := store.log["bad.mp3"]; got[len(got)-1] != "fail: burn failed" {
+		t.Errorf("store last saw %q, want the failure", got[len(got)-1])
+	}
+
+	if good.Status != model.ClipStatusCompleted {
+		t.Errorf("good.mp3 is %s, want completed", good.Status)
+	}
+	if want := []string{"good.mp3"}; !reflect.DeepEqual(*done, want) {
+		t.Errorf("done = %v, want %v", *done, want)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	var tr trace
+	stages := tr.stages()
+	stages[0] = tr.stage(model.ClipStageCaptions, func(ctx context.Context, _ *Job) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	stages[0].Timeout = 10 * time.Millisecond
+	p, _ := testPipeline(newRecordingStore(), stages)
+
+	clip := newClip("a.mp3")
+	if err := run(p, context.Background(), clip); err == nil || err.Error() != "1 clip(s) failed" {
+		t.Errorf("Run error = %v, want 1 clip(s) failed", err)
+	}
+
+	if clip.Status != model.ClipStatusFailed || clip.LastError == nil || !strings.Contains(*clip.LastError, "timed out after 10ms") {
+		t.Errorf("clip is %s with error %v, want failed by the timeout", clip.Status, clip.LastError)
+	}
+	if got := tr.ran["a.mp3"]; len(got) != 1 {
+		t.Errorf("ran %v, want only captions", got)
+	}
+}
+
+func TestRunInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var tr trace
+	stages := tr.stages()
+	stages[1] = tr.stage(model.ClipStageBurn, func(ctx context.Context, _ *Job) error {
+		cancel()
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	store := newRecordingStore()
+	p, done := testPipeline(store, stages)
+
+	clip := newClip("a.mp3")
+	err := run(p, ctx, clip)
+	if err == nil || !strings.Contains(err.Error(), "interrupted") || !errors.Is(err, context.Canceled) {
+		t.Errorf("Run error = %v, want interrupted", err)
+	}
+
+	// Pending rather than failed, so the next run tries burn again
+	if clip.Stage != model.ClipStageBurn || clip.Status != model.ClipStatusPending {
+		t.Errorf("clip is %s %s, want burn pending", clip.Stage, clip.Status)
+	}
+	if got := store.log["a.mp3"]; got[len(got)-1] != "interrupt: burn pending" {
+		t.Errorf("store last saw %q, want the interruption", got[len(got)-1])
+	}
+	if got, want := tr.ran["a.mp3"], model.ClipStages[:2]; !reflect.DeepEqual(got, want) {
+		t.Errorf("ran %v, want %v", got, want)
+	}
+	if len(*done) != 0 {
+		t.Errorf("done = %v, want no clips done", *done)
+	}
+}
+
+func TestRunAfterStageError(t *testing.T) {
+	var tr trace
+	p, done := testPipeline(newRecordingStore(), tr.stages())
+	p.Hooks.AfterStage = func(_ context.Context, _ *Job, stage model.ClipStage) error {
+		if stage == model.ClipStageCaptions {
+			return errors.New("review failed")
+		}
+		return nil
+	}
+
+	clip := newClip("a.mp3")
+	if err := run(p, context.Background(), clip); err == nil || err.Error() != "1 clip(s) failed" {
+		t.Errorf("Run error = %v, want 1 clip(s) failed", err)
+	}
+	// The stage itself completed, so only the rest of the run is lost
+	if clip.Stage != model.ClipStageBurn || clip.Status != model.ClipStatusPending {
+		t.Errorf("clip is %s %s, want burn pending", clip.Stage, clip.Status)
+	}
+	if got := tr.ran["a.mp3"]; len(got) != 1 || len(*done) != 0 {
+		t.Errorf("ran %v and done %v, want only captions run", got, *done)
+	}
+}
+
+func TestRunAdmission(t *testing.T) {
+	dir := t.TempDir()
+	srt := filepath.Join(dir, "a.srt")
+	burned := filepath.Join(dir, "a-burn.mp4")
+	trimmed := filepath.Join(dir, "a-trim.mp4")
+	bio := filepath.Join(dir, "a.txt")
+	for _, path := range []string{srt, burned, trimmed, bio} {
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// resumed has made its captions and burned video and stopped before trim
+	resumed := func() *model.ClipDTO {
+		clip := newClip("resumed.mp3")
+		clip.StartStage(model.ClipStageCaptions)
+		clip.CompleteStage(model.ClipStageCaptions)
+		clip.StartStage(model.ClipStageBurn)
+		clip.CompleteStage(model.ClipStageBurn)
+		clip.SRTCaptionPath = &srt
+		clip.CaptionsVideoOutputPath = &burned
+		return clip
+	}
+	failed := func() *model.ClipDTO {
+		clip := newClip("failed.mp3")
+		clip.StartStage(model.ClipStageCaptions)
+		clip.FailStage(model.ClipStageCaptions, errors.New("no speech"))
+		return clip
+	}
+
+	tests := []struct {
+		name        string
+		clip        func() *model.ClipDTO
+		retryFailed bool
+		want        []model.ClipStage
+	}{
+		{name: "resumed at its stage", clip: resumed, want: []model.ClipStage{model.ClipStageTrim, model.ClipStageBio}},
+		{
+			name: "rewound to a missing output",
+			clip: func() *model.ClipDTO {
+				clip := resumed()
+				missing := filepath.Join(dir, "gone.mp4")
+				clip.CaptionsVideoOutputPath = &missing
+				return clip
+			},
+			want: model.ClipStages[1:],
+		},
+		{name: "failed skipped", clip: failed},
+		{name: "failed retried", clip: failed, retryFailed: true, want: model.ClipStages},
+		{
+			name: "completed skipped",
+			clip: func() *model.ClipDTO {
+				clip := newClip("done.mp3")
+				for _, stage := range model.ClipStages {
+					clip.StartStage(stage)
+					clip.CompleteStage(stage)
+				}
+				clip.SRTCaptionPath, clip.CaptionsVideoOutputPath = &srt, &burned
+				clip.TrimmedVideoOutputPath, clip.BioPath = &trimmed, &bio
+				return clip
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tr trace
+			p, _ := testPipeline(newRecordingStore(), tr.stages())
+			p.RetryFailed = test.retryFailed
+
+			clip := test.clip()
+			_ = run(p, context.Background(), clip)
+			if got := tr.ran[clip.AudioInputPath]; !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ran %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRunCreatesOutputDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "out", "clips")
+	p, _ := testPipeline(newRecordingStore(), nil)
+	p.OutputDir = dir
+
+	if err := run(p, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !helper.IsDirectory(dir) {
+		t.Errorf("%s wasn't created", dir)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	const workers = 3
+
+	in := make(chan *Job)
+	go func() {
+		for i := range 20 {
+			in <- &Job{Prefix: fmt.Sprint(i)}
+		}
+		close(in)
+	}()
+
+	var active, most atomic.Int32
+	out := fanOut(workers, in, func(job *Job) bool {
+		n := active.Add(1)
+		for {
+			m := most.Load()
+			if n <= m || most.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		active.Add(-1)
+		// Odd jobs are dropped
+		i, _ := strconv.Atoi(job.Prefix)
+		return i%2 == 0
+	})
+
+	var got []string
+	for job := range out {
+		got = append(got, job.Prefix)
+	}
+	slices.Sort(got)
+	want := []string{"0", "10", "12", "14", "16", "18", "2", "4", "6", "8"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("forwarded %v, want %v", got, want)
+	}
+	if n := most.Load(); n > workers {
+		t.Errorf("%d jobs ran at once, want at most %d", n, workers)
+	}
+}
+
+// TestFanOutBackPressure checks a stage takes no more jobs than its workers
+// and buffer hold while nothing downstream reads them.
+func TestFanOutBackPressure(t *testing.T) {
+	in := make(chan *Job, 10)
+	for range 10 {
+		in <- &Job{}
+	}
+	close(in)
+
+	var calls atomic.Int32
+	out := fanOut(1, in, func(*Job) bool {
+		calls.Add(1)
+		return true
+	})
+
+	time.Sleep(50 * time.Millisecond)
+	// One job in the buffer, and the worker blocked sending the next
+	if n := calls.Load(); n > 2 {
+		t.Errorf("took %d jobs with nothing reading, want at most 2", n)
+	}
+
+	n := 0
+	for range out {
+		n++
+	}
+	if n != 10 {
+		t.Errorf("forwarded %d jobs once read, want 10", n)
+	}
+}
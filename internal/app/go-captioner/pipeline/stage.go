@@ -0,0 +1,45 @@
+package pipeline
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// Job is a clip travelling through the pipeline.
+type Job struct {
+	// Prefix labels the job's progress lines, e.g. "[1/3 track.mp3]".
+	Prefix string
+	Clip   *model.ClipDTO
+	// Output receives the job's script output. The pipeline points it at a
+	// prefixed progress writer if left nil.
+	Output io.Writer
+}
+
+// Stage is a single step run on every clip, in pipeline order.
+type Stage interface {
+	Name() model.ClipStage
+	Run(ctx context.Context, job *Job) error
+}
+
+// StageConfig controls how a Stage is scheduled.
+type StageConfig struct {
+	Stage Stage
+	// Workers is the number of clips processed by the stage at once.
+	Workers int
+	// Timeout cancels the stage for a clip after this long, 0 disables it.
+	Timeout time.Duration
+	// Skip stops clips before the stage instead of running it.
+	Skip bool
+}
+
+// Hooks let commands plug into the pipeline without writing a Stage.
+type Hooks struct {
+	// AfterStage runs once stage has completed for a clip, e.g. to let the
+	// user review its output. An error drops the clip from the rest of the run.
+	AfterStage func(ctx context.Context, job *Job, stage model.ClipStage) error
+	// OnDone runs for every clip that made it through all stages.
+	OnDone func(job *Job) error
+}
@@ -0,0 +1,192 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+)
+
+// CaptionStage transcribes the clip's audio and renders its captions. With
+// auto window set, the window is first picked from the audio.
+type CaptionStage struct {
+	Scripts service.ScriptServiceImpl
+	Options model.CommonOptions
+}
+
+func (s CaptionStage) Name() model.ClipStage {
+	return model.ClipStageCaptions
+}
+
+func (s CaptionStage) Run(ctx context.Context, job *Job) error {
+	scripts := s.Scripts
+	scripts.Output = job.Output
+
+	startTime, endTime := s.Options.StartTime, s.Options.EndTime
+	if s.Options.AutoWindow {
+		length, err := helper.SecondsFromStartAndEnd(startTime, endTime)
+		if err != nil {
+			return err
+		}
+		if err := scripts.RunChooseWindowOnClip(ctx, job.Clip, length, s.Options.Verbose); err != nil {
+			return err
+		}
+		startTime, endTime = job.Clip.StartTime, job.Clip.EndTime
+	}
+
+	return scripts.RunGenerateSRTCaptionsOnClip(
+		ctx,
+		s.Options.OutputDir,
+		job.Clip,
+		s.Options.WhisperModel,
+		startTime,
+		endTime,
+		s.Options.Verbose,
+	)
+}
+
+// BurnStage burns the captions into the background video, muxed with the audio.
+// With beat sync set, clips without a segment plan get one first, so their
+// background is cut on the beat.
+type BurnStage struct {
+	Scripts service.ScriptServiceImpl
+	Options model.CommonOptions
+}
+
+func (s BurnStage) Name() model.ClipStage {
+	return model.ClipStageBurn
+}
+
+func (s BurnStage) Run(ctx context.Context, job *Job) error {
+	scripts := s.Scripts
+	scripts.Output = job.Output
+
+	startTime, endTime := job.Clip.Window(s.Options.StartTime, s.Options.EndTime)
+	if s.Options.BeatSync && job.Clip.SegmentPlan == nil {
+		videos := []string{job.Clip.VideoInputPath}
+		if helper.IsDirectory(s.Options.VideoPath) {
+			var err error
+			if videos, err = helper.GetFilesInDirectory(s.Options.VideoPath); err != nil {
+				return err
+			}
+		}
+
+		if err := scripts.RunPlanSegmentsOnClip(
+			ctx,
+			job.Clip,
+			videos,
+			startTime,
+			endTime,
+			s.Options.BeatsPerCut,
+			s.Options.Verbose,
+		); err != nil {
+			return err
+		}
+	}
+
+	return scripts.RunBurnCaptionsOnClip(
+		ctx,
+		s.Options.OutputDir,
+		job.Clip,
+		&s.Options.Width,
+		&s.Options.Height,
+		startTime,
+		endTime,
+		s.Options.Verbose,
+	)
+}
+
+// TrimStage trims the burned video to the clip window and fades it out. With
+// normalise set, its audio is first measured so the trim can master it.
+type TrimStage struct {
+	Scripts service.ScriptServiceImpl
+	Options model.CommonOptions
+}
+
+func (s TrimStage) Name() model.ClipStage {
+	return model.ClipStageTrim
+}
+
+func (s TrimStage) Run(ctx context.Context, job *Job) error {
+	scripts := s.Scripts
+	scripts.Output = job.Output
+
+	duration, err := helper.DurationFromStartAndEnd(job.Clip.Window(s.Options.StartTime, s.Options.EndTime))
+	if err != nil {
+		return err
+	}
+
+	mode, err := model.ParseAudioCensorMode(s.Options.AudioCensor)
+	if err != nil {
+		return err
+	}
+
+	var loudness *model.LoudnessTarget
+	if s.Options.Loudness.Normalise {
+		loudness = &s.Options.Loudness.Target
+	}
+
+	return scripts.RunTrimAndFadeOnClip(
+		ctx,
+		s.Options.OutputDir,
+		job.Clip,
+		duration,
+		&s.Options.FadeDuration,
+		s.Options.FadeInDuration,
+		model.AudioCensor{Mode: mode, Padding: s.Options.AudioCensorPadding},
+		loudness,
+		s.Options.Verbose,
+	)
+}
+
+// BioStage writes the clip's TikTok caption from its artist's bio template.
+type BioStage struct {
+	Scripts service.ScriptServiceImpl
+	Options model.CommonOptions
+}
+
+func (s BioStage) Name() model.ClipStage {
+	return model.ClipStageBio
+}
+
+func (s BioStage) Run(ctx context.Context, job *Job) error {
+	scripts := s.Scripts
+	scripts.Output = job.Output
+
+	startTime, endTime := job.Clip.Window(s.Options.StartTime, s.Options.EndTime)
+	return scripts.RunWriteBioOnClip(
+		ctx,
+		job.Clip,
+		s.Options.BiosDir,
+		s.Options.BioMaxLength,
+		startTime,
+		endTime,
+	)
+}
+
+// DefaultStages returns the caption, burn, trim and bio stages, each run by a
+// single worker and timed out as set in opts.
+func DefaultStages(scripts service.ScriptServiceImpl, opts model.CommonOptions) []StageConfig {
+	return []StageConfig{
+		{
+			Stage:   CaptionStage{Scripts: scripts, Options: opts},
+			Workers: 1,
+			Timeout: opts.TranscribeTimeout,
+		},
+		{
+			Stage:   BurnStage{Scripts: scripts, Options: opts},
+			Workers: 1,
+			Timeout: opts.BurnTimeout,
+		},
+		{
+			Stage:   TrimStage{Scripts: scripts, Options: opts},
+			Workers: 1,
+			Timeout: opts.TrimTimeout,
+		},
+		{
+			Stage:   BioStage{Scripts: scripts, Options: opts},
+			Workers: 1,
+		},
+	}
+}
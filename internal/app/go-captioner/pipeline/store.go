@@ -0,0 +1,55 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/service"
+)
+
+// Store persists the stage transitions of clips. The failure methods return
+// err, joined with any error from persisting it.
+type Store interface {
+	StartStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage) error
+	CompleteStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage) error
+	FailStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage, err error) error
+	InterruptStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage, err error) error
+	// Update persists changes made to the clip between stages, e.g. by a
+	// review.
+	Update(ctx context.Context, clip *model.ClipDTO) error
+}
+
+// ClipServiceImpl persists clips with ent, so batch runs can resume.
+var _ Store = (*service.ClipServiceImpl)(nil)
+
+// MemoryStore tracks transitions on the clip alone, for runs that keep no
+// database such as the caption command.
+type MemoryStore struct{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (MemoryStore) StartStage(_ context.Context, clip *model.ClipDTO, stage model.ClipStage) error {
+	clip.StartStage(stage)
+	return nil
+}
+
+func (MemoryStore) CompleteStage(_ context.Context, clip *model.ClipDTO, stage model.ClipStage) error {
+	clip.CompleteStage(stage)
+	return nil
+}
+
+func (MemoryStore) FailStage(_ context.Context, clip *model.ClipDTO, stage model.ClipStage, err error) error {
+	clip.FailStage(stage, err)
+	return err
+}
+
+func (MemoryStore) Update(context.Context, *model.ClipDTO) error {
+	return nil
+}
+
+func (MemoryStore) InterruptStage(_ context.Context, clip *model.ClipDTO, stage model.ClipStage, err error) error {
+	clip.InterruptStage(stage, err)
+	return err
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sam-laister/tiktok-creator/ent"
+	"github.com/sam-laister/tiktok-creator/ent/audio"
+)
+
+type AudioRepository struct {
+	client *ent.Client
+}
+
+func NewAudioRepository(
+	client *ent.Client,
+) *AudioRepository {
+	return &AudioRepository{
+		client: client,
+	}
+}
+
+// Save records a, updating the audio with the same hash if there is one so a
+// moved file keeps its clips.
+func (r *AudioRepository) Save(ctx context.Context, a *ent.Audio) (*ent.Audio, error) {
+	existing, err := r.GetByHash(ctx, a.Hash)
+	switch {
+	case ent.IsNotFound(err):
+		return r.client.Audio.
+			Create().
+			SetHash(a.Hash).
+			SetPath(a.Path).
+			SetDuration(a.Duration).
+			SetCodec(a.Codec).
+			SetSampleRate(a.SampleRate).
+			SetChannels(a.Channels).
+			SetNillableLoudness(a.Loudness).
+			SetTags(a.Tags).
+			Save(ctx)
+	case err != nil:
+		return nil, err
+	}
+
+	update := existing.Update().
+		SetPath(a.Path).
+		SetDuration(a.Duration).
+		SetCodec(a.Codec).
+		SetSampleRate(a.SampleRate).
+		SetChannels(a.Channels).
+		SetTags(a.Tags).
+		SetScannedAt(time.Now())
+
+	// A scan that skipped measuring keeps the loudness measured before
+	if a.Loudness != nil {
+		update.SetLoudness(*a.Loudness)
+	}
+	return update.Save(ctx)
+}
+
+func (r *AudioRepository) GetByHash(ctx context.Context, hash string) (*ent.Audio, error) {
+	return r.client.Audio.
+		Query().
+		Where(audio.Hash(hash)).
+		Only(ctx)
+}
+
+func (r *AudioRepository) GetAll(ctx context.Context) ([]*ent.Audio, error) {
+	return r.client.Audio.
+		Query().
+		Order(ent.Asc(audio.FieldPath)).
+		All(ctx)
+}
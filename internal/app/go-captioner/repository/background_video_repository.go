@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sam-laister/tiktok-creator/ent"
+	"github.com/sam-laister/tiktok-creator/ent/backgroundvideo"
+)
+
+type BackgroundVideoRepository struct {
+	client *ent.Client
+}
+
+func NewBackgroundVideoRepository(
+	client *ent.Client,
+) *BackgroundVideoRepository {
+	return &BackgroundVideoRepository{
+		client: client,
+	}
+}
+
+func (r *BackgroundVideoRepository) GetOrCreateByPath(ctx context.Context, path string) (*ent.BackgroundVideo, error) {
+	v, err := r.GetByPath(ctx, path)
+	switch {
+	case err == nil:
+		return v, nil
+	case !ent.IsNotFound(err):
+		return nil, err
+	}
+
+	return r.client.BackgroundVideo.
+		Create().
+		SetPath(path).
+		Save(ctx)
+}
+
+// SaveMedia records what a scan found about the video at v.Path.
+func (r *BackgroundVideoRepository) SaveMedia(ctx context.Context, v *ent.BackgroundVideo) (*ent.BackgroundVideo, error) {
+	existing, err := r.GetOrCreateByPath(ctx, v.Path)
+	if err != nil {
+		return nil, err
+	}
+	return existing.Update().
+		SetNillableHash(v.Hash).
+		SetNillableDuration(v.Duration).
+		SetNillableCodec(v.Codec).
+		SetNillableWidth(v.Width).
+		SetNillableHeight(v.Height).
+		SetNillableFps(v.Fps).
+		SetTags(v.Tags).
+		SetScannedAt(time.Now()).
+		Save(ctx)
+}
+
+func (r *BackgroundVideoRepository) GetByPath(ctx context.Context, path string) (*ent.BackgroundVideo, error) {
+	return r.client.BackgroundVideo.
+		Query().
+		Where(backgroundvideo.Path(path)).
+		Only(ctx)
+}
+
+func (r *BackgroundVideoRepository) GetAll(ctx context.Context) ([]*ent.BackgroundVideo, error) {
+	return r.client.BackgroundVideo.
+		Query().
+		Order(ent.Asc(backgroundvideo.FieldPath)).
+		All(ctx)
+}
+
+// RecordUse counts another clip cut from the video.
+func (r *BackgroundVideoRepository) RecordUse(ctx context.Context, id int) (*ent.BackgroundVideo, error) {
+	return r.client.BackgroundVideo.
+		UpdateOneID(id).
+		AddUseCount(1).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+}
+
+func (r *BackgroundVideoRepository) SetWeight(ctx context.Context, path string, weight float64) (*ent.BackgroundVideo, error) {
+	v, err := r.GetOrCreateByPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return v.Update().
+		SetWeight(weight).
+		Save(ctx)
+}
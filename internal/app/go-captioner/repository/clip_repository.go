@@ -6,6 +6,7 @@ import (
 
 	"github.com/sam-laister/tiktok-creator/ent"
 	"github.com/sam-laister/tiktok-creator/ent/clip"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 )
 
 type ClipRepository struct {
@@ -45,20 +46,157 @@ func (r *ClipRepository) GetClipByID(ctx context.Context, id int) (*ent.Clip, er
 }
 
 func (r *ClipRepository) Update(ctx context.Context, clip *ent.Clip) (*ent.Clip, error) {
-	c, err := r.client.Clip.
+	update := r.client.Clip.
 		UpdateOne(clip).
-		SetNillableGenCaptionsPath(clip.GenCaptionsPath).
 		SetVideoPath(clip.VideoPath).
 		SetAudioPath(clip.AudioPath).
-		SetNillableGenRawVideoPath(clip.GenRawVideoPath).
-		SetNillableGenTrimmedVideoPath(clip.GenTrimmedVideoPath).
-		Save(ctx)
+		SetStartTime(clip.StartTime).
+		SetEndTime(clip.EndTime).
+		SetArtist(clip.Artist).
+		SetTitle(clip.Title).
+		SetAlbum(clip.Album).
+		SetStatus(clip.Status).
+		SetStage(clip.Stage).
+		SetAttempts(clip.Attempts).
+		SetStageTimestamps(clip.StageTimestamps).
+		SetUpdatedAt(time.Now())
+
+	// Optional fields are cleared when nil so the row always matches the clip
+	// rather than keeping values the clip has dropped.
+	if clip.GenCaptionsPath == nil {
+		update.ClearGenCaptionsPath()
+	} else {
+		update.SetGenCaptionsPath(*clip.GenCaptionsPath)
+	}
+
+	if clip.GenRawVideoPath == nil {
+		update.ClearGenRawVideoPath()
+	} else {
+		update.SetGenRawVideoPath(*clip.GenRawVideoPath)
+	}
+
+	if clip.GenTrimmedVideoPath == nil {
+		update.ClearGenTrimmedVideoPath()
+	} else {
+		update.SetGenTrimmedVideoPath(*clip.GenTrimmedVideoPath)
+	}
+
+	if clip.TranscriptPath == nil {
+		update.ClearTranscriptPath()
+	} else {
+		update.SetTranscriptPath(*clip.TranscriptPath)
+	}
+	if clip.CaptionsReviewedAt == nil {
+		update.ClearCaptionsReviewedAt()
+	} else {
+		update.SetCaptionsReviewedAt(*clip.CaptionsReviewedAt)
+	}
+
+	if clip.CaptionStyle == nil {
+		update.ClearCaptionStyle()
+	} else {
+		update.SetCaptionStyle(clip.CaptionStyle)
+	}
+	if clip.SubtitlesPath == nil {
+		update.ClearSubtitlesPath()
+	} else {
+		update.SetSubtitlesPath(*clip.SubtitlesPath)
+	}
+	if clip.LyricsPath == nil {
+		update.ClearLyricsPath()
+	} else {
+		update.SetLyricsPath(*clip.LyricsPath)
+	}
+	if clip.LyricsAlignment == nil {
+		update.ClearLyricsAlignment()
+	} else {
+		update.SetLyricsAlignment(clip.LyricsAlignment)
+	}
+	if clip.CensorLog == nil {
+		update.ClearCensorLog()
+	} else {
+		update.SetCensorLog(clip.CensorLog)
+	}
+	if clip.AudioCensor == nil {
+		update.ClearAudioCensor()
+	} else {
+		update.SetAudioCensor(clip.AudioCensor)
+	}
+	if clip.FadeInDuration == nil {
+		update.ClearFadeInDuration()
+	} else {
+		update.SetFadeInDuration(*clip.FadeInDuration)
+	}
+	if clip.Loudness == nil {
+		update.ClearLoudness()
+	} else {
+		update.SetLoudness(clip.Loudness)
+	}
+	if clip.SegmentPlan == nil {
+		update.ClearSegmentPlan()
+	} else {
+		update.SetSegmentPlan(clip.SegmentPlan)
+	}
+	update.
+		SetNillableSeed(clip.Seed).
+		SetNillableBackgroundStart(clip.BackgroundStart).
+		SetNillableWidth(clip.Width).
+		SetNillableHeight(clip.Height).
+		SetNillableFadeDuration(clip.FadeDuration)
+
+	if clip.Bio == nil {
+		update.ClearBio()
+	} else {
+		update.SetBio(*clip.Bio)
+	}
+	if clip.BioPath == nil {
+		update.ClearBioPath()
+	} else {
+		update.SetBioPath(*clip.BioPath)
+	}
+	if clip.Hashtags == nil {
+		update.ClearHashtags()
+	} else {
+		update.SetHashtags(clip.Hashtags)
+	}
+
+	if clip.AudioID == nil {
+		update.ClearAudioID()
+	} else {
+		update.SetAudioID(*clip.AudioID)
+	}
+	if clip.BackgroundVideoID == nil {
+		update.ClearBackgroundVideoID()
+	} else {
+		update.SetBackgroundVideoID(*clip.BackgroundVideoID)
+	}
+
+	if clip.LastError == nil {
+		update.ClearLastError()
+	} else {
+		update.SetLastError(*clip.LastError)
+	}
+
+	c, err := update.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
+func (r *ClipRepository) GetClipsByStatus(ctx context.Context, statuses ...model.ClipStatus) ([]*ent.Clip, error) {
+	query := r.client.Clip.
+		Query().
+		Where(clip.DeletedAtIsNil()).
+		Order(ent.Asc(clip.FieldID))
+
+	if len(statuses) > 0 {
+		query.Where(clip.StatusIn(statuses...))
+	}
+
+	return query.All(ctx)
+}
+
 func (r *ClipRepository) Delete(ctx context.Context, id int) error {
 	_, err := r.client.Clip.
 		UpdateOneID(id).
@@ -70,6 +208,13 @@ func (r *ClipRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (r *ClipRepository) GetClipByHash(ctx context.Context, hash string) (*ent.Clip, error) {
+	return r.client.Clip.
+		Query().
+		Where(clip.Hash(hash)).
+		Only(ctx)
+}
+
 func (r *ClipRepository) GetOrCreateWithHash(
 	ctx context.Context,
 	hash, audioPath, videoPath string,
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sam-laister/tiktok-creator/ent"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+)
+
+type AssetServiceImpl struct {
+	audioRepo      *repository.AudioRepository
+	backgroundRepo *repository.BackgroundVideoRepository
+	clipService    *ClipServiceImpl
+}
+
+func NewAssetServiceImpl(
+	audioRepo *repository.AudioRepository,
+	backgroundRepo *repository.BackgroundVideoRepository,
+	clipService *ClipServiceImpl,
+) *AssetServiceImpl {
+	return &AssetServiceImpl{
+		audioRepo:      audioRepo,
+		backgroundRepo: backgroundRepo,
+		clipService:    clipService,
+	}
+}
+
+// SaveAudio catalogues the audio file at path as info describes it, along
+// with its loudness if it was measured.
+func (r *AssetServiceImpl) SaveAudio(
+	ctx context.Context,
+	path string,
+	info *model.MediaInfo,
+	loudness *float64,
+) (*model.AudioDTO, error) {
+	hash, err := helper.GetFilehash(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var a *ent.Audio
+	err = r.clipService.Locked(func() (err error) {
+		a, err = r.audioRepo.Save(ctx, &ent.Audio{
+			Hash:       hash,
+			Path:       path,
+			Duration:   info.Duration,
+			Codec:      info.AudioCodec,
+			SampleRate: info.SampleRate,
+			Channels:   info.Channels,
+			Loudness:   loudness,
+			Tags:       info.Tags,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.AudioToDTO(a), nil
+}
+
+// SaveBackgroundVideo catalogues the video at path as info describes it.
+func (r *AssetServiceImpl) SaveBackgroundVideo(
+	ctx context.Context,
+	path string,
+	info *model.MediaInfo,
+) (*model.BackgroundVideoDTO, error) {
+	hash, err := helper.GetFilehash(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *ent.BackgroundVideo
+	err = r.clipService.Locked(func() (err error) {
+		v, err = r.backgroundRepo.SaveMedia(ctx, &ent.BackgroundVideo{
+			Path:     path,
+			Hash:     &hash,
+			Duration: &info.Duration,
+			Codec:    &info.VideoCodec,
+			Width:    &info.Width,
+			Height:   &info.Height,
+			Fps:      &info.FPS,
+			Tags:     info.Tags,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.BackgroundVideoToDTO(v), nil
+}
+
+func (r *AssetServiceImpl) GetAudios(ctx context.Context) ([]*model.AudioDTO, error) {
+	audios, err := r.audioRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.AudioDTO, 0, len(audios))
+	for _, a := range audios {
+		dtos = append(dtos, helper.AudioToDTO(a))
+	}
+	return dtos, nil
+}
+
+func (r *AssetServiceImpl) GetBackgroundVideos(ctx context.Context) ([]*model.BackgroundVideoDTO, error) {
+	videos, err := r.backgroundRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.BackgroundVideoDTO, 0, len(videos))
+	for _, v := range videos {
+		dtos = append(dtos, helper.BackgroundVideoToDTO(v))
+	}
+	return dtos, nil
+}
+
+// Link points the clip at its catalogued audio and background video, saving
+// it if they changed, and returns them. Either is nil if it hasn't been
+// catalogued.
+func (r *AssetServiceImpl) Link(
+	ctx context.Context,
+	clip *model.ClipDTO,
+) (*model.AudioDTO, *model.BackgroundVideoDTO, error) {
+	audio, video, err := r.getClipAssets(ctx, clip)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var audioID, videoID *int
+	if audio != nil {
+		audioID = &audio.ID
+	}
+	if video != nil {
+		videoID = &video.ID
+	}
+
+	if !sameID(clip.AudioID, audioID) || !sameID(clip.BackgroundVideoID, videoID) {
+		clip.AudioID = audioID
+		clip.BackgroundVideoID = videoID
+		if clip.ID != nil {
+			if err := r.clipService.Update(ctx, clip); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return audio, video, nil
+}
+
+func (r *AssetServiceImpl) getClipAssets(
+	ctx context.Context,
+	clip *model.ClipDTO,
+) (audio *model.AudioDTO, video *model.BackgroundVideoDTO, err error) {
+	err = r.clipService.Locked(func() error {
+		if clip.Hash != nil {
+			a, err := r.audioRepo.GetByHash(ctx, *clip.Hash)
+			switch {
+			case err == nil:
+				audio = helper.AudioToDTO(a)
+			case !ent.IsNotFound(err):
+				return err
+			}
+		}
+
+		v, err := r.backgroundRepo.GetByPath(ctx, clip.VideoInputPath)
+		switch {
+		case err == nil:
+			video = helper.BackgroundVideoToDTO(v)
+		case !ent.IsNotFound(err):
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return audio, video, nil
+}
+
+func sameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
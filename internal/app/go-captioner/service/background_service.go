@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// BackgroundClaimer hands out stretches of background video so that clips by
+// the same artist never share one.
+type BackgroundClaimer interface {
+	Claim(ctx context.Context, clip *model.ClipDTO, choose func(used []model.VideoRange) ([]model.VideoRange, error)) error
+}
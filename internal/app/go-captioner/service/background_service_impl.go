@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+
+	"github.com/sam-laister/tiktok-creator/ent"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
+)
+
+type BackgroundServiceImpl struct {
+	backgroundRepo *repository.BackgroundVideoRepository
+	clipService    *ClipServiceImpl
+
+	// claims are the stretches handed out this run by clip hash, since a clip
+	// only saves them once its burn has finished.
+	claims map[string][]model.VideoRange
+	mu     sync.Mutex
+}
+
+func NewBackgroundServiceImpl(
+	backgroundRepo *repository.BackgroundVideoRepository,
+	clipService *ClipServiceImpl,
+) *BackgroundServiceImpl {
+	return &BackgroundServiceImpl{
+		backgroundRepo: backgroundRepo,
+		clipService:    clipService,
+		claims:         map[string][]model.VideoRange{},
+	}
+}
+
+// Sync makes sure every one of paths is tracked and returns them.
+func (r *BackgroundServiceImpl) Sync(ctx context.Context, paths []string) ([]*model.BackgroundVideoDTO, error) {
+	// Writes share the clip service's lock, SQLite only tolerating one writer
+	videos := make([]*model.BackgroundVideoDTO, 0, len(paths))
+	err := r.clipService.Locked(func() error {
+		for _, path := range paths {
+			v, err := r.backgroundRepo.GetOrCreateByPath(ctx, path)
+			if err != nil {
+				return err
+			}
+			videos = append(videos, helper.BackgroundVideoToDTO(v))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+func (r *BackgroundServiceImpl) GetAll(ctx context.Context) ([]*model.BackgroundVideoDTO, error) {
+	videos, err := r.backgroundRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.BackgroundVideoDTO, 0, len(videos))
+	for _, v := range videos {
+		dtos = append(dtos, helper.BackgroundVideoToDTO(v))
+	}
+	return dtos, nil
+}
+
+func (r *BackgroundServiceImpl) SetWeight(ctx context.Context, path string, weight float64) (*model.BackgroundVideoDTO, error) {
+	var v *ent.BackgroundVideo
+	err := r.clipService.Locked(func() (err error) {
+		v, err = r.backgroundRepo.SetWeight(ctx, path, weight)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.BackgroundVideoToDTO(v), nil
+}
+
+// Pick chooses the background for a new clip by artist from videos, leaving
+// out any the rules exclude. Artist rules don't apply to clips whose artist is
+// unknown.
+func (r *BackgroundServiceImpl) Pick(
+	ctx context.Context,
+	videos []*model.BackgroundVideoDTO,
+	artist string,
+	strategy model.BackgroundStrategy,
+	rules []model.BackgroundRule,
+	rng *rand.Rand,
+) (*model.BackgroundVideoDTO, error) {
+	candidates := slices.Clone(videos)
+
+	if slices.Contains(rules, model.BackgroundRuleBackToBack) && len(candidates) > 1 {
+		var last *model.BackgroundVideoDTO
+		for _, video := range candidates {
+			if video.LastUsedAt != nil && (last == nil || video.LastUsedAt.After(*last.LastUsedAt)) {
+				last = video
+			}
+		}
+		candidates = slices.DeleteFunc(candidates, func(video *model.BackgroundVideoDTO) bool {
+			return video == last
+		})
+	}
+
+	if artist != "" && slices.Contains(rules, model.BackgroundRuleArtistVideo) {
+		clips, err := r.artistClips(ctx, artist)
+		if err != nil {
+			return nil, err
+		}
+
+		used := map[string]bool{}
+		for _, clip := range clips {
+			used[clip.VideoInputPath] = true
+			for _, usedRange := range clip.BackgroundRanges() {
+				used[usedRange.Video] = true
+			}
+		}
+		candidates = slices.DeleteFunc(candidates, func(video *model.BackgroundVideoDTO) bool {
+			return used[video.Path]
+		})
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("every background video has already been used for %s", artist)
+		}
+	}
+
+	return helper.PickBackground(candidates, strategy, rng)
+}
+
+// RecordUse counts a clip cut from video, updating it in place.
+func (r *BackgroundServiceImpl) RecordUse(ctx context.Context, video *model.BackgroundVideoDTO) error {
+	var v *ent.BackgroundVideo
+	err := r.clipService.Locked(func() (err error) {
+		v, err = r.backgroundRepo.RecordUse(ctx, video.ID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*video = *helper.BackgroundVideoToDTO(v)
+	return nil
+}
+
+// Claim calls choose with the stretches of background already used by the
+// clip's artist, by rendered clips and by those claimed this run, and claims
+// the stretches it returns for the clip. Clips whose artist is unknown can
+// use any stretch.
+func (r *BackgroundServiceImpl) Claim(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	choose func(used []model.VideoRange) ([]model.VideoRange, error),
+) error {
+	artist := helper.ClipTrackInfo(clip).Artist
+	if artist == "" || clip.Hash == nil {
+		_, err := choose(nil)
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clips, err := r.artistClips(ctx, artist)
+	if err != nil {
+		return err
+	}
+
+	var used []model.VideoRange
+	for _, other := range clips {
+		if *other.Hash == *clip.Hash {
+			continue
+		}
+		if claimed, ok := r.claims[*other.Hash]; ok {
+			used = append(used, claimed...)
+			continue
+		}
+		used = append(used, other.BackgroundRanges()...)
+	}
+
+	claimed, err := choose(used)
+	if err != nil {
+		return err
+	}
+	r.claims[*clip.Hash] = claimed
+	return nil
+}
+
+// artistClips returns every clip of artist's audio.
+func (r *BackgroundServiceImpl) artistClips(ctx context.Context, artist string) ([]*model.ClipDTO, error) {
+	clips, err := r.clipService.GetByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(clips, func(clip *model.ClipDTO) bool {
+		return helper.ClipTrackInfo(clip).Artist != artist || clip.Hash == nil
+	}), nil
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"sync"
 
+	"github.com/sam-laister/tiktok-creator/ent"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/repository"
@@ -10,6 +13,12 @@ import (
 
 type ClipServiceImpl struct {
 	clipRepo *repository.ClipRepository
+
+	// SQLite only tolerates a single writer, so writes from concurrent batch
+	// workers are serialised here rather than surfacing as "database is locked".
+	// Reads take it too, as under the shared cache a read while another worker
+	// writes fails with "table is locked".
+	mu sync.Mutex
 }
 
 func NewClipServiceImpl(
@@ -21,11 +30,17 @@ func NewClipServiceImpl(
 }
 
 func (r *ClipServiceImpl) Create(ctx context.Context, clip *model.ClipDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	_, err := r.clipRepo.Create(ctx, helper.DTOToClip(clip))
 	return err
 }
 
 func (r *ClipServiceImpl) Update(ctx context.Context, clip *model.ClipDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	_, err := r.clipRepo.Update(ctx, helper.DTOToClip(clip))
 	return err
 }
@@ -36,9 +51,99 @@ func (r *ClipServiceImpl) GetOrCreateWithHash(
 	audioPath,
 	videoPath string,
 ) (*model.ClipDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	clipEntity, err := r.clipRepo.GetOrCreateWithHash(ctx, hash, audioPath, videoPath)
 	if err != nil {
 		return nil, err
 	}
 	return helper.ClipToDTO(clipEntity), nil
 }
+
+func (r *ClipServiceImpl) GetByID(ctx context.Context, id int) (*model.ClipDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clipEntity, err := r.clipRepo.GetClipByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return helper.ClipToDTO(clipEntity), nil
+}
+
+// GetByHash returns the clip of the audio with hash, or nil if there is none.
+func (r *ClipServiceImpl) GetByHash(ctx context.Context, hash string) (*model.ClipDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clipEntity, err := r.clipRepo.GetClipByHash(ctx, hash)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return helper.ClipToDTO(clipEntity), nil
+}
+
+func (r *ClipServiceImpl) GetByStatus(ctx context.Context, statuses ...model.ClipStatus) ([]*model.ClipDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clips, err := r.clipRepo.GetClipsByStatus(ctx, statuses...)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.ClipDTO, 0, len(clips))
+	for _, c := range clips {
+		dtos = append(dtos, helper.ClipToDTO(c))
+	}
+	return dtos, nil
+}
+
+// Locked runs fn holding the lock clip reads and writes take, so services using
+// other tables of the same database don't collide with them.
+func (r *ClipServiceImpl) Locked(fn func() error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return fn()
+}
+
+// StartStage marks stage as running on the clip and persists it.
+func (r *ClipServiceImpl) StartStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage) error {
+	clip.StartStage(stage)
+	return r.Update(ctx, clip)
+}
+
+// CompleteStage advances the clip past stage and persists it.
+func (r *ClipServiceImpl) CompleteStage(ctx context.Context, clip *model.ClipDTO, stage model.ClipStage) error {
+	clip.CompleteStage(stage)
+	return r.Update(ctx, clip)
+}
+
+// FailStage records err against stage and persists it. The original error is
+// returned, joined with any error from saving the clip.
+func (r *ClipServiceImpl) FailStage(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	stage model.ClipStage,
+	err error,
+) error {
+	clip.FailStage(stage, err)
+	return errors.Join(err, r.Update(ctx, clip))
+}
+
+// InterruptStage returns the clip to pending after stage was cancelled. It is
+// persisted even though ctx itself is most likely already cancelled.
+func (r *ClipServiceImpl) InterruptStage(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	stage model.ClipStage,
+	err error,
+) error {
+	clip.InterruptStage(stage, err)
+	return errors.Join(err, r.Update(context.WithoutCancel(ctx), clip))
+}
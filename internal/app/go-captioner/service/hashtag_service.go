@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// HashtagPicker hands out hashtags so that bios rotate through their pool
+// rather than every clip getting the same ones.
+type HashtagPicker interface {
+	Pick(ctx context.Context, clip *model.ClipDTO, choose func(usage []model.HashtagUsage) ([]string, error)) error
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+type HashtagServiceImpl struct {
+	clipService *ClipServiceImpl
+
+	// picks are the hashtags handed out this run by clip hash, since a clip
+	// only saves them once its bio has been written.
+	picks map[string][]string
+	mu    sync.Mutex
+}
+
+func NewHashtagServiceImpl(clipService *ClipServiceImpl) *HashtagServiceImpl {
+	return &HashtagServiceImpl{
+		clipService: clipService,
+		picks:       map[string][]string{},
+	}
+}
+
+// Usage returns every hashtag bios have used and the clips that used them,
+// sorted by hashtag.
+func (r *HashtagServiceImpl) Usage(ctx context.Context) ([]model.HashtagUsage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.usage(ctx, "")
+}
+
+// Pick calls choose with how often each hashtag has been used, by saved clips
+// and by those picked for this run, and picks the hashtags it returns for the
+// clip. The clip's own earlier hashtags don't count against it.
+func (r *HashtagServiceImpl) Pick(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	choose func(usage []model.HashtagUsage) ([]string, error),
+) error {
+	if clip.Hash == nil {
+		_, err := choose(nil)
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	usage, err := r.usage(ctx, *clip.Hash)
+	if err != nil {
+		return err
+	}
+
+	picked, err := choose(usage)
+	if err != nil {
+		return err
+	}
+	r.picks[*clip.Hash] = picked
+	return nil
+}
+
+// usage counts the hashtags of every clip other than the one with skipHash.
+func (r *HashtagServiceImpl) usage(ctx context.Context, skipHash string) ([]model.HashtagUsage, error) {
+	clips, err := r.clipService.GetByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(clips, func(a, b *model.ClipDTO) int {
+		return clipID(a) - clipID(b)
+	})
+
+	byHashtag := map[string]*model.HashtagUsage{}
+	for _, clip := range clips {
+		if clip.ID == nil || (clip.Hash != nil && *clip.Hash == skipHash) {
+			continue
+		}
+
+		hashtags := clip.Hashtags
+		if clip.Hash != nil {
+			if picked, ok := r.picks[*clip.Hash]; ok {
+				hashtags = picked
+			}
+		}
+		for _, hashtag := range hashtags {
+			u, ok := byHashtag[hashtag]
+			if !ok {
+				u = &model.HashtagUsage{Hashtag: hashtag}
+				byHashtag[hashtag] = u
+			}
+			u.ClipIDs = append(u.ClipIDs, *clip.ID)
+		}
+	}
+
+	usage := make([]model.HashtagUsage, 0, len(byHashtag))
+	for _, u := range byHashtag {
+		usage = append(usage, *u)
+	}
+	slices.SortFunc(usage, func(a, b model.HashtagUsage) int {
+		return strings.Compare(a.Hashtag, b.Hashtag)
+	})
+	return usage, nil
+}
+
+func clipID(clip *model.ClipDTO) int {
+	if clip.ID == nil {
+		return 0
+	}
+	return *clip.ID
+}
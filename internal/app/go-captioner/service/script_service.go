@@ -1,8 +1,15 @@
 package service
 
+import (
+	"context"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
 type ScriptService interface {
-	Transcribe(inputFile, outputDir, model string, verbose bool, startTime, endTime string) (*string, error)
-	BurnCaption(captionFile, videoFile, audioFile, outputDir string, targetWidth, targetHeight *int,
-		startTime, endTime string, verbose bool) (*string, error)
-	TrimAndFade(inputFile, outputDir, startTime, duration string, fadeDuration *int, verbose bool) (*string, error)
+	Transcribe(ctx context.Context, inputFile, outputDir, model string, verbose bool, startTime, endTime string) (*string, error)
+	GenerateCaptions(transcriptFile, outputFile string) (*string, []model.CensorEvent, error)
+	BurnCaption(ctx context.Context, captionFile, videoFile, audioFile, segmentsFile, outputFile string, videoStart *float64,
+		targetWidth, targetHeight *int, startTime, endTime string, verbose bool) (*string, error)
+	TrimAndFade(ctx context.Context, inputFile, outputFile, duration string, fadeDuration *int, fadeInDuration *float64, censorMode model.AudioCensorMode, censorSpans []model.TimeSpan, loudness *model.ClipLoudness, verbose bool) (*string, error)
 }
@@ -2,28 +2,107 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/analysis"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/captions"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/censor"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/lyrics"
 	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/subtitles"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/transcriber"
 )
 
 const burnCaptionsPath = "./scripts/burn_captions.py"
 const trimAndFadePath = "./scripts/trim_and_fade.py"
-const generateCaptionsPath = "./scripts/generate_captions.py"
+const defaultCensorPath = "./scripts/censor.yaml"
+const transcriptCacheDir = "transcripts"
 
-type ScriptServiceImpl struct{}
+// Transcripts taken from subtitles are kept with the cached ones under this
+// in place of a transcriber and model.
+const subtitlesTranscriptKey = "subtitles"
 
-func NewScriptServiceImpl() *ScriptServiceImpl {
-	return &ScriptServiceImpl{}
+// Synced lyrics are cut to the window and this many seconds either side before
+// aligning, so a repeated chorus isn't placed on the wrong repeat.
+const lyricsWindowMargin = 5
+const autoWindowCandidates = 3
+const defaultHashtagMax = 5
+
+// Random backgrounds are redrawn this many times to avoid stretches already
+// used before giving up.
+const backgroundAttempts = 50
+
+var stageExtensions = map[model.ClipStage]string{
+	model.ClipStageCaptions: ".ass",
+	model.ClipStageBurn:     ".mp4",
+	model.ClipStageTrim:     ".mp4",
+}
+
+type ScriptServiceImpl struct {
+	// Output receives the command lines and, when verbose, the script output.
+	Output io.Writer
+	// Transcriber produces the word timestamps that captions are rendered from.
+	Transcriber transcriber.Transcriber
+	Captions    captions.Options
+	// CensorPath is the file of censor lists, of which CensorList masks the
+	// captions.
+	CensorPath string
+	CensorList string
+	// SubtitlesDir holds subtitle files that captions are taken from in place
+	// of a transcript.
+	SubtitlesDir string
+	// LyricsDir holds lyrics files whose words replace the transcribed ones,
+	// as long as at least LyricsMinConfidence of them align.
+	LyricsDir           string
+	LyricsMinConfidence float64
+	// Naming lays out stage outputs under the output directory, see
+	// model.OutputName for the fields available to it.
+	Naming *template.Template
+	// Overwrite lets stages replace outputs that already exist.
+	Overwrite bool
+	// Backgrounds, when set, keeps clips by the same artist off the same
+	// stretches of background video.
+	Backgrounds BackgroundClaimer
+	// HashtagPools are what bios draw their hashtags from, picked with
+	// HashtagStrategy and at most HashtagMax of them, 0 for no limit.
+	HashtagPools    model.HashtagPools
+	HashtagStrategy model.HashtagStrategy
+	HashtagMax      int
+	// Hashtags, when set, picks hashtags by how often earlier clips used them.
+	Hashtags HashtagPicker
+}
+
+func NewScriptServiceImpl(opts ...func(*ScriptServiceImpl)) *ScriptServiceImpl {
+	props := ScriptServiceImpl{
+		Output:          os.Stdout,
+		Transcriber:     transcriber.NewPythonTranscriber(),
+		Captions:        *captions.NewOptions(),
+		CensorPath:      defaultCensorPath,
+		CensorList:      censor.DefaultList,
+		Naming:          template.Must(helper.ParseNamingTemplate(model.DefaultNamingTemplate)),
+		HashtagStrategy: model.HashtagStrategyLeastUsed,
+		HashtagMax:      defaultHashtagMax,
+	}
+	for _, opt := range opts {
+		opt(&props)
+	}
+	return &props
 }
 
 func (w ScriptServiceImpl) RunGenerateSRTCaptionsOnClip(
+	ctx context.Context,
 	outputDir string,
 	clip *model.ClipDTO,
 	model string,
@@ -31,24 +110,429 @@ func (w ScriptServiceImpl) RunGenerateSRTCaptionsOnClip(
 	endTime string,
 	verbose bool,
 ) error {
-	srtPath, err := w.Transcribe(
-		clip.AudioInputPath,
-		outputDir,
-		model,
-		verbose,
-		startTime,
-		endTime,
-	)
+	// Reviewed words stand in for a new transcript of the same window, so
+	// captions made again keep the fixes
+	if clip.CaptionsReviewedAt != nil &&
+		clip.StartTime == startTime && clip.EndTime == endTime &&
+		clip.IsValidTranscriptPath() && helper.Exists(*clip.TranscriptPath) {
+		return w.RunRenderCaptionsOnClip(outputDir, clip)
+	}
+
+	transcriptPath, err := w.subtitlesTranscript(clip, outputDir, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	if transcriptPath == nil {
+		transcriptPath, err = w.Transcribe(
+			ctx,
+			clip.AudioInputPath,
+			outputDir,
+			model,
+			verbose,
+			startTime,
+			endTime,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		transcriptPath, err = w.alignLyrics(clip, *transcriptPath, startTime, endTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	clip.TranscriptPath = transcriptPath
+	clip.CaptionsReviewedAt = nil
+	clip.StartTime = startTime
+	clip.EndTime = endTime
+	return w.RunRenderCaptionsOnClip(outputDir, clip)
+}
+
+// RunReviewCaptionsOnClip opens the words of the clip's transcript in editor
+// and, once they are saved without problems, renders its captions again from
+// them and records the review. Saves with problems open the editor again with
+// the problems listed, and deleting every word leaves the captions as they
+// were. The edited words are kept next to the captions, leaving the cached
+// transcript as transcribed. It reports whether the captions changed.
+func (w ScriptServiceImpl) RunReviewCaptionsOnClip(
+	ctx context.Context,
+	outputDir string,
+	clip *model.ClipDTO,
+	editor string,
+) (bool, error) {
+	if !clip.IsValidTranscriptPath() || !clip.IsValidSRTCaptionPath() {
+		return false, errors.New("no captions to review")
+	}
+
+	transcript, err := helper.ReadTranscript(*clip.TranscriptPath)
+	if err != nil {
+		return false, fmt.Errorf("reading transcript %s: %w", *clip.TranscriptPath, err)
+	}
+	window, err := helper.SecondsFromStartAndEnd(clip.StartTime, clip.EndTime)
+	if err != nil {
+		return false, err
+	}
+
+	base := strings.TrimSuffix(*clip.SRTCaptionPath, filepath.Ext(*clip.SRTCaptionPath))
+	reviewFile := base + ".review.txt"
+	if err := helper.WriteReviewFile(reviewFile, helper.ClipTrackInfo(clip).Name(), window, transcript); err != nil {
+		return false, err
+	}
+	defer os.Remove(reviewFile)
+
+	var edited *model.Transcript
+	for {
+		if err := helper.OpenEditor(ctx, editor, reviewFile); err != nil {
+			return false, err
+		}
+
+		edited, err = helper.ReadReviewFile(reviewFile, window, transcript.Language)
+		if err == nil {
+			break
+		}
+		if edited == nil {
+			return false, err
+		}
+		if err := helper.MarkReviewProblems(reviewFile, err); err != nil {
+			return false, err
+		}
+	}
+
+	if len(edited.Words) == 0 {
+		_, _ = fmt.Fprintln(w.Output, "No words left, keeping the captions as they were")
+		return false, nil
+	}
+
+	now := time.Now()
+	if helper.SameWords(transcript, edited) {
+		clip.CaptionsReviewedAt = &now
+		return false, nil
+	}
+
+	reviewedPath := base + ".reviewed.json"
+	if err := helper.WriteTranscript(reviewedPath, edited); err != nil {
+		return false, err
+	}
+	clip.TranscriptPath = &reviewedPath
+	clip.CaptionsReviewedAt = &now
+
+	if err := w.RunRenderCaptionsOnClip(outputDir, clip); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// subtitlesTranscript writes the words of the clip's subtitles in
+// SubtitlesDir between startTime and endTime as a transcript, returning its
+// path, or nil if the clip has no subtitles or they have no words in the
+// window.
+func (w ScriptServiceImpl) subtitlesTranscript(clip *model.ClipDTO, outputDir, startTime, endTime string) (*string, error) {
+	clip.SubtitlesPath = nil
+	if w.SubtitlesDir == "" {
+		return nil, nil
+	}
+
+	hash := ""
+	if clip.Hash != nil {
+		hash = *clip.Hash
+	}
+	subtitlesPath := helper.FindSubtitles(w.SubtitlesDir, clip.AudioInputPath, hash)
+	if subtitlesPath == "" {
+		return nil, nil
+	}
+
+	start, err := strconv.Atoi(startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time %s: %w", startTime, err)
+	}
+	end, err := strconv.Atoi(endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time %s: %w", endTime, err)
+	}
+
+	subs, err := subtitles.Read(subtitlesPath)
+	if err != nil {
+		return nil, err
+	}
+	transcript := subs.Transcript(float64(start), float64(end))
+	if len(transcript.Words) == 0 {
+		_, _ = fmt.Fprintf(w.Output, "Not using subtitles %s, they have no words between %ds and %ds\n", subtitlesPath, start, end)
+		return nil, nil
+	}
+
+	if hash == "" {
+		if hash, err = helper.GetFilehash(clip.AudioInputPath); err != nil {
+			return nil, err
+		}
+	}
+	cacheDir := filepath.Join(outputDir, transcriptCacheDir)
+	if err := helper.CreateDirectoryIfNotExists(cacheDir); err != nil {
+		return nil, err
+	}
+
+	// Written every time, as the subtitles may have been edited since
+	transcriptPath := helper.TranscriptCachePath(cacheDir, hash, subtitlesTranscriptKey, startTime, endTime)
+	if err := helper.WriteTranscript(transcriptPath, transcript); err != nil {
+		return nil, err
+	}
+	_, _ = fmt.Fprintf(w.Output, "Captioning from subtitles %s instead of transcribing\n", subtitlesPath)
+
+	clip.SubtitlesPath = &subtitlesPath
+	clip.LyricsPath = nil
+	clip.LyricsAlignment = nil
+	return &transcriptPath, nil
+}
+
+// alignLyrics aligns the clip's lyrics in LyricsDir, if it has some, onto the
+// transcript at transcriptPath and returns the path of a transcript with the
+// lyrics' words, reporting how well each line matched. Lyrics that don't
+// align well enough, such as those of another track, are reported and the
+// transcript is kept as transcribed.
+func (w ScriptServiceImpl) alignLyrics(clip *model.ClipDTO, transcriptPath, startTime, endTime string) (*string, error) {
+	clip.LyricsPath = nil
+	clip.LyricsAlignment = nil
+	if w.LyricsDir == "" {
+		return &transcriptPath, nil
+	}
+
+	hash := ""
+	if clip.Hash != nil {
+		hash = *clip.Hash
+	}
+	lyricsPath := helper.FindLyrics(w.LyricsDir, clip.AudioInputPath, hash)
+	if lyricsPath == "" {
+		return &transcriptPath, nil
+	}
+
+	start, err := strconv.Atoi(startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time %s: %w", startTime, err)
+	}
+	end, err := strconv.Atoi(endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time %s: %w", endTime, err)
+	}
+
+	trackLyrics, err := lyrics.Read(lyricsPath)
+	if err != nil {
+		return nil, err
+	}
+	transcript, err := helper.ReadTranscript(transcriptPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading transcript %s: %w", transcriptPath, err)
+	}
+
+	aligned, alignment, err := lyrics.Align(trackLyrics.Window(float64(start), float64(end), lyricsWindowMargin), transcript)
+	if err != nil {
+		_, _ = fmt.Fprintf(w.Output, "Not using lyrics %s: %s\n", lyricsPath, err)
+		return &transcriptPath, nil
+	}
+
+	for _, line := range alignment.Lines {
+		_, _ = fmt.Fprintf(w.Output, "Lyrics %3.0f%% %6.2f-%-6.2f %s\n", line.Confidence*100, line.Start, line.End, line.Text)
+	}
+	confidence := alignment.Confidence()
+	if confidence < w.LyricsMinConfidence {
+		_, _ = fmt.Fprintf(
+			w.Output,
+			"Not using lyrics %s, only %.0f%% aligned, under the minimum of %.0f%%\n",
+			lyricsPath,
+			confidence*100,
+			w.LyricsMinConfidence*100,
+		)
+		return &transcriptPath, nil
+	}
+	_, _ = fmt.Fprintf(w.Output, "Aligned lyrics %s with %.0f%% confidence, dropping %d transcribed word(s)\n", lyricsPath, confidence*100, alignment.Dropped)
+
+	// The cached transcript is left as transcribed, so other lyrics can be
+	// aligned to it later
+	alignedPath := strings.TrimSuffix(transcriptPath, filepath.Ext(transcriptPath)) + ".lyrics.json"
+	if err := helper.WriteTranscript(alignedPath, aligned); err != nil {
+		return nil, err
+	}
+
+	clip.LyricsPath = &lyricsPath
+	clip.LyricsAlignment = alignment
+	return &alignedPath, nil
+}
+
+// RunChooseWindowOnClip analyses the clip's audio and records the best window
+// of length seconds on it. The runners up are printed for manual picking.
+func (w ScriptServiceImpl) RunChooseWindowOnClip(ctx context.Context, clip *model.ClipDTO, length int, verbose bool) error {
+	candidates, err := analysis.FindWindows(ctx, clip.AudioInputPath, length, autoWindowCandidates, w.Output, verbose)
+	if err != nil {
+		return err
+	}
+
+	best := candidates[0]
+	_, _ = fmt.Fprintf(w.Output, "Picked window %d-%d (score %.2f)\n", best.Start, best.End, best.Score)
+	for _, candidate := range candidates[1:] {
+		_, _ = fmt.Fprintf(w.Output, "Runner up window %d-%d (score %.2f)\n", candidate.Start, candidate.End, candidate.Score)
+	}
+
+	clip.StartTime = strconv.Itoa(best.Start)
+	clip.EndTime = strconv.Itoa(best.End)
+	return nil
+}
+
+// RunPlanSegmentsOnClip detects the beats in the clip's window and records a
+// plan cutting its background from videos every beatsPerCut beats.
+func (w ScriptServiceImpl) RunPlanSegmentsOnClip(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	videos []string,
+	startTime,
+	endTime string,
+	beatsPerCut int,
+	verbose bool,
+) error {
+	start, err := strconv.ParseFloat(startTime, 64)
+	if err != nil {
+		return fmt.Errorf("invalid start time %s: %w", startTime, err)
+	}
+	end, err := strconv.ParseFloat(endTime, 64)
+	if err != nil {
+		return fmt.Errorf("invalid end time %s: %w", endTime, err)
+	}
+
+	samples, err := analysis.DecodeWindow(ctx, clip.AudioInputPath, start, end, w.Output, verbose)
+	if err != nil {
+		return err
+	}
+	beats := analysis.DetectBeats(samples)
+
+	sources := make([]model.VideoSource, 0, len(videos))
+	for _, video := range videos {
+		duration, err := helper.ProbeDuration(ctx, video)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, model.VideoSource{Path: video, Duration: duration})
+	}
+
+	rng := clipRand(clip, helper.RandSegments)
+	var plan *model.SegmentPlan
+	err = w.claimBackground(ctx, clip, func(used []model.VideoRange) ([]model.VideoRange, error) {
+		for range backgroundAttempts {
+			plan, err = helper.PlanSegments(beats.Times, beats.BPM, end-start, beatsPerCut, sources, rng)
+			if err != nil {
+				return nil, err
+			}
+
+			planned := (&model.ClipDTO{SegmentPlan: plan}).BackgroundRanges()
+			if !overlapsAny(planned, used) {
+				return planned, nil
+			}
+		}
+		return nil, errors.New("no cuts of the background videos are left unused for this artist")
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w.Output, "Planned %d cuts at %.0f BPM\n", len(plan.Segments), plan.BPM)
+	clip.SegmentPlan = plan
+	return nil
+}
+
+// chooseBackgroundStart records a random point of the clip's background video
+// to cut the window from.
+func (w ScriptServiceImpl) chooseBackgroundStart(ctx context.Context, clip *model.ClipDTO, startTime, endTime string) error {
+	clipDuration, err := helper.SecondsFromStartAndEnd(startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	videoDuration, err := helper.ProbeDuration(ctx, clip.VideoInputPath)
+	if err != nil {
+		return err
+	}
+
+	rng := clipRand(clip, helper.RandBackgroundStart)
+	return w.claimBackground(ctx, clip, func(used []model.VideoRange) ([]model.VideoRange, error) {
+		for range backgroundAttempts {
+			start := helper.RandomStart(rng, videoDuration, float64(clipDuration))
+			chosen := []model.VideoRange{{
+				Video: clip.VideoInputPath,
+				Start: start,
+				End:   start + float64(clipDuration),
+			}}
+			if !overlapsAny(chosen, used) {
+				clip.BackgroundStart = &start
+				return chosen, nil
+			}
+		}
+		return nil, fmt.Errorf("no stretch of %s is left unused for this artist", clip.VideoInputPath)
+	})
+}
+
+// claimBackground runs choose with the stretches of background the clip must
+// avoid, none unless Backgrounds is set.
+func (w ScriptServiceImpl) claimBackground(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	choose func(used []model.VideoRange) ([]model.VideoRange, error),
+) error {
+	if w.Backgrounds == nil {
+		_, err := choose(nil)
+		return err
+	}
+	return w.Backgrounds.Claim(ctx, clip, choose)
+}
+
+func overlapsAny(ranges, others []model.VideoRange) bool {
+	for _, r := range ranges {
+		for _, other := range others {
+			if r.Overlaps(other) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// clipRand returns the source for one kind of the clip's random choices,
+// giving the clip a seed first if it has none.
+func clipRand(clip *model.ClipDTO, purpose string) *rand.Rand {
+	if clip.Seed == nil {
+		seed := helper.ClipSeed(0, "")
+		clip.Seed = &seed
+	}
+	return helper.SeededRand(*clip.Seed, purpose)
+}
 
+// RunRenderCaptionsOnClip renders the clip's transcript to a new ASS file using
+// the service's current caption options.
+func (w ScriptServiceImpl) RunRenderCaptionsOnClip(outputDir string, clip *model.ClipDTO) error {
+	if clip.TranscriptPath == nil {
+		return errors.New("no transcript path provided")
+	}
+
+	// The style is recorded first so the output is named after it
+	style := w.Captions.CaptionStyle
+	clip.CaptionStyle = &style
+
+	outputFile, err := w.OutputPath(outputDir, clip, model.ClipStageCaptions)
+	if err != nil {
+		return err
+	}
+
+	w.Captions.Title = helper.ClipTrackInfo(clip).Name()
+	srtPath, censored, err := w.GenerateCaptions(*clip.TranscriptPath, outputFile)
 	if err != nil {
 		return err
 	}
 
 	clip.SRTCaptionPath = srtPath
+	clip.CensorLog = censored
 	return nil
 }
 
 func (w ScriptServiceImpl) RunBurnCaptionsOnClip(
+	ctx context.Context,
 	outputDir string,
 	clip *model.ClipDTO,
 	targetWidth, targetHeight *int,
@@ -59,11 +543,46 @@ func (w ScriptServiceImpl) RunBurnCaptionsOnClip(
 		return errors.New("no captions path provided")
 	}
 
+	// Everything that frames the background is recorded before the output is
+	// named, so the name and any later rerender follow it
+	if clip.SegmentPlan == nil && clip.BackgroundStart == nil {
+		if err := w.chooseBackgroundStart(ctx, clip, startTime, endTime); err != nil {
+			return err
+		}
+	}
+	if targetWidth != nil {
+		width := *targetWidth
+		clip.Width = &width
+	}
+	if targetHeight != nil {
+		height := *targetHeight
+		clip.Height = &height
+	}
+
+	outputFile, err := w.OutputPath(outputDir, clip, model.ClipStageBurn)
+	if err != nil {
+		return err
+	}
+
+	// The plan goes to the script as a file next to the output, removed once
+	// the burn is done; the clip keeps the canonical copy
+	segmentsFile := ""
+	if clip.SegmentPlan != nil {
+		segmentsFile = strings.TrimSuffix(outputFile, filepath.Ext(outputFile)) + ".segments.json"
+		if err := helper.WriteSegmentPlan(segmentsFile, clip.SegmentPlan); err != nil {
+			return err
+		}
+		defer os.Remove(segmentsFile)
+	}
+
 	finalOutput, err := w.BurnCaption(
+		ctx,
 		*clip.SRTCaptionPath,
 		clip.VideoInputPath,
 		clip.AudioInputPath,
-		outputDir,
+		segmentsFile,
+		outputFile,
+		clip.BackgroundStart,
 		targetWidth,
 		targetHeight,
 		startTime,
@@ -80,22 +599,77 @@ func (w ScriptServiceImpl) RunBurnCaptionsOnClip(
 }
 
 func (w ScriptServiceImpl) RunTrimAndFadeOnClip(
+	ctx context.Context,
 	outputDir string,
 	clip *model.ClipDTO,
 	duration string,
 	fadeDuration *int,
+	fadeInDuration float64,
+	audioCensor model.AudioCensor,
+	loudness *model.LoudnessTarget,
 	verbose bool,
 ) error {
 	if fadeDuration == nil {
 		fadeDuration = new(int)
 		*fadeDuration = 5
 	}
+	fade := *fadeDuration
+	clip.FadeDuration = &fade
+
+	clip.FadeInDuration = nil
+	if fadeInDuration > 0 {
+		clip.FadeInDuration = &fadeInDuration
+	}
+
+	// The target names the output, what it measures is filled in below
+	clip.Loudness = nil
+	if loudness != nil {
+		clip.Loudness = &model.ClipLoudness{Target: *loudness}
+	}
+
+	clip.AudioCensor = nil
+	var censorSpans []model.TimeSpan
+	if audioCensor.Mode != "" && audioCensor.Mode != model.AudioCensorOff {
+		clip.AudioCensor = &audioCensor
+		seconds, err := strconv.ParseFloat(duration, 64)
+		if err != nil {
+			return err
+		}
+		// Censor events are timed from the start of the window, as the
+		// trimmed video is
+		censorSpans = helper.AudioCensorSpans(clip.CensorLog, audioCensor.Padding, seconds)
+	}
+
+	outputFile, err := w.OutputPath(outputDir, clip, model.ClipStageTrim)
+	if err != nil {
+		return err
+	}
+
+	if clip.Loudness != nil {
+		measured, err := helper.AnalyseLoudness(ctx, *clip.CaptionsVideoOutputPath, clip.Loudness.Target)
+		if err != nil {
+			return err
+		}
+		clip.Loudness.Measured = *measured
+		_, _ = fmt.Fprintf(
+			w.Output,
+			"Measured %.1f LUFS peaking at %.1f dBTP, normalising to %g LUFS\n",
+			measured.Integrated,
+			measured.TruePeak,
+			clip.Loudness.Target.Integrated,
+		)
+	}
 
 	trimmedPath, err := w.TrimAndFade(
+		ctx,
 		*clip.CaptionsVideoOutputPath,
-		outputDir,
+		outputFile,
 		duration,
 		fadeDuration,
+		clip.FadeInDuration,
+		audioCensor.Mode,
+		censorSpans,
+		clip.Loudness,
 		verbose,
 	)
 
@@ -107,7 +681,110 @@ func (w ScriptServiceImpl) RunTrimAndFadeOnClip(
 	return nil
 }
 
+// RunWriteBioOnClip renders the bio template in biosDir matching the clip's
+// artist and writes it next to the clip's final video.
+func (w ScriptServiceImpl) RunWriteBioOnClip(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	biosDir string,
+	maxLength int,
+	startTime,
+	endTime string,
+) error {
+	if clip.TrimmedVideoOutputPath == nil {
+		return errors.New("no final video to write a bio for")
+	}
+
+	bio, hashtags, err := w.RenderBio(ctx, clip, biosDir, maxLength, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	video := *clip.TrimmedVideoOutputPath
+	outputFile := strings.TrimSuffix(video, filepath.Ext(video)) + ".txt"
+	overwrite := w.Overwrite || clip.OwnsArtifact(model.ClipStageBio, outputFile)
+	if err := helper.CheckOverwrite(outputFile, overwrite); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(w.Output, "Writing bio to", outputFile)
+	if err := os.WriteFile(outputFile, []byte(bio+"\n"), 0644); err != nil {
+		return err
+	}
+
+	clip.Bio = &bio
+	clip.BioPath = &outputFile
+	clip.Hashtags = hashtags
+	return nil
+}
+
+// RenderBio renders the clip's bio from the template in biosDir matching its
+// artist, refusing bios longer than maxLength characters. It returns the
+// hashtags the bio used, the clip's own if it has some and otherwise ones
+// picked from the pool for its artist.
+func (w ScriptServiceImpl) RenderBio(
+	ctx context.Context,
+	clip *model.ClipDTO,
+	biosDir string,
+	maxLength int,
+	startTime,
+	endTime string,
+) (string, []string, error) {
+	data, err := helper.ClipBioData(clip, startTime, endTime)
+	if err != nil {
+		return "", nil, err
+	}
+
+	templatePath, err := helper.FindBioTemplate(biosDir, data.Artist)
+	if err != nil {
+		return "", nil, err
+	}
+
+	bio, hashtags, err := helper.RenderBio(templatePath, data, func() ([]string, error) {
+		return w.pickHashtags(ctx, clip, data.Artist)
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", templatePath, err)
+	}
+	if err := helper.CheckBioLength(bio, maxLength); err != nil {
+		return "", nil, fmt.Errorf("%s: %w", templatePath, err)
+	}
+	return bio, hashtags, nil
+}
+
+// pickHashtags returns the hashtags the clip's bio was first rendered with, or
+// else picks them from the pool for artist.
+func (w ScriptServiceImpl) pickHashtags(ctx context.Context, clip *model.ClipDTO, artist string) ([]string, error) {
+	if len(clip.Hashtags) > 0 {
+		return clip.Hashtags, nil
+	}
+
+	pool, err := helper.ResolveHashtagPool(w.HashtagPools, artist)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashtags []string
+	choose := func(usage []model.HashtagUsage) ([]string, error) {
+		hashtags, err = helper.PickHashtags(pool, usage, w.HashtagStrategy, w.HashtagMax, clipRand(clip, helper.RandHashtags))
+		return hashtags, err
+	}
+	if w.Hashtags == nil {
+		_, err := choose(nil)
+		return hashtags, err
+	}
+	if err := w.Hashtags.Pick(ctx, clip, choose); err != nil {
+		return nil, err
+	}
+	return hashtags, nil
+}
+
+// Transcribe runs the configured Transcriber over the window and returns the
+// path of the word level transcript JSON. Transcripts are cached under
+// outputDir by audio hash, model and window, so a cached one is reused rather
+// than transcribing again.
 func (w ScriptServiceImpl) Transcribe(
+	ctx context.Context,
 	inputFile,
 	outputDir,
 	model string,
@@ -115,40 +792,107 @@ func (w ScriptServiceImpl) Transcribe(
 	startTime,
 	endTime string,
 ) (*string, error) {
-	t := time.Now().Unix()
-	outputFile := fmt.Sprintf("%s/%d.ass", outputDir, t)
+	hash, err := helper.GetFilehash(inputFile)
+	if err != nil {
+		return nil, err
+	}
 
-	args := []string{inputFile, outputFile, "--model", model, "--start", startTime, "--end", endTime}
-	cmd := exec.CommandContext(context.Background(), generateCaptionsPath, args...)
+	cacheDir := filepath.Join(outputDir, transcriptCacheDir)
+	if err := helper.CreateDirectoryIfNotExists(cacheDir); err != nil {
+		return nil, err
+	}
 
-	fmt.Println("Running: ", helper.GetCommandPrintable(cmd))
+	source := w.Transcriber.CacheKey() + "-" + model
+	transcriptFile := helper.TranscriptCachePath(cacheDir, hash, source, startTime, endTime)
+	if helper.Exists(transcriptFile) {
+		_, _ = fmt.Fprintln(w.Output, "Using cached transcript", transcriptFile)
+		return &transcriptFile, nil
+	}
 
-	if verbose {
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+	transcript, err := w.Transcriber.Transcribe(ctx, transcriber.Request{
+		AudioPath: inputFile,
+		Model:     model,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Verbose:   verbose,
+		Output:    w.Output,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := cmd.Run(); err != nil {
+	if err := helper.WriteTranscript(transcriptFile, transcript); err != nil {
 		return nil, err
 	}
 
-	return &outputFile, nil
+	return &transcriptFile, nil
+}
+
+// GenerateCaptions censors the transcript at transcriptFile and renders it to
+// an ASS file at outputFile, returning its path and the words censored.
+func (w ScriptServiceImpl) GenerateCaptions(transcriptFile, outputFile string) (*string, []model.CensorEvent, error) {
+	transcript, err := helper.ReadTranscript(transcriptFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading transcript %s: %w", transcriptFile, err)
+	}
+
+	filter, err := w.CensorFilter()
+	if err != nil {
+		return nil, nil, err
+	}
+	censored, events := filter.Transcript(transcript)
+	if len(events) > 0 {
+		_, _ = fmt.Fprintf(w.Output, "Censored %d word(s)\n", len(events))
+	}
+
+	if err := w.RenderCaptions(censored, outputFile); err != nil {
+		return nil, nil, err
+	}
+
+	return &outputFile, events, nil
+}
+
+// CensorFilter loads the service's censor list.
+func (w ScriptServiceImpl) CensorFilter() (*censor.Filter, error) {
+	lists, err := censor.Load(w.CensorPath)
+	if err != nil {
+		return nil, err
+	}
+	return lists.Filter(w.CensorList)
+}
+
+// RenderCaptions lays the transcript out as ASS subtitles at outputFile using
+// the service's caption options.
+func (w ScriptServiceImpl) RenderCaptions(transcript *model.Transcript, outputFile string) error {
+	_, _ = fmt.Fprintln(w.Output, "Writing captions to", outputFile)
+
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+
+	if err := captions.WriteASS(file, transcript, w.Captions); err != nil {
+		_ = file.Close()
+		_ = os.Remove(outputFile)
+		return err
+	}
+	return file.Close()
 }
 
 func (w ScriptServiceImpl) BurnCaption(
+	ctx context.Context,
 	captionFile,
 	videoFile,
 	audioFile,
-	outputDir string,
+	segmentsFile,
+	outputFile string,
+	videoStart *float64,
 	targetWidth,
 	targetHeight *int,
 	startTime,
 	endTime string,
 	verbose bool,
 ) (*string, error) {
-	t := time.Now().Unix()
-	outputFile := fmt.Sprintf("%s/%d-captions.mp4", outputDir, t)
-
 	var defaultWidth = 1080
 	var defaultHeight = 1920
 
@@ -171,15 +915,16 @@ func (w ScriptServiceImpl) BurnCaption(
 		"--end",
 		endTime,
 	}
-
-	cmd := exec.CommandContext(context.Background(), burnCaptionsPath, args...)
-
-	if verbose {
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+	if segmentsFile != "" {
+		args = append(args, "--segments", segmentsFile)
+	}
+	if videoStart != nil {
+		args = append(args, "--video-start", strconv.FormatFloat(*videoStart, 'f', -1, 64))
 	}
 
-	if err := cmd.Run(); err != nil {
+	cmd := exec.CommandContext(ctx, burnCaptionsPath, args...)
+
+	if err := w.runScript(ctx, cmd, outputFile, verbose); err != nil {
 		return nil, err
 	}
 
@@ -187,15 +932,17 @@ func (w ScriptServiceImpl) BurnCaption(
 }
 
 func (w ScriptServiceImpl) TrimAndFade(
+	ctx context.Context,
 	inputFile,
-	outputDir,
+	outputFile,
 	duration string,
 	fadeDuration *int,
+	fadeInDuration *float64,
+	censorMode model.AudioCensorMode,
+	censorSpans []model.TimeSpan,
+	loudness *model.ClipLoudness,
 	verbose bool,
 ) (*string, error) {
-	t := time.Now().Unix()
-	outputFile := fmt.Sprintf("%s/%d-trim.mp4", outputDir, t)
-
 	var defaultFadeDuration = 3
 	if fadeDuration == nil {
 		fadeDuration = &defaultFadeDuration
@@ -208,17 +955,143 @@ func (w ScriptServiceImpl) TrimAndFade(
 		duration,
 		fmt.Sprintf("--fade-duration=%d", *fadeDuration),
 	}
+	if fadeInDuration != nil {
+		args = append(args, fmt.Sprintf("--fade-in=%g", *fadeInDuration))
+	}
+	if len(censorSpans) > 0 {
+		args = append(args, fmt.Sprintf("--censor-mode=%s", censorMode))
+		for _, span := range censorSpans {
+			args = append(args, fmt.Sprintf("--censor=%.3f-%.3f", span.Start, span.End))
+		}
+	}
+	if loudness != nil {
+		args = append(
+			args,
+			fmt.Sprintf("--loudness-target=%g", loudness.Target.Integrated),
+			fmt.Sprintf("--true-peak=%g", loudness.Target.TruePeak),
+			fmt.Sprintf("--loudness-range=%g", loudness.Target.Range),
+			fmt.Sprintf("--measured-i=%g", loudness.Measured.Integrated),
+			fmt.Sprintf("--measured-tp=%g", loudness.Measured.TruePeak),
+			fmt.Sprintf("--measured-lra=%g", loudness.Measured.Range),
+			fmt.Sprintf("--measured-thresh=%g", loudness.Measured.Threshold),
+			fmt.Sprintf("--target-offset=%g", loudness.Measured.Offset),
+		)
+	}
+
+	cmd := exec.CommandContext(ctx, trimAndFadePath, args...)
+
+	if err := w.runScript(ctx, cmd, outputFile, verbose); err != nil {
+		return nil, err
+	}
+
+	return &outputFile, nil
+}
+
+// renderKey is the part of a video's output key beyond its captions.
+type renderKey struct {
+	SegmentPlan     *model.SegmentPlan    `json:"segment_plan,omitempty"`
+	BackgroundStart *float64              `json:"background_start,omitempty"`
+	Width           *int                  `json:"width,omitempty"`
+	Height          *int                  `json:"height,omitempty"`
+	FadeDuration    *int                  `json:"fade_duration,omitempty"`
+	AudioCensor     *model.AudioCensor    `json:"audio_censor,omitempty"`
+	FadeInDuration  *float64              `json:"fade_in_duration,omitempty"`
+	Loudness        *model.LoudnessTarget `json:"loudness,omitempty"`
+}
+
+// OutputPath names the output of stage for clip under outputDir. Names are
+// derived from the clip's contents, so the same clip, window and style always
+// map to the same file, and an existing file is only replaced with Overwrite.
+func (w ScriptServiceImpl) OutputPath(outputDir string, clip *model.ClipDTO, stage model.ClipStage) (string, error) {
+	if clip.Hash == nil {
+		hash, err := helper.GetFilehash(clip.AudioInputPath)
+		if err != nil {
+			return "", err
+		}
+		clip.Hash = &hash
+	}
 
-	cmd := exec.CommandContext(context.Background(), trimAndFadePath, args...)
+	style := w.Captions.CaptionStyle
+	if clip.CaptionStyle != nil {
+		style = *clip.CaptionStyle
+	}
+	// Presets only name a style, so renaming one doesn't change the output
+	style.Name = ""
+	styleJSON, err := json.Marshal(style)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{*clip.Hash, clip.VideoInputPath, clip.StartTime, clip.EndTime, string(styleJSON)}
+	// Videos are also shaped by how their background was cut and framed
+	if stage != model.ClipStageCaptions {
+		render := renderKey{
+			SegmentPlan:     clip.SegmentPlan,
+			BackgroundStart: clip.BackgroundStart,
+			Width:           clip.Width,
+			Height:          clip.Height,
+		}
+		if stage == model.ClipStageTrim {
+			render.FadeDuration = clip.FadeDuration
+			render.AudioCensor = clip.AudioCensor
+			render.FadeInDuration = clip.FadeInDuration
+			if clip.Loudness != nil {
+				render.Loudness = &clip.Loudness.Target
+			}
+		}
+		renderJSON, err := json.Marshal(render)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, string(renderJSON))
+	}
+
+	track := helper.ClipTrackInfo(clip)
+	outputFile, err := helper.RenderOutputPath(w.Naming, outputDir, model.OutputName{
+		Artist:    track.Artist,
+		Track:     track.Title,
+		AudioHash: *clip.Hash,
+		Hash8:     helper.OutputKey(parts...),
+		Stage:     stage,
+	}, stageExtensions[stage])
+	if err != nil {
+		return "", err
+	}
+
+	overwrite := w.Overwrite || clip.OwnsArtifact(stage, outputFile)
+	if err := helper.CheckOverwrite(outputFile, overwrite); err != nil {
+		return "", err
+	}
+	if err := helper.CreateDirectoryIfNotExists(filepath.Dir(outputFile)); err != nil {
+		return "", err
+	}
+	return outputFile, nil
+}
+
+// runScript runs cmd to produce outputFile. If the script fails, times out or
+// is interrupted, the partially written outputFile is removed so a retry starts
+// from a clean slate.
+func (w ScriptServiceImpl) runScript(ctx context.Context, cmd *exec.Cmd, outputFile string, verbose bool) error {
+	helper.PrepareCommand(cmd)
+
+	_, _ = fmt.Fprintln(w.Output, "Running: ", helper.GetCommandPrintable(cmd))
 
 	if verbose {
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+		cmd.Stdout = w.Output
+		cmd.Stderr = w.Output
 	}
 
-	if err := cmd.Run(); err != nil {
-		return nil, err
+	err := cmd.Run()
+	if err == nil {
+		return nil
 	}
 
-	return &outputFile, nil
+	if rmErr := os.Remove(outputFile); rmErr != nil && !errors.Is(rmErr, os.ErrNotExist) {
+		err = errors.Join(err, rmErr)
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(cmd.Path), ctxErr)
+	}
+	return err
 }
@@ -0,0 +1,217 @@
+package subtitles
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+var (
+	// Override blocks such as {\an5\pos(540,960)}.
+	assOverridePattern = regexp.MustCompile(`\{[^}]*\}`)
+	// Karaoke tags, \k, \kf, \K and \ko, giving how many centiseconds the
+	// syllable after them lasts.
+	assKaraokePattern = regexp.MustCompile(`\\(?:k[fo]?|K)(\d+)`)
+)
+
+// The fields of the events section when a script doesn't give its own.
+var defaultASSEventFields = []string{
+	"Layer", "Start", "End", "Style", "Name", "MarginL", "MarginR", "MarginV", "Effect", "Text",
+}
+
+const assHeader = `[Script Info]
+ScriptType: v4.00+
+PlayResX: 1080
+PlayResY: 1920
+
+[V4+ Styles]
+Format: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, OutlineColour, BackColour, Bold, Italic, Underline, StrikeOut, ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, Alignment, MarginL, MarginR, MarginV, Encoding
+Style: Default,Arial,72,&H00FFFFFF,&H000000FF,&H00000000,&H00000000,0,0,0,0,100,100,0,0,1,3,0,2,10,10,10,1
+
+[Events]
+Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text
+`
+
+// parseASS reads the dialogue of an ASS or SSA script, dropping its styling.
+// Karaoke tags time the words they lead. Scripts that place each word as an
+// event of its own, as captions renders them, give a cue per word.
+func parseASS(text string) (*Subtitles, error) {
+	subs := &Subtitles{}
+	inEvents := false
+	fields := defaultASSEventFields
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") {
+			inEvents = strings.EqualFold(line, "[Events]")
+			continue
+		}
+		if !inEvents {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "Format":
+			fields = strings.Split(value, ",")
+			for i := range fields {
+				fields[i] = strings.TrimSpace(fields[i])
+			}
+		case "Dialogue":
+			cue, err := parseASSDialogue(value, fields)
+			if err != nil {
+				return nil, err
+			}
+			if cue.Text != "" {
+				subs.Cues = append(subs.Cues, cue)
+			}
+		}
+	}
+
+	if !inEvents && len(subs.Cues) == 0 {
+		return nil, errors.New("missing [Events] section")
+	}
+	return subs, nil
+}
+
+func parseASSDialogue(value string, fields []string) (Cue, error) {
+	// Text is the last field and the only one that may hold commas
+	values := strings.SplitN(value, ",", len(fields))
+	if len(values) != len(fields) {
+		return Cue{}, fmt.Errorf("dialogue %q has %d fields, expected %d", strings.TrimSpace(value), len(values), len(fields))
+	}
+	get := func(name string) string {
+		for i, field := range fields {
+			if strings.EqualFold(field, name) {
+				return strings.TrimSpace(values[i])
+			}
+		}
+		return ""
+	}
+
+	start, err := parseTimestamp(get("Start"))
+	if err != nil {
+		return Cue{}, err
+	}
+	end, err := parseTimestamp(get("End"))
+	if err != nil {
+		return Cue{}, err
+	}
+
+	raw := strings.NewReplacer(`\N`, "\n", `\n`, "\n", `\h`, " ").Replace(get("Text"))
+	cue := Cue{Start: start, End: end, Text: cleanText(assOverridePattern.ReplaceAllString(raw, ""))}
+	cue.Words = assKaraokeWords(start, end, raw)
+	return cue, nil
+}
+
+// assKaraokeWords times the words of raw dialogue text by its karaoke tags,
+// each syllable following the last from start and sharing its time between
+// the words in it. Words split into syllables are joined again, and text
+// without karaoke tags has no word timings.
+func assKaraokeWords(start, end float64, raw string) []model.Word {
+	if !assKaraokePattern.MatchString(raw) {
+		return nil
+	}
+
+	type syllable struct {
+		text       string
+		start, end float64
+	}
+	// Text belongs to the syllable of the last karaoke tag before it, and
+	// tags with no text after them are pauses
+	var syllables []syllable
+	current := syllable{start: start, end: start}
+	at := start
+	last := 0
+	for _, block := range assOverridePattern.FindAllStringIndex(raw, -1) {
+		current.text += raw[last:block[0]]
+		for _, match := range assKaraokePattern.FindAllStringSubmatch(raw[block[0]:block[1]], -1) {
+			cs, _ := strconv.Atoi(match[1])
+			syllables = append(syllables, current)
+			current = syllable{start: at, end: min(at+float64(cs)/100, end)}
+			at += float64(cs) / 100
+		}
+		last = block[1]
+	}
+	current.text += raw[last:]
+	syllables = append(syllables, current)
+
+	var words []model.Word
+	joinNext := false
+	for _, s := range syllables {
+		fields := strings.Fields(s.text)
+		if len(fields) == 0 {
+			// A syllable of only a space still ends the word before it
+			joinNext = joinNext && s.text == ""
+			continue
+		}
+
+		step := (s.end - s.start) / float64(len(fields))
+		for j, field := range fields {
+			if j == 0 && joinNext && !startsWithSpace(s.text) && len(words) > 0 {
+				words[len(words)-1].Word += field
+				words[len(words)-1].End = s.start + step
+				continue
+			}
+			words = append(words, model.Word{Word: field, Start: s.start + step*float64(j), End: s.start + step*float64(j+1)})
+		}
+		joinNext = !endsWithSpace(s.text)
+	}
+	return words
+}
+
+func startsWithSpace(s string) bool {
+	return strings.TrimLeft(s, " \n") != s
+}
+
+func endsWithSpace(s string) bool {
+	return strings.TrimRight(s, " \n") != s
+}
+
+// writeASS writes a plain ASS script with a cue per dialogue line, timing the
+// words of cues that have word timings with karaoke tags.
+func writeASS(w io.Writer, subs *Subtitles) error {
+	if _, err := io.WriteString(w, assHeader); err != nil {
+		return err
+	}
+	for _, cue := range subs.Cues {
+		text := strings.ReplaceAll(cue.Text, "\n", `\N`)
+		if len(cue.Words) > 0 {
+			parts := make([]string, len(cue.Words))
+			for i, word := range cue.Words {
+				// A word is highlighted from when it's sung until the next one
+				// is, with any lead in before the first folded into it
+				from := cue.Start
+				if i > 0 {
+					from = word.Start
+				}
+				to := cue.End
+				if i+1 < len(cue.Words) {
+					to = cue.Words[i+1].Start
+				}
+				parts[i] = fmt.Sprintf("{\\k%d}%s", int(math.Round((to-from)*100)), strings.TrimSpace(word.Word))
+			}
+			text = strings.Join(parts, " ")
+		}
+		if _, err := fmt.Fprintf(w, "Dialogue: 0,%s,%s,Default,,0,0,0,,%s\n", assTime(cue.Start), assTime(cue.End), text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assTime formats seconds as H:MM:SS.cc, rounded to centiseconds as captions
+// rounds them.
+func assTime(t float64) string {
+	h, m, s, cs := splitClock(t, 100)
+	return fmt.Sprintf("%d:%02d:%02d.%02d", h, m, s, cs)
+}
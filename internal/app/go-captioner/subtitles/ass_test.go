@@ -0,0 +1,156 @@
+package subtitles
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func TestAssKaraokeWords(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		end  float64
+		want []model.Word
+	}{
+		{
+			name: "no karaoke tags",
+			raw:  `{\an5\pos(540,960)}Yeah baby`,
+			end:  1,
+		},
+		{
+			name: "each tag form",
+			raw:  `{\k10}a {\kf20}b {\K30}c {\ko40}d`,
+			end:  2,
+			want: []model.Word{
+				{Word: "a", Start: 0, End: 0.1},
+				{Word: "b", Start: 0.1, End: 0.3},
+				{Word: "c", Start: 0.3, End: 0.6},
+				{Word: "d", Start: 0.6, End: 1},
+			},
+		},
+		{
+			name: "syllables joined into words",
+			raw:  `{\k20}Lu{\k30}cid {\k50}dreams`,
+			end:  1,
+			want: []model.Word{
+				{Word: "Lucid", Start: 0, End: 0.5},
+				{Word: "dreams", Start: 0.5, End: 1},
+			},
+		},
+		{
+			name: "several words share a syllable",
+			raw:  `{\k60}we go`,
+			end:  1,
+			want: []model.Word{
+				{Word: "we", Start: 0, End: 0.3},
+				{Word: "go", Start: 0.3, End: 0.6},
+			},
+		},
+		{
+			name: "pauses",
+			raw:  `{\k50}{\k50}Yeah{\k25} {\k25}go`,
+			end:  2,
+			want: []model.Word{
+				{Word: "Yeah", Start: 0.5, End: 1},
+				{Word: "go", Start: 1.25, End: 1.5},
+			},
+		},
+		{
+			name: "cut off at the end of the event",
+			raw:  `{\k100}Yeah {\k100}go {\k100}on`,
+			end:  1.5,
+			want: []model.Word{
+				{Word: "Yeah", Start: 0, End: 1},
+				{Word: "go", Start: 1, End: 1.5},
+				{Word: "on", Start: 2, End: 1.5},
+			},
+		},
+		{
+			name: "tags among other overrides",
+			raw:  `{\an5\k40\1c&H0000FF&}Yeah{\i1} {\k60\i0}baby`,
+			end:  1,
+			want: []model.Word{
+				{Word: "Yeah", Start: 0, End: 0.4},
+				{Word: "baby", Start: 0.4, End: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cue := Cue{End: test.end, Words: assKaraokeWords(0, test.end, test.raw)}
+			got := rounded(&Subtitles{Cues: []Cue{cue}}).Cues[0].Words
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("assKaraokeWords = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseASS(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []Cue
+	}{
+		{
+			name: "own field order, commas in text",
+			source: "[Events]\nFormat: Start, End, Text\n" +
+				"Dialogue: 0:00:01.00,0:00:02.00,Yeah, I'm tryna\n",
+			want: []Cue{{Start: 1, End: 2, Text: "Yeah, I'm tryna"}},
+		},
+		{
+			name: "line breaks and hard spaces",
+			source: "[Events]\n" +
+				`Dialogue: 0,0:00:01.00,0:00:02.00,Default,,0,0,0,,Yeah\Nbaby\hgo\nnow` + "\n",
+			want: []Cue{{Start: 1, End: 2, Text: "Yeah\nbaby go\nnow"}},
+		},
+		{
+			name: "dialogue outside events and empty text skipped",
+			source: "[Script Info]\nDialogue: 0,0:00:05.00,0:00:06.00,Default,,0,0,0,,not an event\n" +
+				"[Events]\nDialogue: 0,0:00:01.00,0:00:02.00,Default,,0,0,0,,{\\an5}\n" +
+				"Dialogue: 0,0:00:02.00,0:00:03.00,Default,,0,0,0,,Yeah\n",
+			want: []Cue{{Start: 2, End: 3, Text: "Yeah"}},
+		},
+		{
+			name: "a word an event, as captions renders them",
+			source: "[Events]\n" +
+				`Dialogue: 0,0:00:01.00,0:00:03.00,Default,,0,0,0,,{\an5\pos(340,380)}Yeah` + "\n" +
+				`Dialogue: 0,0:00:01.40,0:00:03.00,Default,,0,0,0,,{\an5\pos(790,380)}I'm` + "\n",
+			want: []Cue{{Start: 1, End: 3, Text: "Yeah"}, {Start: 1.4, End: 3, Text: "I'm"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parse(t, test.source, FormatASS)
+			if !reflect.DeepEqual(got.Cues, test.want) {
+				t.Errorf("cues = %+v, want %+v", got.Cues, test.want)
+			}
+		})
+	}
+}
+
+func TestParseASSErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{name: "no events section", source: "[Script Info]\nTitle: x\n", want: "missing [Events] section"},
+		{name: "too few fields", source: "[Events]\nDialogue: 0,0:00:01.00\n", want: "has 2 fields, expected 10"},
+		{name: "bad time", source: "[Events]\nDialogue: 0,soon,0:00:01.00,Default,,0,0,0,,Yeah\n", want: "invalid timestamp"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(test.source), FormatASS)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Parse error = %v, want one containing %q", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package subtitles
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// LRC line timestamps, [mm:ss], [mm:ss.xx] or [mm:ss:xx], several of which
+	// may lead a line that repeats.
+	lrcLineTimePattern = regexp.MustCompile(`^\[(\d+:\d{1,2}(?:[.:]\d{1,3})?)\]`)
+	// Enhanced LRC word timestamps, <mm:ss.xx>.
+	lrcWordTimePattern = regexp.MustCompile(`<(\d+:\d{1,2}(?:[.:]\d{1,3})?)>`)
+)
+
+// parseLRC reads synced LRC lyrics. Each timed line is a cue lasting until the
+// next, which a timestamp with no text can end early, and ID tags such as
+// [ar:Juice WRLD] are skipped. The last line lasts as long as its words would
+// take to sing.
+func parseLRC(text string) (*Subtitles, error) {
+	type timedLine struct {
+		time float64
+		text string
+	}
+
+	var lines []timedLine
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		var times []float64
+		for {
+			match := lrcLineTimePattern.FindStringSubmatch(line)
+			if match == nil {
+				break
+			}
+			t, err := parseTimestamp(lrcClock(match[1]))
+			if err != nil {
+				return nil, err
+			}
+			times = append(times, t)
+			line = strings.TrimSpace(line[len(match[0]):])
+		}
+		for _, t := range times {
+			lines = append(lines, timedLine{time: t, text: line})
+		}
+	}
+
+	// Repeated lines are timed apart from each other, so order them as sung
+	slices.SortStableFunc(lines, func(a, b timedLine) int { return cmp.Compare(a.time, b.time) })
+
+	subs := &Subtitles{}
+	for i, line := range lines {
+		if line.text == "" {
+			continue
+		}
+
+		cue := Cue{Start: line.time}
+		if i+1 < len(lines) {
+			cue.End = lines[i+1].time
+		} else {
+			cue.End = line.time + maxSpreadWordDuration*float64(len(strings.Fields(line.text)))
+		}
+
+		clocked := lrcWordTimePattern.ReplaceAllStringFunc(line.text, func(tag string) string {
+			return "<" + lrcClock(tag[1:len(tag)-1]) + ">"
+		})
+		words, err := timedWords(cue.Start, cue.End, clocked, lrcWordTimePattern)
+		if err != nil {
+			return nil, err
+		}
+		cue.Words = words
+		cue.Text = cleanText(lrcWordTimePattern.ReplaceAllString(line.text, ""))
+		if cue.Text != "" {
+			subs.Cues = append(subs.Cues, cue)
+		}
+	}
+	return subs, nil
+}
+
+// writeLRC writes enhanced LRC, with word timestamps for cues that have word
+// timings. LRC lines are single lines, and those ending before the next one
+// starts are followed by an empty line at their end.
+func writeLRC(w io.Writer, subs *Subtitles) error {
+	// Gaps shorter than LRC's hundredths of a second aren't worth marking
+	const minGap = 0.01
+
+	for i, cue := range subs.Cues {
+		text := strings.Join(strings.Fields(cue.Text), " ")
+		if len(cue.Words) > 0 {
+			text = joinTimedWords(cue, func(t float64) string { return "<" + lrcTime(t) + ">" })
+		}
+		if _, err := fmt.Fprintf(w, "[%s]%s\n", lrcTime(cue.Start), text); err != nil {
+			return err
+		}
+
+		if i+1 == len(subs.Cues) || subs.Cues[i+1].Start-cue.End >= minGap {
+			if _, err := fmt.Fprintf(w, "[%s]\n", lrcTime(cue.End)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lrcClock rewrites the mm:ss:xx form of an LRC time as mm:ss.xx.
+func lrcClock(t string) string {
+	if strings.Count(t, ":") == 2 {
+		i := strings.LastIndex(t, ":")
+		return t[:i] + "." + t[i+1:]
+	}
+	return t
+}
+
+// lrcTime formats seconds as mm:ss.xx, with minutes past an hour kept in the
+// minutes.
+func lrcTime(t float64) string {
+	h, m, s, cs := splitClock(t, 100)
+	return fmt.Sprintf("%02d:%02d.%02d", h*60+m, s, cs)
+}
@@ -0,0 +1,85 @@
+package subtitles
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func TestParseLRC(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []Cue
+	}{
+		{
+			name:   "lines last until the next",
+			source: "[00:01.00]Yeah\n[00:02.50]baby\n",
+			// The last line lasts a second a word
+			want: []Cue{{Start: 1, End: 2.5, Text: "Yeah"}, {Start: 2.5, End: 3.5, Text: "baby"}},
+		},
+		{
+			name:   "repeated lines",
+			source: "[00:01.00][00:05.00]Lucid dreams\n[00:03.00]Verse\n[00:04.00]\n",
+			want: []Cue{
+				{Start: 1, End: 3, Text: "Lucid dreams"},
+				{Start: 3, End: 4, Text: "Verse"},
+				{Start: 5, End: 7, Text: "Lucid dreams"},
+			},
+		},
+		{
+			name:   "timestamp forms",
+			source: "[00:01]one\n[00:02.5]two\n[00:03:25]three\n[01:04.125]four\n[01:05.00]\n",
+			want: []Cue{
+				{Start: 1, End: 2.5, Text: "one"},
+				{Start: 2.5, End: 3.25, Text: "two"},
+				{Start: 3.25, End: 64.125, Text: "three"},
+				{Start: 64.125, End: 65, Text: "four"},
+			},
+		},
+		{
+			name:   "id tags and untimed lines",
+			source: "[ar:Juice WRLD]\n[length: 03:59]\n[offset:+0]\nnot timed\n[00:01.00]Yeah\n[00:02.00]\n",
+			want:   []Cue{{Start: 1, End: 2, Text: "Yeah"}},
+		},
+		{
+			name:   "word timestamps in the mm:ss:xx form",
+			source: "[00:01.00]<00:01:00>Yeah <00:01:50>baby\n[00:02.00]\n",
+			want: []Cue{{Start: 1, End: 2, Text: "Yeah baby", Words: []model.Word{
+				{Word: "Yeah", Start: 1, End: 1.5},
+				{Word: "baby", Start: 1.5, End: 2},
+			}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parse(t, test.source, FormatLRC)
+			if !reflect.DeepEqual(got.Cues, test.want) {
+				t.Errorf("cues = %+v, want %+v", got.Cues, test.want)
+			}
+		})
+	}
+}
+
+func TestWriteLRC(t *testing.T) {
+	subs := &Subtitles{Cues: []Cue{
+		{Start: 1, End: 2.004, Text: "Yeah\nbaby"},
+		// Starts too soon after the last ends for the gap to be marked
+		{Start: 2.009, End: 3.996, Words: []model.Word{
+			{Word: " I'm", Start: 2.009, End: 3},
+			{Word: " tryna", Start: 3, End: 3.996},
+		}},
+	}}
+	want := "[00:01.00]Yeah baby\n[00:02.01]<00:02.01>I'm <00:03.00>tryna\n[00:04.00]\n"
+
+	var out bytes.Buffer
+	if err := writeLRC(&out, subs); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("writeLRC =\n%s\nwant\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,103 @@
+package subtitles
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	// The line timing a cue, followed in WebVTT by the cue's settings.
+	cueTimingPattern = regexp.MustCompile(`^\s*(\S+)\s+-->\s+(\S+)`)
+	// Styling such as <i>, <b> or <font color="...">, and in WebVTT <c.class>
+	// and <v Speaker>, which captions have no use for.
+	markupPattern = regexp.MustCompile(`</?[A-Za-z][^>]*>`)
+)
+
+// parseSRT reads SRT, blocks of an index, a timing line and the cue's text.
+func parseSRT(text string) (*Subtitles, error) {
+	subs := &Subtitles{}
+	for _, block := range splitBlocks(text) {
+		cue, ok, err := parseCueBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			cue.Text = cleanText(markupPattern.ReplaceAllString(cue.Text, ""))
+			subs.Cues = append(subs.Cues, cue)
+		}
+	}
+	return subs, nil
+}
+
+func writeSRT(w io.Writer, subs *Subtitles) error {
+	for i, cue := range subs.Cues {
+		if _, err := fmt.Fprintf(w, "%d\n%s --> %s\n%s\n\n", i+1, srtTime(cue.Start), srtTime(cue.End), cue.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitBlocks splits text into its blocks of lines, which blank lines separate.
+func splitBlocks(text string) [][]string {
+	var blocks [][]string
+	var block []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(block) > 0 {
+				blocks = append(blocks, block)
+			}
+			block = nil
+			continue
+		}
+		block = append(block, strings.TrimRight(line, " \t"))
+	}
+	if len(block) > 0 {
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// parseCueBlock reads a block of an identifier, which may be left out, a
+// timing line and the text, reporting false for blocks without a timing line.
+func parseCueBlock(block []string) (Cue, bool, error) {
+	for i, line := range block[:min(len(block), 2)] {
+		match := cueTimingPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		start, err := parseTimestamp(match[1])
+		if err != nil {
+			return Cue{}, false, err
+		}
+		end, err := parseTimestamp(match[2])
+		if err != nil {
+			return Cue{}, false, err
+		}
+		if end < start {
+			return Cue{}, false, fmt.Errorf("cue at %s ends before it starts", match[1])
+		}
+		return Cue{Start: start, End: end, Text: strings.Join(block[i+1:], "\n")}, true, nil
+	}
+	return Cue{}, false, nil
+}
+
+// cleanText trims each line of text and drops those left empty.
+func cleanText(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// srtTime formats seconds as HH:MM:SS,mmm.
+func srtTime(t float64) string {
+	h, m, s, ms := splitClock(t, 1000)
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", h, m, s, ms)
+}
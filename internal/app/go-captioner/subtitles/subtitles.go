@@ -0,0 +1,288 @@
+// Package subtitles reads and writes timed text in the SRT, WebVTT, LRC and
+// ASS formats, and converts it to and from word level transcripts.
+package subtitles
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// Format is a subtitle file format.
+type Format string
+
+const (
+	FormatSRT Format = "srt"
+	FormatVTT Format = "vtt"
+	FormatLRC Format = "lrc"
+	FormatASS Format = "ass"
+)
+
+// Formats lists the supported formats, also the extensions of their files.
+var Formats = []Format{FormatSRT, FormatVTT, FormatLRC, FormatASS}
+
+// Words spread over a cue without word timings take no more than this many
+// seconds each, so a line followed by a long instrumental isn't drawn out.
+const maxSpreadWordDuration = 1.0
+
+// Cue is a piece of text shown between Start and End, in seconds. Words holds
+// the timing of each word, for formats that carry it, and is otherwise empty.
+type Cue struct {
+	Start float64
+	End   float64
+	Text  string
+	Words []model.Word
+}
+
+// Subtitles are cues in the order they start.
+type Subtitles struct {
+	Cues []Cue
+}
+
+// ParseFormat returns the format named s, ignoring case and a leading dot so
+// file extensions can be given.
+func ParseFormat(s string) (Format, error) {
+	format := Format(strings.ToLower(strings.TrimPrefix(s, ".")))
+	if !slices.Contains(Formats, format) {
+		return "", fmt.Errorf("unknown subtitle format %q, expected one of %s", s, joinFormats())
+	}
+	return format, nil
+}
+
+// FormatOf returns the format of the file at path by its extension.
+func FormatOf(path string) (Format, error) {
+	ext := filepath.Ext(path)
+	if ext == "" {
+		return "", fmt.Errorf("%s has no extension to tell its subtitle format by", path)
+	}
+	return ParseFormat(ext)
+}
+
+// Read parses the subtitles at path, in the format given by its extension.
+func Read(path string) (*Subtitles, error) {
+	format, err := FormatOf(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	subs, err := Parse(file, format)
+	if err != nil {
+		return nil, fmt.Errorf("reading subtitles %s: %w", path, err)
+	}
+	return subs, nil
+}
+
+// Parse reads subtitles in format from r. Cues are sorted by their start.
+func Parse(r io.Reader, format Format) (*Subtitles, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// Editors on Windows like to lead with a byte order mark and end lines
+	// with carriage returns
+	text := strings.ReplaceAll(string(bytes.TrimPrefix(data, []byte("\ufeff"))), "\r\n", "\n")
+
+	var subs *Subtitles
+	switch format {
+	case FormatSRT:
+		subs, err = parseSRT(text)
+	case FormatVTT:
+		subs, err = parseVTT(text)
+	case FormatLRC:
+		subs, err = parseLRC(text)
+	case FormatASS:
+		subs, err = parseASS(text)
+	default:
+		return nil, fmt.Errorf("unknown subtitle format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(subs.Cues) == 0 {
+		return nil, fmt.Errorf("no %s cues found", format)
+	}
+
+	slices.SortStableFunc(subs.Cues, func(a, b Cue) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	return subs, nil
+}
+
+// Write writes subs to w in format.
+func Write(w io.Writer, subs *Subtitles, format Format) error {
+	switch format {
+	case FormatSRT:
+		return writeSRT(w, subs)
+	case FormatVTT:
+		return writeVTT(w, subs)
+	case FormatLRC:
+		return writeLRC(w, subs)
+	case FormatASS:
+		return writeASS(w, subs)
+	default:
+		return fmt.Errorf("unknown subtitle format %q", format)
+	}
+}
+
+// WriteFile writes subs to path, in the format given by its extension.
+func WriteFile(path string, subs *Subtitles) error {
+	format, err := FormatOf(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(file, subs, format); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Transcript returns the words sung between start and end seconds, timed from
+// start as transcripts are. Cues without word timings have their time shared
+// between their words by length.
+func (s *Subtitles) Transcript(start, end float64) *model.Transcript {
+	transcript := &model.Transcript{}
+	for _, cue := range s.Cues {
+		if cue.End <= start || cue.Start >= end {
+			continue
+		}
+
+		words := cue.Words
+		if len(words) == 0 {
+			words = spreadWords(cue)
+		}
+		for _, word := range words {
+			if word.End <= start || word.Start >= end {
+				continue
+			}
+			word.Start = max(word.Start, start) - start
+			word.End = min(word.End, end) - start
+			transcript.Words = append(transcript.Words, word)
+		}
+	}
+	return transcript
+}
+
+// FromTranscript groups the words of transcript into cues of a line of at
+// most maxChars characters each, keeping the words' timings, with offset
+// seconds added to every time.
+func FromTranscript(transcript *model.Transcript, offset float64, maxChars int) *Subtitles {
+	subs := &Subtitles{}
+	var cue Cue
+	flush := func() {
+		if len(cue.Words) > 0 {
+			subs.Cues = append(subs.Cues, cue)
+		}
+		cue = Cue{}
+	}
+
+	for _, word := range transcript.Words {
+		text := strings.TrimSpace(word.Word)
+		if text == "" {
+			continue
+		}
+		if len(cue.Words) > 0 && utf8.RuneCountInString(cue.Text)+1+utf8.RuneCountInString(text) > maxChars {
+			flush()
+		}
+
+		word.Word = text
+		word.Start += offset
+		word.End += offset
+		if len(cue.Words) == 0 {
+			cue.Start = word.Start
+			cue.Text = text
+		} else {
+			cue.Text += " " + text
+		}
+		cue.End = max(cue.End, word.End)
+		cue.Words = append(cue.Words, word)
+	}
+	flush()
+	return subs
+}
+
+// spreadWords splits the time of cue between its words by their length.
+func spreadWords(cue Cue) []model.Word {
+	texts := strings.Fields(cue.Text)
+	if len(texts) == 0 {
+		return nil
+	}
+
+	total := 0
+	for _, text := range texts {
+		total += utf8.RuneCountInString(text)
+	}
+	duration := min(cue.End-cue.Start, maxSpreadWordDuration*float64(len(texts)))
+
+	words := make([]model.Word, len(texts))
+	at := cue.Start
+	for i, text := range texts {
+		length := duration * float64(utf8.RuneCountInString(text)) / float64(total)
+		words[i] = model.Word{Word: text, Start: at, End: at + length}
+		at += length
+	}
+	return words
+}
+
+// parseTimestamp reads [hh:]mm:ss[.fff] or [hh:]mm:ss[,fff] as seconds, which
+// covers the times of every supported format.
+func parseTimestamp(s string) (float64, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+
+	seconds, err := strconv.ParseFloat(strings.Replace(parts[len(parts)-1], ",", ".", 1), 64)
+	if err != nil || seconds < 0 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+	total := seconds
+	unit := 60.0
+	for i := len(parts) - 2; i >= 0; i-- {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid timestamp %q", s)
+		}
+		total += float64(n) * unit
+		unit *= 60
+	}
+	return total, nil
+}
+
+// splitClock splits seconds into hours, minutes, seconds and the fraction of a
+// second in units of 1/perSecond, rounding to the nearest unit first so that
+// 59.999 at centiseconds is a whole minute.
+func splitClock(t float64, perSecond int) (h, m, s, frac int) {
+	total := int(math.Round(max(t, 0) * float64(perSecond)))
+	perMinute := perSecond * 60
+	return total / (perMinute * 60), total / perMinute % 60, total / perSecond % 60, total % perSecond
+}
+
+func joinFormats() string {
+	names := make([]string, len(Formats))
+	for i, format := range Formats {
+		names[i] = string(format)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,290 @@
+package subtitles
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// sung has word timings on the centisecond grid every format can hold, each
+// word lasting until the next is sung.
+var sung = &Subtitles{Cues: []Cue{
+	{Start: 1, End: 2.5, Text: "Yeah I'm tryna", Words: []model.Word{
+		{Word: "Yeah", Start: 1, End: 1.4},
+		{Word: "I'm", Start: 1.4, End: 1.9},
+		{Word: "tryna", Start: 1.9, End: 2.5},
+	}},
+	{Start: 3, End: 4.25, Text: "get it all", Words: []model.Word{
+		{Word: "get", Start: 3, End: 3.3},
+		{Word: "it", Start: 3.3, End: 3.5},
+		{Word: "all", Start: 3.5, End: 4.25},
+	}},
+}}
+
+// plain has no word timings, and a cue of two lines for the formats that
+// keep them.
+var plain = &Subtitles{Cues: []Cue{
+	{Start: 1, End: 2.5, Text: "Line one\nline two"},
+	{Start: 3, End: 4.25, Text: "Get it all"},
+}}
+
+// withoutWords returns subs with their word timings dropped.
+func withoutWords(subs *Subtitles) *Subtitles {
+	out := &Subtitles{Cues: make([]Cue, len(subs.Cues))}
+	for i, cue := range subs.Cues {
+		cue.Words = nil
+		out.Cues[i] = cue
+	}
+	return out
+}
+
+// rounded returns subs with every time rounded to the millisecond, so sums
+// of karaoke durations compare equal to the times they add up to.
+func rounded(subs *Subtitles) *Subtitles {
+	round := func(t float64) float64 { return math.Round(t*1000) / 1000 }
+
+	out := &Subtitles{Cues: make([]Cue, len(subs.Cues))}
+	for i, cue := range subs.Cues {
+		cue.Start, cue.End = round(cue.Start), round(cue.End)
+		if cue.Words != nil {
+			words := make([]model.Word, len(cue.Words))
+			for j, word := range cue.Words {
+				word.Start, word.End = round(word.Start), round(word.End)
+				words[j] = word
+			}
+			cue.Words = words
+		}
+		out.Cues[i] = cue
+	}
+	return out
+}
+
+func parse(t *testing.T, text string, format Format) *Subtitles {
+	t.Helper()
+	subs, err := Parse(strings.NewReader(text), format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rounded(subs)
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		format Format
+		source string
+		want   *Subtitles
+	}{
+		{
+			format: FormatSRT,
+			source: "1\n00:00:01,000 --> 00:00:02,500\n<i>Line one</i>\n  line   two  \n\n" +
+				"2\n00:00:03,000 --> 00:00:04,250\nGet it all\n",
+			want: plain,
+		},
+		{
+			format: FormatVTT,
+			source: "WEBVTT - Lucid Dreams\nKind: captions\n\n" +
+				"NOTE written by hand\n\n" +
+				"STYLE\n::cue { color: white }\n\n" +
+				"intro\n00:01.000 --> 00:02.500 align:center\n<v Juice>Yeah <00:01.400>I'm <00:01.900>tryna</v>\n\n" +
+				"2\n00:00:03.000 --> 00:00:04.250\n<c.lyric>get</c> <00:03.300>it <00:03.500>all\n",
+			want: sung,
+		},
+		{
+			format: FormatVTT,
+			source: "WEBVTT\n\n00:01.000 --> 00:02.500\nLine one\nline two\n\n00:03.000 --> 00:04.250\nGet it all\n",
+			want:   plain,
+		},
+		{
+			format: FormatLRC,
+			source: "[ar:Juice WRLD]\n[ti:Lucid Dreams]\n" +
+				"[00:01.00]Yeah <00:01.40>I'm <00:01.90>tryna\n[00:02.50]\n" +
+				"[00:03:00]get <00:03.30>it <00:03.50>all\n[00:04.25]\n",
+			want: sung,
+		},
+		{
+			format: FormatLRC,
+			source: "[00:01.00]Line one line two\n[00:02.50]\n[00:03.00]Get it all\n[00:04.25]\n",
+			want: &Subtitles{Cues: []Cue{
+				{Start: 1, End: 2.5, Text: "Line one line two"},
+				{Start: 3, End: 4.25, Text: "Get it all"},
+			}},
+		},
+		{
+			format: FormatASS,
+			source: "[Script Info]\nTitle: Lucid Dreams\n\n[Events]\n" +
+				"Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n" +
+				`Dialogue: 0,0:00:01.00,0:00:02.50,Default,,0,0,0,,{\k40}Yeah {\kf50}I'm {\K60}tryna` + "\n" +
+				"Comment: 0,0:00:02.50,0:00:03.00,Default,,0,0,0,,not sung\n" +
+				`Dialogue: 0,0:00:03.00,0:00:04.25,Default,,0,0,0,,{\an5}{\k30}get {\ko20}it {\k75}all` + "\n",
+			want: sung,
+		},
+		{
+			format: FormatASS,
+			source: "[Events]\n" +
+				`Dialogue: 0,0:00:01.00,0:00:02.50,Default,,0,0,0,,{\i1}Line one\Nline two` + "\n" +
+				"Dialogue: 0,0:00:03.00,0:00:04.25,Default,,0,0,0,,Get it all\n",
+			want: plain,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			got := parse(t, test.source, test.format)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("Parse =\n%+v\nwant\n%+v", got, test.want)
+			}
+
+			var out bytes.Buffer
+			if err := Write(&out, got, test.format); err != nil {
+				t.Fatal(err)
+			}
+			if again := parse(t, out.String(), test.format); !reflect.DeepEqual(again, test.want) {
+				t.Errorf("Parse of written\n%s=\n%+v\nwant\n%+v", out.String(), again, test.want)
+			}
+		})
+	}
+}
+
+// TestWriteDropsWhatFormatsCantHold checks SRT loses word timings and LRC
+// puts a cue's lines on one.
+func TestWriteDropsWhatFormatsCantHold(t *testing.T) {
+	tests := []struct {
+		format Format
+		subs   *Subtitles
+		want   *Subtitles
+	}{
+		{format: FormatSRT, subs: sung, want: withoutWords(sung)},
+		{
+			format: FormatLRC,
+			subs:   plain,
+			want: &Subtitles{Cues: []Cue{
+				{Start: 1, End: 2.5, Text: "Line one line two"},
+				{Start: 3, End: 4.25, Text: "Get it all"},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			var out bytes.Buffer
+			if err := Write(&out, test.subs, test.format); err != nil {
+				t.Fatal(err)
+			}
+			if got := parse(t, out.String(), test.format); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse of written\n%s=\n%+v\nwant\n%+v", out.String(), got, test.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	// Windows editors lead with a byte order mark and end lines with \r\n
+	source := "\ufeff2\r\n00:00:03,000 --> 00:00:04,000\r\nsecond\r\n\r\n1\r\n00:00:01,000 --> 00:00:02,000\r\nfirst\r\n"
+	want := &Subtitles{Cues: []Cue{
+		{Start: 1, End: 2, Text: "first"},
+		{Start: 3, End: 4, Text: "second"},
+	}}
+	if got := parse(t, source, FormatSRT); !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		source string
+		want   string
+	}{
+		{name: "no cues", format: FormatSRT, source: "just text\n", want: "no srt cues found"},
+		{name: "ends before it starts", format: FormatSRT, source: "1\n00:00:02,000 --> 00:00:01,000\nback\n", want: "ends before it starts"},
+		{name: "bad timestamp", format: FormatSRT, source: "1\n00:00:aa,000 --> 00:00:01,000\nbad\n", want: "invalid timestamp"},
+		{name: "unknown format", format: "sub", source: "", want: "unknown subtitle format"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(test.source), test.format)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Parse error = %v, want one containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{in: "00:01.5", want: 1.5},
+		{in: "01:02", want: 62},
+		{in: "1:00:00,250", want: 3600.25},
+		{in: " 00:00:03.000 ", want: 3},
+		{in: "75:00.00", want: 4500},
+		{in: "1", wantErr: true},
+		{in: "1:2:3:4", wantErr: true},
+		{in: "aa:01", wantErr: true},
+		{in: "00:-1", wantErr: true},
+		{in: "-1:00", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := parseTimestamp(test.in)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("parseTimestamp(%q) = %g, %v, want %g, error %t", test.in, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func TestClockFormats(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		srt     string
+		vtt     string
+		lrc     string
+		ass     string
+	}{
+		{seconds: 0, srt: "00:00:00,000", vtt: "00:00:00.000", lrc: "00:00.00", ass: "0:00:00.00"},
+		{seconds: -1, srt: "00:00:00,000", vtt: "00:00:00.000", lrc: "00:00.00", ass: "0:00:00.00"},
+		{seconds: 3.454, srt: "00:00:03,454", vtt: "00:00:03.454", lrc: "00:03.45", ass: "0:00:03.45"},
+		{seconds: 3.456, srt: "00:00:03,456", vtt: "00:00:03.456", lrc: "00:03.46", ass: "0:00:03.46"},
+		{seconds: 59.999, srt: "00:00:59,999", vtt: "00:00:59.999", lrc: "01:00.00", ass: "0:01:00.00"},
+		{seconds: 59.9996, srt: "00:01:00,000", vtt: "00:01:00.000", lrc: "01:00.00", ass: "0:01:00.00"},
+		{seconds: 3599.996, srt: "00:59:59,996", vtt: "00:59:59.996", lrc: "60:00.00", ass: "1:00:00.00"},
+		{seconds: 3725.5, srt: "01:02:05,500", vtt: "01:02:05.500", lrc: "62:05.50", ass: "1:02:05.50"},
+	}
+
+	for _, test := range tests {
+		got := [4]string{srtTime(test.seconds), vttTime(test.seconds), lrcTime(test.seconds), assTime(test.seconds)}
+		want := [4]string{test.srt, test.vtt, test.lrc, test.ass}
+		if got != want {
+			t.Errorf("times of %g = %q, want %q", test.seconds, got, want)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Format
+		wantErr bool
+	}{
+		{in: "srt", want: FormatSRT},
+		{in: ".VTT", want: FormatVTT},
+		{in: "Lrc", want: FormatLRC},
+		{in: "ssa", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseFormat(test.in)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("ParseFormat(%q) = %q, %v, want %q, error %t", test.in, got, err, test.want, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package subtitles
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// WebVTT karaoke timestamps, <00:01.500>, giving when the text after them is
+// sung.
+var vttWordTimePattern = regexp.MustCompile(`<((?:\d+:)?\d+:\d+\.\d+)>`)
+
+// parseVTT reads WebVTT, skipping its header and the NOTE, STYLE and REGION
+// blocks. Cues with karaoke timestamps keep the timing of each word.
+func parseVTT(text string) (*Subtitles, error) {
+	blocks := splitBlocks(text)
+	if len(blocks) == 0 || !strings.HasPrefix(blocks[0][0], "WEBVTT") {
+		return nil, errors.New("missing WEBVTT header")
+	}
+
+	subs := &Subtitles{}
+	for _, block := range blocks[1:] {
+		switch strings.Fields(block[0])[0] {
+		case "NOTE", "STYLE", "REGION":
+			continue
+		}
+
+		cue, ok, err := parseCueBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		cue.Words, err = timedWords(cue.Start, cue.End, cue.Text, vttWordTimePattern)
+		if err != nil {
+			return nil, err
+		}
+		cue.Text = cleanText(vttWordTimePattern.ReplaceAllString(markupPattern.ReplaceAllString(cue.Text, ""), ""))
+		subs.Cues = append(subs.Cues, cue)
+	}
+	return subs, nil
+}
+
+// writeVTT writes WebVTT, with karaoke timestamps before each word of cues
+// that have word timings.
+func writeVTT(w io.Writer, subs *Subtitles) error {
+	if _, err := io.WriteString(w, "WEBVTT\n\n"); err != nil {
+		return err
+	}
+	for _, cue := range subs.Cues {
+		text := cue.Text
+		if len(cue.Words) > 0 {
+			text = joinTimedWords(cue, func(t float64) string { return "<" + vttTime(t) + ">" })
+		}
+		if _, err := fmt.Fprintf(w, "%s --> %s\n%s\n\n", vttTime(cue.Start), vttTime(cue.End), text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// timedWords reads the words of text timed by the timestamps pattern matches,
+// each word starting at the timestamp before it, or start for those before
+// the first, and ending when the next word starts or at end. Text without
+// timestamps has no word timings.
+func timedWords(start, end float64, text string, pattern *regexp.Regexp) ([]model.Word, error) {
+	text = markupPattern.ReplaceAllString(text, "")
+	matches := pattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	var words []model.Word
+	at := start
+	add := func(segment string) {
+		for _, field := range strings.Fields(segment) {
+			words = append(words, model.Word{Word: field, Start: at})
+		}
+	}
+
+	add(text[:matches[0][0]])
+	for i, match := range matches {
+		t, err := parseTimestamp(text[match[2]:match[3]])
+		if err != nil {
+			return nil, err
+		}
+		at = t
+
+		segmentEnd := len(text)
+		if i+1 < len(matches) {
+			segmentEnd = matches[i+1][0]
+		}
+		add(text[match[1]:segmentEnd])
+	}
+
+	for i := range words {
+		words[i].End = end
+		if i+1 < len(words) {
+			words[i].End = max(words[i].Start, words[i+1].Start)
+		}
+	}
+	return words, nil
+}
+
+// joinTimedWords writes the words of cue on one line, each led by its start
+// formatted with stamp.
+func joinTimedWords(cue Cue, stamp func(float64) string) string {
+	parts := make([]string, len(cue.Words))
+	for i, word := range cue.Words {
+		parts[i] = stamp(word.Start) + strings.TrimSpace(word.Word)
+	}
+	return strings.Join(parts, " ")
+}
+
+// vttTime formats seconds as HH:MM:SS.mmm.
+func vttTime(t float64) string {
+	h, m, s, ms := splitClock(t, 1000)
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", h, m, s, ms)
+}
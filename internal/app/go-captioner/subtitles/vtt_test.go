@@ -0,0 +1,71 @@
+package subtitles
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func TestParseVTT(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []Cue
+	}{
+		{
+			name:   "header text and metadata",
+			source: "WEBVTT Lucid Dreams\nKind: captions\nLanguage: en\n\n00:01.000 --> 00:02.000\nYeah\n",
+			want:   []Cue{{Start: 1, End: 2, Text: "Yeah"}},
+		},
+		{
+			name:   "cue ids",
+			source: "WEBVTT\n\nchorus-1\n00:01.000 --> 00:02.000\nYeah\n\n2\n00:02.000 --> 00:03.000\nbaby\n",
+			want:   []Cue{{Start: 1, End: 2, Text: "Yeah"}, {Start: 2, End: 3, Text: "baby"}},
+		},
+		{
+			name: "note, style and region blocks",
+			source: "WEBVTT\n\nNOTE\n00:09.000 --> 00:10.000 is not a cue\n\n" +
+				"REGION\nid:top\n\nSTYLE\n::cue { color: red }\n\n00:01.000 --> 00:02.000\nYeah\n",
+			want: []Cue{{Start: 1, End: 2, Text: "Yeah"}},
+		},
+		{
+			name:   "blocks without a timing line",
+			source: "WEBVTT\n\nstray text\n\n00:01.000 --> 00:02.000 line:90% align:start\nYeah\n",
+			want:   []Cue{{Start: 1, End: 2, Text: "Yeah"}},
+		},
+		{
+			name:   "markup",
+			source: "WEBVTT\n\n00:01.000 --> 00:02.000\n<v.loud Juice WRLD><b>Yeah</b>\n<i>baby</i></v>\n",
+			want:   []Cue{{Start: 1, End: 2, Text: "Yeah\nbaby"}},
+		},
+		{
+			name:   "words before the first karaoke timestamp start with the cue",
+			source: "WEBVTT\n\n01:00:01.000 --> 01:00:03.000\nYeah I'm <01:00:02.000>tryna\n",
+			want: []Cue{{Start: 3601, End: 3603, Text: "Yeah I'm tryna", Words: []model.Word{
+				{Word: "Yeah", Start: 3601, End: 3601},
+				{Word: "I'm", Start: 3601, End: 3602},
+				{Word: "tryna", Start: 3602, End: 3603},
+			}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parse(t, test.source, FormatVTT)
+			if !reflect.DeepEqual(got.Cues, test.want) {
+				t.Errorf("cues = %+v, want %+v", got.Cues, test.want)
+			}
+		})
+	}
+}
+
+func TestParseVTTRequiresHeader(t *testing.T) {
+	for _, source := range []string{"", "00:01.000 --> 00:02.000\nYeah\n", "1\n00:00:01,000 --> 00:00:02,000\nYeah\n"} {
+		_, err := Parse(strings.NewReader(source), FormatVTT)
+		if err == nil || !strings.Contains(err.Error(), "missing WEBVTT header") {
+			t.Errorf("Parse(%q) error = %v, want the missing header", source, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	id3HeaderSize = 10
+	id3v1Size     = 128
+
+	id3FlagUnsynchronised = 0x80
+	id3FlagExtendedHeader = 0x40
+
+	// Frame flags, which moved between versions
+	id3v23FrameCompressed      = 0x0080
+	id3v23FrameEncrypted       = 0x0040
+	id3v23FrameGrouped         = 0x0020
+	id3v24FrameGrouped         = 0x0040
+	id3v24FrameCompressed      = 0x0008
+	id3v24FrameEncrypted       = 0x0004
+	id3v24FrameUnsynchronised  = 0x0002
+	id3v24FrameDataLengthGiven = 0x0001
+)
+
+// id3Frames maps the frame IDs of ID3v2.2 and later to the field they set.
+// The album artist only stands in for a missing artist.
+var id3Frames = map[string]func(t *Tags) *string{
+	"TPE1": func(t *Tags) *string { return &t.Artist },
+	"TP1":  func(t *Tags) *string { return &t.Artist },
+	"TIT2": func(t *Tags) *string { return &t.Title },
+	"TT2":  func(t *Tags) *string { return &t.Title },
+	"TALB": func(t *Tags) *string { return &t.Album },
+	"TAL":  func(t *Tags) *string { return &t.Album },
+}
+
+var id3AlbumArtistFrames = []string{"TPE2", "TP2"}
+
+// readID3v2 reads the ID3v2.2, 2.3 or 2.4 tag at the start of r.
+func readID3v2(r io.Reader) (*Tags, error) {
+	header := make([]byte, id3HeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrNoTags
+	}
+	version := header[3]
+	flags := header[5]
+	if version < 2 || version > 4 {
+		return nil, ErrNoTags
+	}
+
+	body := make([]byte, syncsafe(header[6:10]))
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, ErrNoTags
+	}
+
+	// Before 2.4 unsynchronisation applies to the whole tag at once
+	if flags&id3FlagUnsynchronised != 0 && version < 4 {
+		body = resync(body)
+	}
+
+	if flags&id3FlagExtendedHeader != 0 && version > 2 {
+		if len(body) < 4 {
+			return nil, ErrNoTags
+		}
+		// 2.3 doesn't count the size field itself, 2.4 does
+		size := int(binary.BigEndian.Uint32(body))
+		if version == 3 {
+			size += 4
+		} else {
+			size = syncsafe(body[:4])
+		}
+		if size > len(body) {
+			return nil, ErrNoTags
+		}
+		body = body[size:]
+	}
+
+	idSize, headerSize := 4, 10
+	if version == 2 {
+		idSize, headerSize = 3, 6
+	}
+
+	tags := &Tags{}
+	var albumArtist string
+	for len(body) >= headerSize && body[0] != 0 {
+		id := string(body[:idSize])
+
+		var size int
+		var frameFlags uint16
+		switch version {
+		case 2:
+			size = int(body[3])<<16 | int(body[4])<<8 | int(body[5])
+		case 3:
+			size = int(binary.BigEndian.Uint32(body[4:8]))
+			frameFlags = binary.BigEndian.Uint16(body[8:10])
+		default:
+			size = syncsafe(body[4:8])
+			frameFlags = binary.BigEndian.Uint16(body[8:10])
+		}
+		if size > len(body)-headerSize {
+			break
+		}
+		data := body[headerSize : headerSize+size]
+		body = body[headerSize+size:]
+
+		data, ok := id3FrameData(data, version, frameFlags)
+		if !ok {
+			continue
+		}
+
+		if field, ok := id3Frames[id]; ok {
+			*field(tags) = id3Text(data)
+		} else if slices.Contains(id3AlbumArtistFrames, id) {
+			albumArtist = id3Text(data)
+		}
+	}
+
+	if tags.Artist == "" {
+		tags.Artist = albumArtist
+	}
+	return tags, nil
+}
+
+// id3FrameData strips what the frame flags add around a frame's data,
+// returning false for frames that can't be read without more work than tags
+// are worth, such as compressed ones.
+func id3FrameData(data []byte, version byte, flags uint16) ([]byte, bool) {
+	switch version {
+	case 3:
+		if flags&(id3v23FrameCompressed|id3v23FrameEncrypted) != 0 {
+			return nil, false
+		}
+		if flags&id3v23FrameGrouped != 0 {
+			if len(data) < 1 {
+				return nil, false
+			}
+			data = data[1:]
+		}
+	case 4:
+		if flags&(id3v24FrameCompressed|id3v24FrameEncrypted) != 0 {
+			return nil, false
+		}
+		if flags&id3v24FrameGrouped != 0 {
+			if len(data) < 1 {
+				return nil, false
+			}
+			data = data[1:]
+		}
+		if flags&id3v24FrameDataLengthGiven != 0 {
+			if len(data) < 4 {
+				return nil, false
+			}
+			data = data[4:]
+		}
+		if flags&id3v24FrameUnsynchronised != 0 {
+			data = resync(data)
+		}
+	}
+	return data, true
+}
+
+// id3Text decodes a text frame. Frames holding several values, as 2.4 allows,
+// are joined with commas.
+func id3Text(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	var values []string
+	encoding, text := data[0], data[1:]
+	switch encoding {
+	case 1, 2:
+		values = strings.Split(decodeUTF16(text, encoding == 2), "\x00")
+	case 3:
+		values = strings.Split(string(text), "\x00")
+	default:
+		values = strings.Split(decodeLatin1(text), "\x00")
+	}
+
+	var nonEmpty []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
+
+// decodeUTF16 decodes UTF-16 text, following any byte order marks and
+// otherwise reading it as big endian when bigEndian is set.
+func decodeUTF16(data []byte, bigEndian bool) string {
+	var units []uint16
+	for i := 0; i+1 < len(data); i += 2 {
+		switch {
+		case data[i] == 0xff && data[i+1] == 0xfe:
+			bigEndian = false
+			continue
+		case data[i] == 0xfe && data[i+1] == 0xff:
+			bigEndian = true
+			continue
+		}
+
+		if bigEndian {
+			units = append(units, binary.BigEndian.Uint16(data[i:]))
+		} else {
+			units = append(units, binary.LittleEndian.Uint16(data[i:]))
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+func decodeLatin1(data []byte) string {
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// readID3v1 reads the fixed size ID3v1 tag at the end of r.
+func readID3v1(r io.ReadSeeker) (*Tags, error) {
+	if _, err := r.Seek(-id3v1Size, io.SeekEnd); err != nil {
+		return nil, ErrNoTags
+	}
+	tag := make([]byte, id3v1Size)
+	if _, err := io.ReadFull(r, tag); err != nil || !bytes.HasPrefix(tag, []byte("TAG")) {
+		return nil, ErrNoTags
+	}
+
+	field := func(b []byte) string {
+		if i := bytes.IndexByte(b, 0); i >= 0 {
+			b = b[:i]
+		}
+		return strings.TrimSpace(decodeLatin1(b))
+	}
+	return &Tags{
+		Title:  field(tag[3:33]),
+		Artist: field(tag[33:63]),
+		Album:  field(tag[63:93]),
+	}, nil
+}
+
+// syncsafe decodes a size stored seven bits to a byte.
+func syncsafe(b []byte) int {
+	var size int
+	for _, v := range b {
+		size = size<<7 | int(v&0x7f)
+	}
+	return size
+}
+
+// resync undoes unsynchronisation, which follows every 0xff with a zero byte.
+func resync(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{0xff, 0x00}, []byte{0xff})
+}
@@ -0,0 +1,299 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+// id3v2 builds an ID3v2 tag of frames with a syncsafe size.
+func id3v2(version, flags byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	if flags&id3FlagUnsynchronised != 0 && version < 4 {
+		body = unsync(body)
+	}
+	return append(append([]byte{'I', 'D', '3', version, 0, flags}, syncsafeBytes(len(body))...), body...)
+}
+
+// id3Frame builds a frame with the header of version.
+func id3Frame(version byte, id string, flags uint16, data []byte) []byte {
+	frame := []byte(id)
+	switch version {
+	case 2:
+		frame = append(frame, byte(len(data)>>16), byte(len(data)>>8), byte(len(data)))
+	case 3:
+		frame = binary.BigEndian.AppendUint32(frame, uint32(len(data)))
+		frame = binary.BigEndian.AppendUint16(frame, flags)
+	default:
+		frame = append(frame, syncsafeBytes(len(data))...)
+		frame = binary.BigEndian.AppendUint16(frame, flags)
+	}
+	return append(frame, data...)
+}
+
+// id3v1 builds the 128 byte tag, padding fields with zeros.
+func id3v1(title, artist, album string) []byte {
+	tag := make([]byte, id3v1Size)
+	copy(tag, "TAG")
+	copy(tag[3:33], title)
+	copy(tag[33:63], artist)
+	copy(tag[63:93], album)
+	return tag
+}
+
+func syncsafeBytes(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7f), byte(n >> 14 & 0x7f), byte(n >> 7 & 0x7f), byte(n & 0x7f)}
+}
+
+// unsync follows every 0xff with a zero byte.
+func unsync(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{0xff}, []byte{0xff, 0x00})
+}
+
+func latin1(s string) []byte {
+	data := []byte{0}
+	for _, r := range s {
+		data = append(data, byte(r))
+	}
+	return data
+}
+
+func utf8Text(s string) []byte {
+	return append([]byte{3}, s...)
+}
+
+// utf16Text encodes s with a byte order mark as encoding 1, or as big endian
+// without one as encoding 2.
+func utf16Text(s string, encoding byte, order binary.AppendByteOrder, bom bool) []byte {
+	data := []byte{encoding}
+	if bom {
+		data = order.AppendUint16(data, 0xfeff)
+	}
+	for _, unit := range utf16.Encode([]rune(s)) {
+		data = order.AppendUint16(data, unit)
+	}
+	return data
+}
+
+func TestReadID3v2(t *testing.T) {
+	tests := []struct {
+		name string
+		file []byte
+		want *Tags
+	}{
+		{
+			name: "2.2",
+			file: id3v2(2, 0,
+				id3Frame(2, "TP1", 0, latin1("Juice WRLD")),
+				id3Frame(2, "TT2", 0, latin1("Lucid Dreams")),
+				id3Frame(2, "TAL", 0, latin1("Goodbye & Good Riddance")),
+			),
+			want: &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams", Album: "Goodbye & Good Riddance"},
+		},
+		{
+			name: "latin-1",
+			file: id3v2(3, 0, id3Frame(3, "TPE1", 0, latin1("Beyoncé"))),
+			want: &Tags{Artist: "Beyoncé"},
+		},
+		{
+			name: "utf-16 little endian with a byte order mark",
+			file: id3v2(3, 0, id3Frame(3, "TPE1", 0, utf16Text("Beyoncé", 1, binary.LittleEndian, true))),
+			want: &Tags{Artist: "Beyoncé"},
+		},
+		{
+			name: "utf-16 big endian with a byte order mark",
+			file: id3v2(3, 0, id3Frame(3, "TIT2", 0, utf16Text("Ｌｕｃｉｄ 🌙", 1, binary.BigEndian, true))),
+			want: &Tags{Title: "Ｌｕｃｉｄ 🌙"},
+		},
+		{
+			name: "utf-16 big endian without a byte order mark",
+			file: id3v2(4, 0, id3Frame(4, "TIT2", 0, utf16Text("Lucid Dreams", 2, binary.BigEndian, false))),
+			want: &Tags{Title: "Lucid Dreams"},
+		},
+		{
+			name: "utf-16 without a byte order mark read as little endian",
+			file: id3v2(3, 0, id3Frame(3, "TIT2", 0, utf16Text("Lucid", 1, binary.LittleEndian, false))),
+			want: &Tags{Title: "Lucid"},
+		},
+		{
+			name: "several values",
+			file: id3v2(4, 0, id3Frame(4, "TPE1", 0, utf8Text("Juice WRLD\x00Halsey\x00"))),
+			want: &Tags{Artist: "Juice WRLD, Halsey"},
+		},
+		{
+			name: "album artist stands in for a missing artist",
+			file: id3v2(3, 0, id3Frame(3, "TPE2", 0, latin1("Various")), id3Frame(3, "TALB", 0, latin1("Hits"))),
+			want: &Tags{Artist: "Various", Album: "Hits"},
+		},
+		{
+			name: "album artist doesn't replace the artist",
+			file: id3v2(3, 0, id3Frame(3, "TPE2", 0, latin1("Various")), id3Frame(3, "TPE1", 0, latin1("Juice WRLD"))),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "whole tag unsynchronised",
+			file: id3v2(3, id3FlagUnsynchronised,
+				id3Frame(3, "TIT2", 0, latin1("ÿes ÿes")),
+				id3Frame(3, "TPE1", 0, latin1("Juice WRLD")),
+			),
+			want: &Tags{Artist: "Juice WRLD", Title: "ÿes ÿes"},
+		},
+		{
+			name: "frame unsynchronised with its data length given",
+			file: id3v2(4, 0,
+				id3Frame(4, "TIT2", id3v24FrameUnsynchronised|id3v24FrameDataLengthGiven,
+					append(syncsafeBytes(len(latin1("ÿes"))), unsync(latin1("ÿes"))...)),
+				id3Frame(4, "TPE1", 0, latin1("Juice WRLD")),
+			),
+			want: &Tags{Artist: "Juice WRLD", Title: "ÿes"},
+		},
+		{
+			name: "2.3 extended header",
+			file: append([]byte("ID3\x03\x00\x40"), append(syncsafeBytes(10+10+len(latin1("Lucid"))),
+				append([]byte{0, 0, 0, 6, 0, 0, 0, 0, 0, 0}, id3Frame(3, "TIT2", 0, latin1("Lucid"))...)...)...),
+			want: &Tags{Title: "Lucid"},
+		},
+		{
+			name: "2.4 extended header",
+			file: append([]byte("ID3\x04\x00\x40"), append(syncsafeBytes(6+10+len(latin1("Lucid"))),
+				append([]byte{0, 0, 0, 6, 1, 0}, id3Frame(4, "TIT2", 0, latin1("Lucid"))...)...)...),
+			want: &Tags{Title: "Lucid"},
+		},
+		{
+			name: "compressed and encrypted frames skipped",
+			file: id3v2(3, 0,
+				id3Frame(3, "TPE1", id3v23FrameCompressed, latin1("zipped")),
+				id3Frame(3, "TIT2", id3v23FrameEncrypted, latin1("secret")),
+				id3Frame(3, "TALB", 0, latin1("Hits")),
+			),
+			want: &Tags{Album: "Hits"},
+		},
+		{
+			name: "grouped frames",
+			file: id3v2(4, 0,
+				id3Frame(4, "TPE1", id3v24FrameGrouped, append([]byte{7}, latin1("Juice WRLD")...)),
+				id3Frame(4, "TALB", id3v24FrameCompressed, latin1("zipped")),
+			),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "padding ends the frames",
+			file: id3v2(3, 0, id3Frame(3, "TPE1", 0, latin1("Juice WRLD")), make([]byte, 64)),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "frame running past the tag",
+			file: id3v2(3, 0,
+				id3Frame(3, "TPE1", 0, latin1("Juice WRLD")),
+				id3Frame(3, "TIT2", 0, latin1("Lucid Dreams"))[:14],
+			),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "frame with a huge size",
+			file: id3v2(3, 0,
+				id3Frame(3, "TPE1", 0, latin1("Juice WRLD")),
+				[]byte{'T', 'I', 'T', '2', 0xff, 0xff, 0xff, 0xff, 0, 0, 0},
+			),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "empty frame",
+			file: id3v2(3, 0, id3Frame(3, "TIT2", 0, nil), id3Frame(3, "TPE1", 0, latin1("Juice WRLD"))),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := read(bytes.NewReader(test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("read = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReadID3v1Merge(t *testing.T) {
+	audio := bytes.Repeat([]byte{0xff, 0xfb}, 100)
+
+	tests := []struct {
+		name string
+		file []byte
+		want *Tags
+	}{
+		{
+			name: "fills what ID3v2 left out",
+			file: bytes.Join([][]byte{
+				id3v2(3, 0, id3Frame(3, "TPE1", 0, latin1("Juice WRLD"))),
+				audio,
+				id3v1("Lucid Dreams (v1)", "Someone Else", "Goodbye"),
+			}, nil),
+			want: &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams (v1)", Album: "Goodbye"},
+		},
+		{
+			name: "stands in for an unreadable ID3v2 tag",
+			file: bytes.Join([][]byte{[]byte("ID3\x09\x00\x00\x00\x00\x00\x00"), audio, id3v1("Lucid Dreams", "Juice WRLD", "")}, nil),
+			want: &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams"},
+		},
+		{
+			name: "without ID3v2, fields trimmed",
+			file: append(audio, id3v1("Lucid Dreams   ", "Beyonc\xe9", "")...),
+			want: &Tags{Artist: "Beyoncé", Title: "Lucid Dreams"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := read(bytes.NewReader(test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("read = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReadID3v2Truncated(t *testing.T) {
+	// The header claims more than the file holds, and there's no ID3v1 tag
+	file := id3v2(3, 0, id3Frame(3, "TPE1", 0, latin1("Juice WRLD")))
+	if _, err := read(bytes.NewReader(file[:len(file)-4])); !errors.Is(err, ErrNoTags) {
+		t.Errorf("read error = %v, want ErrNoTags", err)
+	}
+}
+
+func TestSyncsafe(t *testing.T) {
+	tests := []struct {
+		in   []byte
+		want int
+	}{
+		{in: []byte{0, 0, 0, 0x7f}, want: 127},
+		{in: []byte{0, 0, 1, 0}, want: 128},
+		{in: []byte{0, 0, 2, 1}, want: 257},
+		{in: []byte{0x7f, 0x7f, 0x7f, 0x7f}, want: 1<<28 - 1},
+		// The high bit of each byte is never set and is ignored if it is
+		{in: []byte{0x80, 0x80, 0x81, 0x80}, want: 128},
+	}
+
+	for _, test := range tests {
+		if got := syncsafe(test.in); got != test.want {
+			t.Errorf("syncsafe(% x) = %d, want %d", test.in, got, test.want)
+		}
+	}
+}
+
+func TestResync(t *testing.T) {
+	got := resync([]byte{0xff, 0x00, 0xe0, 0xff, 0x00, 0x00, 0xff})
+	want := []byte{0xff, 0xe0, 0xff, 0x00, 0xff}
+	if !bytes.Equal(got, want) {
+		t.Errorf("resync = % x, want % x", got, want)
+	}
+}
@@ -0,0 +1,174 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+const (
+	mp4AtomHeaderSize = 8
+	// Tags are small, larger ilst atoms are taken to be damaged rather than
+	// read into memory.
+	maxMP4TagSize = 16 << 20
+)
+
+// mp4Items maps the iTunes metadata items to the field they set.
+var mp4Items = map[string]func(t *Tags) *string{
+	"\xa9ART": func(t *Tags) *string { return &t.Artist },
+	"\xa9nam": func(t *Tags) *string { return &t.Title },
+	"\xa9alb": func(t *Tags) *string { return &t.Album },
+}
+
+// readMP4 reads the iTunes style metadata at moov/udta/meta/ilst, seeking past
+// the atoms in between so the media data is never read.
+func readMP4(r io.ReadSeeker) (*Tags, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	moov, err := findMP4Atom(r, 0, end, "moov")
+	if err != nil {
+		return nil, err
+	}
+
+	// Most files keep meta under udta, some straight under moov
+	meta, err := findMP4Path(r, moov, "udta", "meta")
+	if err != nil {
+		meta, err = findMP4Atom(r, moov.start, moov.end, "meta")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// meta is a full atom with a version and flags before its children,
+	// except in QuickTime files
+	metaStart := meta.start
+	peek := make([]byte, 8)
+	if _, err := r.Seek(meta.start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, peek); err != nil {
+		return nil, ErrNoTags
+	}
+	if string(peek[4:8]) != "hdlr" {
+		metaStart += 4
+	}
+
+	ilst, err := findMP4Atom(r, metaStart, meta.end, "ilst")
+	if err != nil {
+		return nil, err
+	}
+	if ilst.end-ilst.start > maxMP4TagSize {
+		return nil, ErrNoTags
+	}
+
+	data := make([]byte, ilst.end-ilst.start)
+	if _, err := r.Seek(ilst.start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, ErrNoTags
+	}
+
+	tags := &Tags{}
+	var albumArtist string
+	for len(data) >= mp4AtomHeaderSize {
+		size := int(binary.BigEndian.Uint32(data))
+		if size < mp4AtomHeaderSize || size > len(data) {
+			break
+		}
+		name := string(data[4:8])
+		value := mp4ItemText(data[mp4AtomHeaderSize:size])
+		data = data[size:]
+
+		if field, ok := mp4Items[name]; ok {
+			*field(tags) = value
+		} else if name == "aART" {
+			albumArtist = value
+		}
+	}
+
+	if tags.Artist == "" {
+		tags.Artist = albumArtist
+	}
+	return tags, nil
+}
+
+// mp4ItemText returns the text of the data atom inside a metadata item, or
+// nothing if it holds something else, such as cover art.
+func mp4ItemText(item []byte) string {
+	const (
+		dataHeaderSize = 16
+		typeUTF8       = 1
+	)
+	if len(item) < dataHeaderSize || string(item[4:8]) != "data" {
+		return ""
+	}
+	size := int(binary.BigEndian.Uint32(item))
+	if size < dataHeaderSize || size > len(item) {
+		return ""
+	}
+	if binary.BigEndian.Uint32(item[8:12])&0xffffff != typeUTF8 {
+		return ""
+	}
+	return strings.TrimSpace(string(bytes.TrimRight(item[dataHeaderSize:size], "\x00")))
+}
+
+// mp4Atom is the position of an atom's contents, after its header.
+type mp4Atom struct {
+	start int64
+	end   int64
+}
+
+// findMP4Path follows names down from parent.
+func findMP4Path(r io.ReadSeeker, parent mp4Atom, names ...string) (mp4Atom, error) {
+	atom := parent
+	for _, name := range names {
+		var err error
+		if atom, err = findMP4Atom(r, atom.start, atom.end, name); err != nil {
+			return mp4Atom{}, err
+		}
+	}
+	return atom, nil
+}
+
+// findMP4Atom finds the first atom called name between start and end.
+func findMP4Atom(r io.ReadSeeker, start, end int64, name string) (mp4Atom, error) {
+	header := make([]byte, mp4AtomHeaderSize)
+	for offset := start; offset+mp4AtomHeaderSize <= end; {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return mp4Atom{}, err
+		}
+		if _, err := io.ReadFull(r, header); err != nil {
+			return mp4Atom{}, ErrNoTags
+		}
+
+		size := int64(binary.BigEndian.Uint32(header))
+		headerSize := int64(mp4AtomHeaderSize)
+		switch size {
+		case 0:
+			// Runs to the end of its parent
+			size = end - offset
+		case 1:
+			// A 64 bit size follows the name
+			large := make([]byte, 8)
+			if _, err := io.ReadFull(r, large); err != nil {
+				return mp4Atom{}, ErrNoTags
+			}
+			size = int64(binary.BigEndian.Uint64(large))
+			headerSize += 8
+		}
+		if size < headerSize || offset+size > end {
+			return mp4Atom{}, ErrNoTags
+		}
+
+		if string(header[4:8]) == name {
+			return mp4Atom{start: offset + headerSize, end: offset + size}, nil
+		}
+		offset += size
+	}
+	return mp4Atom{}, ErrNoTags
+}
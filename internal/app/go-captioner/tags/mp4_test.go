@@ -0,0 +1,153 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// atom builds an atom of name holding contents.
+func atom(name string, contents ...[]byte) []byte {
+	body := bytes.Join(contents, nil)
+	return append(append(binary.BigEndian.AppendUint32(nil, uint32(mp4AtomHeaderSize+len(body))), name...), body...)
+}
+
+// mp4Data builds the data atom of an item, with the type of its value.
+func mp4Data(kind uint32, value []byte) []byte {
+	return atom("data", binary.BigEndian.AppendUint32(nil, kind), make([]byte, 4), value)
+}
+
+func mp4Item(name, value string) []byte {
+	return atom(name, mp4Data(1, []byte(value)))
+}
+
+// mp4File builds a file of media data followed by a moov atom holding the
+// metadata atoms in its udta.
+func mp4File(udta ...[]byte) []byte {
+	return bytes.Join([][]byte{
+		atom("ftyp", []byte("M4A \x00\x00\x00\x00M4A isom")),
+		atom("mdat", bytes.Repeat([]byte{0xde, 0xad}, 50)),
+		atom("moov", atom("mvhd", make([]byte, 100)), atom("udta", udta...)),
+	}, nil)
+}
+
+// mp4Meta builds a meta atom, a full atom with a version and flags, holding
+// an ilst of items.
+func mp4Meta(items ...[]byte) []byte {
+	return atom("meta", []byte{0, 0, 0, 0}, atom("hdlr", make([]byte, 25)), atom("ilst", items...))
+}
+
+func TestReadMP4(t *testing.T) {
+	items := [][]byte{
+		mp4Item("\xa9ART", "Juice WRLD"),
+		mp4Item("\xa9nam", "Lucid Dreams"),
+		mp4Item("\xa9alb", "Goodbye & Good Riddance"),
+	}
+	all := &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams", Album: "Goodbye & Good Riddance"}
+
+	tests := []struct {
+		name string
+		file []byte
+		want *Tags
+		err  error
+	}{
+		{
+			name: "udta meta",
+			file: mp4File(atom("\xa9too", []byte("Lavf")), mp4Meta(items...)),
+			want: all,
+		},
+		{
+			name: "meta straight under moov",
+			file: append(atom("ftyp", []byte("M4A ")), atom("moov", mp4Meta(items...))...),
+			want: all,
+		},
+		{
+			name: "QuickTime meta without a version",
+			file: mp4File(atom("meta", atom("hdlr", make([]byte, 25)), atom("ilst", items...))),
+			want: all,
+		},
+		{
+			name: "album artist stands in for a missing artist",
+			file: mp4File(mp4Meta(mp4Item("aART", "Various"), mp4Item("\xa9nam", "Lucid Dreams"))),
+			want: &Tags{Artist: "Various", Title: "Lucid Dreams"},
+		},
+		{
+			name: "cover art and other atoms skipped",
+			file: mp4File(mp4Meta(
+				atom("covr", mp4Data(13, []byte{0xff, 0xd8, 0xff})),
+				atom("\xa9ART", atom("mean", []byte("com.apple.iTunes"))),
+				atom("\xa9nam", mp4Data(1, []byte("Lucid Dreams\x00\x00"))),
+			)),
+			want: &Tags{Title: "Lucid Dreams"},
+		},
+		{
+			name: "64 bit atom size",
+			file: bytes.Join([][]byte{
+				atom("ftyp", []byte("M4A ")),
+				binary.BigEndian.AppendUint64(append(binary.BigEndian.AppendUint32(nil, 1), "mdat"...), 16+4),
+				[]byte("data"),
+				atom("moov", atom("udta", mp4Meta(items[0]))),
+			}, nil),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "atom running to the end of the file",
+			file: append(atom("ftyp", []byte("M4A ")), append([]byte{0, 0, 0, 0}, atom("moov", atom("udta", mp4Meta(items[0])))[4:]...)...),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "item running past the ilst",
+			file: mp4File(mp4Meta(items[0], items[1][:len(items[1])-3])),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "data atom running past its item",
+			file: mp4File(mp4Meta(items[0], atom("\xa9nam", mp4Data(1, []byte("Lucid"))[:20]))),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "no moov",
+			file: append(atom("ftyp", []byte("M4A ")), atom("mdat", []byte("audio"))...),
+			err:  ErrNoTags,
+		},
+		{
+			name: "no ilst",
+			file: mp4File(atom("meta", []byte{0, 0, 0, 0}, atom("hdlr", make([]byte, 25)))),
+			err:  ErrNoTags,
+		},
+		{
+			name: "moov running past the file",
+			file: mp4File(mp4Meta(items...))[:200],
+			err:  ErrNoTags,
+		},
+		{
+			name: "atom too small for its header",
+			file: append(atom("ftyp", []byte("M4A ")), 0, 0, 0, 4, 'm', 'o', 'o', 'v'),
+			err:  ErrNoTags,
+		},
+		{
+			// Large enough that adding it to its offset overflows
+			name: "64 bit size overflowing",
+			file: append(atom("ftyp", []byte("M4A ")),
+				binary.BigEndian.AppendUint64(append(binary.BigEndian.AppendUint32(nil, 1), "moov"...), 1<<63-1)...),
+			err: ErrNoTags,
+		},
+		{
+			name: "ilst with a 64 bit size overflowing",
+			file: mp4File(atom("meta", []byte{0, 0, 0, 0},
+				binary.BigEndian.AppendUint64(append(binary.BigEndian.AppendUint32(nil, 1), "ilst"...), 1<<63-1))),
+			err: ErrNoTags,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := read(bytes.NewReader(test.file))
+			if !errors.Is(err, test.err) || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("read = %+v, %v, want %+v, %v", got, err, test.want, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Package tags reads the artist, title and album embedded in audio files.
+package tags
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrNoTags is returned for files in a format that can't carry tags, or that
+// are too damaged to find them.
+var ErrNoTags = errors.New("no supported tags found")
+
+// Tags are the fields read from a file, empty when the file doesn't set them.
+type Tags struct {
+	Artist string
+	Title  string
+	Album  string
+}
+
+func (t *Tags) empty() bool {
+	return t.Artist == "" && t.Title == "" && t.Album == ""
+}
+
+// merge fills the fields of t that are empty from other.
+func (t *Tags) merge(other *Tags) {
+	if t.Artist == "" {
+		t.Artist = other.Artist
+	}
+	if t.Title == "" {
+		t.Title = other.Title
+	}
+	if t.Album == "" {
+		t.Album = other.Album
+	}
+}
+
+// Read returns the tags of the audio file at path, recognising ID3 tagged
+// MP3s, FLAC, Ogg Vorbis and Opus and MP4 audio by their contents rather than
+// their extension.
+func Read(path string) (*Tags, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	tags, err := read(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading tags of %s: %w", path, err)
+	}
+	return tags, nil
+}
+
+func read(r io.ReadSeeker) (*Tags, error) {
+	magic := make([]byte, 12)
+	n, err := io.ReadFull(r, magic)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, ErrNoTags
+	}
+	magic = magic[:n]
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var tags *Tags
+	switch {
+	case bytes.HasPrefix(magic, []byte("ID3")):
+		tags, err = readID3v2(r)
+		// An ID3v1 tag at the end fills in anything the ID3v2 tag left out
+		if v1, errV1 := readID3v1(r); errV1 == nil {
+			if tags == nil {
+				tags, err = v1, nil
+			} else {
+				tags.merge(v1)
+			}
+		}
+	case bytes.HasPrefix(magic, []byte("fLaC")):
+		tags, err = readFLAC(r)
+	case bytes.HasPrefix(magic, []byte("OggS")):
+		tags, err = readOgg(r)
+	case len(magic) >= 8 && string(magic[4:8]) == "ftyp":
+		tags, err = readMP4(r)
+	default:
+		tags, err = readID3v1(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tags.empty() {
+		return nil, ErrNoTags
+	}
+	return tags, nil
+}
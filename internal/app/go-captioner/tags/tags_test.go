@@ -0,0 +1,73 @@
+package tags
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// fixtures are whole files of each format read by TestReadDamaged.
+var fixtures = map[string][]byte{
+	"id3v2.3": id3v2(3, 0, id3Frame(3, "TPE1", 0, latin1("Juice WRLD")), id3Frame(3, "TIT2", 0, latin1("Lucid Dreams"))),
+	"id3v2.4": id3v2(4, 0, id3Frame(4, "TPE1", 0, utf8Text("Juice WRLD")), id3Frame(4, "TALB", 0, utf8Text("Goodbye & Good Riddance"))),
+	"id3v1":   append([]byte("audio"), id3v1("Lucid Dreams", "Juice WRLD", "")...),
+	"flac":    flac(flacBlock(0, false, make([]byte, 34)), flacBlock(flacBlockVorbisComment, true, vorbisComment("ref", "ARTIST=Juice WRLD"))),
+	"ogg":     ogg(1, []byte("\x01vorbis ident"), append([]byte("\x03vorbis"), vorbisComment("ref", "TITLE=Lucid Dreams")...)),
+	"mp4":     mp4File(atom("meta", []byte{0, 0, 0, 0}, atom("hdlr", make([]byte, 8)), atom("ilst", mp4Item("\xa9ART", "Juice WRLD")))),
+}
+
+func TestReadFormats(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    *Tags
+	}{
+		{fixture: "id3v2.3", want: &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams"}},
+		{fixture: "id3v2.4", want: &Tags{Artist: "Juice WRLD", Album: "Goodbye & Good Riddance"}},
+		{fixture: "id3v1", want: &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams"}},
+		{fixture: "flac", want: &Tags{Artist: "Juice WRLD"}},
+		{fixture: "ogg", want: &Tags{Title: "Lucid Dreams"}},
+		{fixture: "mp4", want: &Tags{Artist: "Juice WRLD"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			got, err := read(bytes.NewReader(fixtures[test.fixture]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("read = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReadWithoutTags(t *testing.T) {
+	files := map[string][]byte{
+		"empty":          nil,
+		"short":          []byte("ID"),
+		"unknown format": bytes.Repeat([]byte("RIFF"), 64),
+		"empty tag":      id3v2(3, 0),
+		"blank fields":   id3v2(3, 0, id3Frame(3, "TPE1", 0, latin1("  "))),
+	}
+
+	for name, file := range files {
+		if _, err := read(bytes.NewReader(file)); !errors.Is(err, ErrNoTags) {
+			t.Errorf("%s: read error = %v, want ErrNoTags", name, err)
+		}
+	}
+}
+
+// TestReadDamaged reads every fixture cut short at each length, which must
+// fail cleanly or read what is left rather than panic.
+func TestReadDamaged(t *testing.T) {
+	for name, file := range fixtures {
+		for n := range len(file) {
+			tags, err := read(bytes.NewReader(file[:n]))
+			if err == nil && tags.empty() {
+				t.Errorf("%s cut to %d bytes: read empty tags without an error", name, n)
+			}
+		}
+	}
+}
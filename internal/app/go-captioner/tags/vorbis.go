@@ -0,0 +1,152 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+const (
+	flacBlockVorbisComment = 4
+	flacLastBlock          = 0x80
+
+	oggPageHeaderSize = 27
+	// Comments come in a stream's second packet, so reading stops well before
+	// the audio if a file is damaged.
+	maxOggPages = 64
+)
+
+// readFLAC reads the Vorbis comment block among the metadata blocks at the
+// start of a FLAC file.
+func readFLAC(r io.Reader) (*Tags, error) {
+	if _, err := io.ReadFull(r, make([]byte, 4)); err != nil {
+		return nil, ErrNoTags
+	}
+
+	header := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, ErrNoTags
+		}
+		size := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+		block := make([]byte, size)
+		if _, err := io.ReadFull(r, block); err != nil {
+			return nil, ErrNoTags
+		}
+
+		if header[0]&^flacLastBlock == flacBlockVorbisComment {
+			return parseVorbisComment(block)
+		}
+		if header[0]&flacLastBlock != 0 {
+			return nil, ErrNoTags
+		}
+	}
+}
+
+// readOgg reads the comment header of the first logical stream of an Ogg
+// Vorbis or Opus file.
+func readOgg(r io.Reader) (*Tags, error) {
+	var packet []byte
+	packets := 0
+	serial := uint32(0)
+
+	header := make([]byte, oggPageHeaderSize)
+	for page := range maxOggPages {
+		if _, err := io.ReadFull(r, header); err != nil || !bytes.HasPrefix(header, []byte("OggS")) {
+			return nil, ErrNoTags
+		}
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+		if page == 0 {
+			serial = pageSerial
+		}
+
+		lacing := make([]byte, header[26])
+		if _, err := io.ReadFull(r, lacing); err != nil {
+			return nil, ErrNoTags
+		}
+		for _, size := range lacing {
+			segment := make([]byte, size)
+			if _, err := io.ReadFull(r, segment); err != nil {
+				return nil, ErrNoTags
+			}
+			// Pages of other streams multiplexed in are skipped
+			if pageSerial != serial {
+				continue
+			}
+
+			packet = append(packet, segment...)
+			// A segment shorter than 255 bytes ends the packet
+			if size < 255 {
+				packets++
+				if packets == 2 {
+					return parseOggComment(packet)
+				}
+				packet = packet[:0]
+			}
+		}
+	}
+	return nil, ErrNoTags
+}
+
+// parseOggComment strips the codec's prefix from a comment packet.
+func parseOggComment(packet []byte) (*Tags, error) {
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x03vorbis")):
+		return parseVorbisComment(packet[len("\x03vorbis"):])
+	case bytes.HasPrefix(packet, []byte("OpusTags")):
+		return parseVorbisComment(packet[len("OpusTags"):])
+	}
+	return nil, ErrNoTags
+}
+
+// parseVorbisComment reads a Vorbis comment: a vendor string and a list of
+// KEY=value fields, with lengths in little endian. Fields that repeat, such
+// as several artists, are joined with commas.
+func parseVorbisComment(data []byte) (*Tags, error) {
+	next := func() ([]byte, bool) {
+		if len(data) < 4 {
+			return nil, false
+		}
+		size := binary.LittleEndian.Uint32(data)
+		if uint64(size) > uint64(len(data)-4) {
+			return nil, false
+		}
+		value := data[4 : 4+size]
+		data = data[4+size:]
+		return value, true
+	}
+
+	// Vendor
+	if _, ok := next(); !ok {
+		return nil, ErrNoTags
+	}
+	if len(data) < 4 {
+		return nil, ErrNoTags
+	}
+	count := binary.LittleEndian.Uint32(data)
+	data = data[4:]
+
+	fields := map[string][]string{}
+	for range count {
+		comment, ok := next()
+		if !ok {
+			break
+		}
+		key, value, found := strings.Cut(string(comment), "=")
+		if value = strings.TrimSpace(value); found && value != "" {
+			key = strings.ToUpper(key)
+			fields[key] = append(fields[key], value)
+		}
+	}
+
+	tags := &Tags{
+		Artist: strings.Join(fields["ARTIST"], ", "),
+		Title:  strings.Join(fields["TITLE"], ", "),
+		Album:  strings.Join(fields["ALBUM"], ", "),
+	}
+	if tags.Artist == "" {
+		tags.Artist = strings.Join(fields["ALBUMARTIST"], ", ")
+	}
+	return tags, nil
+}
@@ -0,0 +1,212 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// vorbisComment builds a comment block of a vendor string and comments.
+func vorbisComment(vendor string, comments ...string) []byte {
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(vendor)))
+	data = append(data, vendor...)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(comments)))
+	for _, comment := range comments {
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(comment)))
+		data = append(data, comment...)
+	}
+	return data
+}
+
+func flac(blocks ...[]byte) []byte {
+	return append([]byte("fLaC"), bytes.Join(blocks, nil)...)
+}
+
+func flacBlock(kind byte, last bool, data []byte) []byte {
+	if last {
+		kind |= flacLastBlock
+	}
+	return append([]byte{kind, byte(len(data) >> 16), byte(len(data) >> 8), byte(len(data))}, data...)
+}
+
+// ogg builds a stream with serial of a page per packet.
+func ogg(serial uint32, packets ...[]byte) []byte {
+	var file []byte
+	for _, packet := range packets {
+		file = append(file, oggPage(serial, lace(len(packet)), packet)...)
+	}
+	return file
+}
+
+// oggPage builds a page of serial holding data in segments of the sizes in
+// lacing.
+func oggPage(serial uint32, lacing []byte, data []byte) []byte {
+	header := make([]byte, oggPageHeaderSize)
+	copy(header, "OggS")
+	binary.LittleEndian.PutUint32(header[14:], serial)
+	header[26] = byte(len(lacing))
+	return append(append(header, lacing...), data...)
+}
+
+// lace returns the segment sizes of a whole packet of n bytes, which a
+// segment under 255 bytes ends.
+func lace(n int) []byte {
+	return append(bytes.Repeat([]byte{255}, n/255), byte(n%255))
+}
+
+func TestReadFLAC(t *testing.T) {
+	streamInfo := flacBlock(0, false, make([]byte, 34))
+	padding := flacBlock(1, false, make([]byte, 100))
+
+	tests := []struct {
+		name string
+		file []byte
+		want *Tags
+		err  error
+	}{
+		{
+			name: "comment after other blocks",
+			file: flac(streamInfo, padding, flacBlock(flacBlockVorbisComment, true,
+				vorbisComment("reference libFLAC", "ARTIST=Juice WRLD", "TITLE=Lucid Dreams", "ALBUM=Goodbye & Good Riddance"))),
+			want: &Tags{Artist: "Juice WRLD", Title: "Lucid Dreams", Album: "Goodbye & Good Riddance"},
+		},
+		{
+			name: "no comment block",
+			file: flac(streamInfo, flacBlock(1, true, make([]byte, 10)), []byte("audio")),
+			err:  ErrNoTags,
+		},
+		{
+			name: "block running past the file",
+			file: flac(streamInfo, flacBlock(flacBlockVorbisComment, true, vorbisComment("ref", "ARTIST=Juice WRLD"))[:20]),
+			err:  ErrNoTags,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := read(bytes.NewReader(test.file))
+			if !errors.Is(err, test.err) || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("read = %+v, %v, want %+v, %v", got, err, test.want, test.err)
+			}
+		})
+	}
+}
+
+func TestReadOgg(t *testing.T) {
+	comment := vorbisComment("Lavf", "artist=Juice WRLD", "title="+strings.Repeat("Lucid ", 100))
+	long := append([]byte("OpusTags"), comment...)
+	want := &Tags{Artist: "Juice WRLD", Title: strings.TrimSpace(strings.Repeat("Lucid ", 100))}
+
+	tests := []struct {
+		name string
+		file []byte
+		want *Tags
+		err  error
+	}{
+		{
+			name: "vorbis",
+			file: ogg(1, []byte("\x01vorbis ident"), append([]byte("\x03vorbis"), comment...)),
+			want: want,
+		},
+		{
+			name: "opus, comment over several pages",
+			file: bytes.Join([][]byte{
+				ogg(7, []byte("OpusHead ident")),
+				oggPage(7, []byte{255, 255}, long[:510]),
+				oggPage(7, lace(len(long)-510), long[510:]),
+			}, nil),
+			want: want,
+		},
+		{
+			name: "another stream's pages between",
+			file: bytes.Join([][]byte{
+				ogg(1, []byte("\x01vorbis ident")),
+				ogg(2, []byte("\x80theora"), []byte("\x81theora comment")),
+				ogg(1, append([]byte("\x03vorbis"), comment...)),
+			}, nil),
+			want: want,
+		},
+		{
+			name: "codec without comments",
+			file: ogg(1, []byte("\x80theora"), []byte("\x81theora")),
+			err:  ErrNoTags,
+		},
+		{
+			name: "page cut short",
+			file: ogg(1, []byte("\x01vorbis ident"), append([]byte("\x03vorbis"), comment...))[:60],
+			err:  ErrNoTags,
+		},
+		{
+			name: "not a page",
+			file: append(ogg(1, []byte("\x01vorbis ident")), bytes.Repeat([]byte("junk"), 10)...),
+			err:  ErrNoTags,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := read(bytes.NewReader(test.file))
+			if !errors.Is(err, test.err) || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("read = %+v, %v, want %+v, %v", got, err, test.want, test.err)
+			}
+		})
+	}
+}
+
+func TestParseVorbisComment(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want *Tags
+		err  error
+	}{
+		{
+			name: "keys in any case, repeats joined",
+			data: vorbisComment("ref", "Artist=Juice WRLD", "ARTIST=Halsey", "title= Lucid Dreams "),
+			want: &Tags{Artist: "Juice WRLD, Halsey", Title: "Lucid Dreams"},
+		},
+		{
+			name: "album artist stands in for a missing artist",
+			data: vorbisComment("ref", "ALBUMARTIST=Various", "ALBUM=Hits"),
+			want: &Tags{Artist: "Various", Album: "Hits"},
+		},
+		{
+			name: "fields without a value skipped",
+			data: vorbisComment("ref", "ARTIST", "TITLE=", "ALBUM=Hits"),
+			want: &Tags{Album: "Hits"},
+		},
+		{
+			name: "count larger than the comments",
+			data: append(vorbisComment("ref")[:7], 0xff, 0xff, 0xff, 0xff,
+				9, 0, 0, 0, 'T', 'I', 'T', 'L', 'E', '=', 'L', 'u', 'x'),
+			want: &Tags{Title: "Lux"},
+		},
+		{
+			name: "comment length past the end",
+			data: append(vorbisComment("ref", "ARTIST=Juice WRLD"), 0xff, 0xff, 0xff, 0x7f, 'T'),
+			want: &Tags{Artist: "Juice WRLD"},
+		},
+		{
+			name: "vendor past the end",
+			data: []byte{10, 0, 0, 0, 'r', 'e', 'f'},
+			err:  ErrNoTags,
+		},
+		{
+			name: "no count",
+			data: []byte{3, 0, 0, 0, 'r', 'e', 'f', 1},
+			err:  ErrNoTags,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseVorbisComment(test.data)
+			if !errors.Is(err, test.err) || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseVorbisComment = %+v, %v, want %+v, %v", got, err, test.want, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package transcriber
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+const transcriptionsPath = "/v1/audio/transcriptions"
+
+// OpenAITranscriber posts the audio window to an OpenAI compatible
+// /v1/audio/transcriptions endpoint, such as the OpenAI API itself,
+// faster-whisper-server or the whisper.cpp server.
+type OpenAITranscriber struct {
+	URL    string
+	APIKey string
+	Client *http.Client
+}
+
+func NewOpenAITranscriber(url, apiKey string) *OpenAITranscriber {
+	return &OpenAITranscriber{
+		URL:    url,
+		APIKey: apiKey,
+		Client: http.DefaultClient,
+	}
+}
+
+// openAIVerboseTranscription is the subset of the verbose_json response we use.
+// Some servers only nest words inside segments, so both are read.
+type openAIVerboseTranscription struct {
+	Language string        `json:"language"`
+	Words    []model.Word  `json:"words"`
+	Segments []openAIWords `json:"segments"`
+}
+
+type openAIWords struct {
+	Words []model.Word `json:"words"`
+}
+
+// CacheKey includes a hash of the URL, as servers may run different weights
+// under the same model name.
+func (t *OpenAITranscriber) CacheKey() string {
+	return BackendOpenAI + "-" + helper.OutputKey(strings.TrimSuffix(t.URL, "/"))
+}
+
+func (t *OpenAITranscriber) Transcribe(ctx context.Context, req Request) (*model.Transcript, error) {
+	tmpDir, err := os.MkdirTemp("", "transcript-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	segmentFile := filepath.Join(tmpDir, "segment.wav")
+	if err := extractSegment(ctx, req, segmentFile); err != nil {
+		return nil, err
+	}
+
+	return t.post(ctx, segmentFile, req)
+}
+
+// post uploads the extracted segmentFile and reads the words out of the
+// response.
+func (t *OpenAITranscriber) post(ctx context.Context, segmentFile string, req Request) (*model.Transcript, error) {
+	body, contentType, err := t.buildForm(segmentFile, req.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	url := strings.TrimSuffix(t.URL, "/") + transcriptionsPath
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	if t.APIKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+t.APIKey)
+	}
+
+	if req.Output != nil {
+		_, _ = fmt.Fprintln(req.Output, "Posting: ", url)
+	}
+
+	resp, err := t.Client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		const maxErrorBody = 4096
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return nil, fmt.Errorf("transcription request failed: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var result openAIVerboseTranscription
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decoding transcription response: %w", err)
+	}
+
+	transcript := &model.Transcript{
+		Language: result.Language,
+		Words:    result.Words,
+	}
+	if len(transcript.Words) == 0 {
+		for _, segment := range result.Segments {
+			transcript.Words = append(transcript.Words, segment.Words...)
+		}
+	}
+
+	return transcript, nil
+}
+
+func (t *OpenAITranscriber) buildForm(segmentFile, modelName string) (io.Reader, string, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+
+	fields := [][2]string{
+		{"model", modelName},
+		{"language", "en"},
+		{"response_format", "verbose_json"},
+		{"timestamp_granularities[]", "word"},
+	}
+	for _, field := range fields {
+		if err := form.WriteField(field[0], field[1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	file, err := os.Open(segmentFile)
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	part, err := form.CreateFormFile("file", filepath.Base(segmentFile))
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, "", err
+	}
+
+	if err := form.Close(); err != nil {
+		return nil, "", err
+	}
+	return &body, form.FormDataContentType(), nil
+}
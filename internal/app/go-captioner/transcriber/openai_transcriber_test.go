@@ -0,0 +1,111 @@
+package transcriber
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+func TestOpenAITranscriberPost(t *testing.T) {
+	want := &model.Transcript{
+		Language: "english",
+		Words: []model.Word{
+			{Word: "Yeah", Start: 0, End: 0.42},
+			{Word: "I'm", Start: 0.42, End: 0.9},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{
+			name:     "top level words",
+			response: `{"language":"english","words":[{"word":"Yeah","start":0,"end":0.42},{"word":"I'm","start":0.42,"end":0.9}]}`,
+		},
+		{
+			name:     "words in segments",
+			response: `{"language":"english","segments":[{"words":[{"word":"Yeah","start":0,"end":0.42}]},{"words":[{"word":"I'm","start":0.42,"end":0.9}]}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != transcriptionsPath {
+					t.Errorf("path = %s, want %s", r.URL.Path, transcriptionsPath)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+					t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+				}
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					t.Fatalf("parsing form: %v", err)
+				}
+				fields := map[string]string{
+					"model":                     "base",
+					"response_format":           "verbose_json",
+					"timestamp_granularities[]": "word",
+				}
+				for key, value := range fields {
+					if got := r.FormValue(key); got != value {
+						t.Errorf("form %s = %q, want %q", key, got, value)
+					}
+				}
+
+				file, _, err := r.FormFile("file")
+				if err != nil {
+					t.Fatalf("reading uploaded file: %v", err)
+				}
+				defer file.Close()
+				audio, _ := io.ReadAll(file)
+				if string(audio) != "RIFF" {
+					t.Errorf("uploaded file = %q, want the segment", audio)
+				}
+
+				_, _ = io.WriteString(w, test.response)
+			}))
+			defer server.Close()
+
+			got, err := NewOpenAITranscriber(server.URL+"/", "secret").post(context.Background(), segmentFile(t), Request{Model: "base"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("transcript = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestOpenAITranscriberPostError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewOpenAITranscriber(server.URL, "").post(context.Background(), segmentFile(t), Request{Model: "huge"})
+	if err == nil {
+		t.Fatal("expected an error for a 404 response")
+	}
+	if !strings.Contains(err.Error(), "404") || !strings.Contains(err.Error(), "model not found") {
+		t.Errorf("error = %q, want the status and body", err)
+	}
+}
+
+// segmentFile writes a stand in for an extracted audio segment.
+func segmentFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "segment.wav")
+	if err := os.WriteFile(path, []byte("RIFF"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,74 @@
+package transcriber
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+const generateCaptionsPath = "./scripts/generate_captions.py"
+
+// PythonTranscriber runs openai-whisper through scripts/generate_captions.py.
+type PythonTranscriber struct {
+	ScriptPath string
+}
+
+// whisperResult is the subset of openai-whisper's transcribe() result we use.
+type whisperResult struct {
+	Language string `json:"language"`
+	Segments []struct {
+		Words []model.Word `json:"words"`
+	} `json:"segments"`
+}
+
+func NewPythonTranscriber() *PythonTranscriber {
+	return &PythonTranscriber{
+		ScriptPath: generateCaptionsPath,
+	}
+}
+
+func (t *PythonTranscriber) CacheKey() string {
+	return BackendPython
+}
+
+func (t *PythonTranscriber) Transcribe(ctx context.Context, req Request) (*model.Transcript, error) {
+	tmpDir, err := os.MkdirTemp("", "transcript-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	transcriptFile := filepath.Join(tmpDir, "whisper.json")
+
+	args := []string{
+		req.AudioPath,
+		transcriptFile,
+		"--model", req.Model,
+		"--start", req.StartTime,
+		"--end", req.EndTime,
+	}
+
+	if err := runCommand(exec.CommandContext(ctx, t.ScriptPath, args...), req); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(transcriptFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var result whisperResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	transcript := &model.Transcript{Language: result.Language}
+	for _, segment := range result.Segments {
+		transcript.Words = append(transcript.Words, segment.Words...)
+	}
+	return transcript, nil
+}
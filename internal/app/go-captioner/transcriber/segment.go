@@ -0,0 +1,58 @@
+package transcriber
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+)
+
+// extractSegment writes the requested window of the audio to outputFile as
+// 16kHz mono PCM, the format whisper models expect.
+func extractSegment(ctx context.Context, req Request, outputFile string) error {
+	start, err := strconv.ParseFloat(req.StartTime, 64)
+	if err != nil {
+		return fmt.Errorf("invalid start time %s: %w", req.StartTime, err)
+	}
+
+	end, err := strconv.ParseFloat(req.EndTime, 64)
+	if err != nil {
+		return fmt.Errorf("invalid end time %s: %w", req.EndTime, err)
+	}
+
+	if end <= start {
+		return fmt.Errorf("end time %s must be greater than start time %s", req.EndTime, req.StartTime)
+	}
+
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+		"-y",
+		"-ss", req.StartTime,
+		"-t", strconv.FormatFloat(end-start, 'f', -1, 64),
+		"-i", req.AudioPath,
+		"-ac", "1",
+		"-ar", "16000",
+		"-c:a", "pcm_s16le",
+		outputFile,
+	}
+
+	return runCommand(exec.CommandContext(ctx, "ffmpeg", args...), req)
+}
+
+func runCommand(cmd *exec.Cmd, req Request) error {
+	helper.PrepareCommand(cmd)
+
+	if req.Output != nil {
+		_, _ = fmt.Fprintln(req.Output, "Running: ", helper.GetCommandPrintable(cmd))
+
+		if req.Verbose {
+			cmd.Stdout = req.Output
+			cmd.Stderr = req.Output
+		}
+	}
+
+	return cmd.Run()
+}
@@ -0,0 +1,66 @@
+package transcriber
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+const (
+	BackendPython     = "python"
+	BackendWhisperCpp = "whisper-cpp"
+	BackendOpenAI     = "openai"
+)
+
+var Backends = []string{
+	BackendPython,
+	BackendWhisperCpp,
+	BackendOpenAI,
+}
+
+// Request describes the window of an audio file to transcribe. StartTime and
+// EndTime are in seconds.
+type Request struct {
+	AudioPath string
+	Model     string
+	StartTime string
+	EndTime   string
+	Verbose   bool
+	// Output receives the commands being run and, when Verbose, their output.
+	Output io.Writer
+}
+
+// Transcriber turns a window of audio into word level timestamps. Timestamps
+// are relative to the start of the window.
+type Transcriber interface {
+	Transcribe(ctx context.Context, req Request) (*model.Transcript, error)
+	// CacheKey names the backend, and the server for remote ones, so cached
+	// transcripts aren't shared between backends given the same model name.
+	CacheKey() string
+}
+
+// New returns the Transcriber selected by opts.Backend.
+func New(opts model.TranscriberOptions) (Transcriber, error) {
+	switch opts.Backend {
+	case BackendPython:
+		return NewPythonTranscriber(), nil
+	case BackendWhisperCpp:
+		return NewWhisperCppTranscriber(opts.WhisperCppBin, opts.WhisperCppModels), nil
+	case BackendOpenAI:
+		apiKey := opts.APIKey
+		if apiKey == "" {
+			apiKey = os.Getenv("OPENAI_API_KEY")
+		}
+		return NewOpenAITranscriber(opts.URL, apiKey), nil
+	}
+
+	return nil, fmt.Errorf(
+		"unknown transcriber %s, expected one of %s",
+		opts.Backend,
+		strings.Join(Backends, ","),
+	)
+}
@@ -0,0 +1,126 @@
+package transcriber
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/helper"
+	"github.com/sam-laister/tiktok-creator/internal/app/go-captioner/model"
+)
+
+// WhisperCppTranscriber runs a local whisper.cpp binary. Models are resolved
+// either as a path to a ggml model file or as a name such as "base", which is
+// looked up as ModelsDir/ggml-base.bin.
+type WhisperCppTranscriber struct {
+	Bin       string
+	ModelsDir string
+}
+
+func NewWhisperCppTranscriber(bin, modelsDir string) *WhisperCppTranscriber {
+	return &WhisperCppTranscriber{
+		Bin:       bin,
+		ModelsDir: modelsDir,
+	}
+}
+
+// whisperCppOutput is the subset of whisper.cpp's --output-json format we use.
+type whisperCppOutput struct {
+	Result struct {
+		Language string `json:"language"`
+	} `json:"result"`
+	Transcription []struct {
+		Offsets struct {
+			From int `json:"from"`
+			To   int `json:"to"`
+		} `json:"offsets"`
+		Text string `json:"text"`
+	} `json:"transcription"`
+}
+
+func (t *WhisperCppTranscriber) CacheKey() string {
+	return BackendWhisperCpp
+}
+
+func (t *WhisperCppTranscriber) Transcribe(ctx context.Context, req Request) (*model.Transcript, error) {
+	modelPath := t.modelPath(req.Model)
+	if !helper.Exists(modelPath) {
+		return nil, fmt.Errorf("whisper.cpp model %s not found", modelPath)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "transcript-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	segmentFile := filepath.Join(tmpDir, "segment.wav")
+	if err := extractSegment(ctx, req, segmentFile); err != nil {
+		return nil, err
+	}
+
+	outputPrefix := filepath.Join(tmpDir, "words")
+	args := []string{
+		"--model", modelPath,
+		"--file", segmentFile,
+		"--language", "en",
+		// One word per segment gives us word level timestamps
+		"--max-len", "1",
+		"--split-on-word",
+		"--output-json",
+		"--output-file", outputPrefix,
+	}
+
+	if err := runCommand(exec.CommandContext(ctx, t.Bin, args...), req); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(outputPrefix + ".json")
+	if err != nil {
+		return nil, err
+	}
+
+	var output whisperCppOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, err
+	}
+
+	const msPerSecond = 1000.0
+	transcript := &model.Transcript{Language: output.Result.Language}
+	for _, segment := range output.Transcription {
+		text := segment.Text
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(strings.TrimSpace(text), "[") {
+			// Skip blanks and annotations such as [BLANK_AUDIO]
+			continue
+		}
+
+		start := float64(segment.Offsets.From) / msPerSecond
+		end := float64(segment.Offsets.To) / msPerSecond
+
+		// Pieces without a leading space continue the previous word, e.g. trailing punctuation.
+		if n := len(transcript.Words); n > 0 && !strings.HasPrefix(text, " ") {
+			transcript.Words[n-1].Word += text
+			transcript.Words[n-1].End = end
+			continue
+		}
+
+		transcript.Words = append(transcript.Words, model.Word{
+			Word:  text,
+			Start: start,
+			End:   end,
+		})
+	}
+
+	return transcript, nil
+}
+
+func (t *WhisperCppTranscriber) modelPath(name string) string {
+	if strings.HasSuffix(name, ".bin") || helper.Exists(name) {
+		return name
+	}
+	return filepath.Join(t.ModelsDir, "ggml-"+name+".bin")
+}
@@ -3,10 +3,18 @@
 Burn ASS captions into a video using ffmpeg.
 Usage:
     python burn_captions.py captions_file.ass video_file audio_file output_file [target_width] [target_height]
-        [--start 0] [--end 30]
+        [--start 0] [--end 30] [--video-start 12.5] [--segments plan.json]
+
+--video-start cuts the background from that point of video_file rather than a
+random one, so a render can be reproduced.
+
+With --segments the background is assembled from the segments of a plan,
+{"segments": [{"video": ..., "start": ..., "duration": ...}, ...]}, played in
+order, instead of one random chunk of video_file.
 """
 
 import argparse
+import json
 import os
 import sys
 import random
@@ -22,6 +30,8 @@ def main():
     parser.add_argument("target_height", nargs="?", type=int, default=1920)
     parser.add_argument("--start", type=float, default=0.0, help="Start time in seconds (default: 0)")
     parser.add_argument("--end", type=float, default=60.0, help="End time in seconds (default: 60)")
+    parser.add_argument("--video-start", type=float, help="Background start time in seconds (default: random)")
+    parser.add_argument("--segments", help="JSON segment plan to cut the background from")
     args = parser.parse_args()
 
     if not os.path.isfile(args.captions_file):
@@ -36,24 +46,31 @@ def main():
         sys.exit("Error: --end must be greater than --start")
     clip_duration = args.end - args.start
 
-    # Probe duration and choose random start within bounds
-    meta = ffmpeg.probe(args.video_file)
-    total_duration = float(meta.get("format", {}).get("duration", 0.0))
-    if not total_duration:
-        vstream = next(s for s in meta["streams"] if s["codec_type"] == "video")
-        total_duration = float(vstream.get("duration", 0.0))
-    if total_duration and clip_duration > total_duration:
-        clip_duration = total_duration
-    max_start = max(0.0, total_duration - clip_duration)
-    trim_start = round(random.uniform(0.0, max_start), 3)
+    if args.segments:
+        video, clip_duration = segmented_background(args.segments, args.target_width, args.target_height)
+    else:
+        video, clip_duration = background(args.video_file, args.video_start, clip_duration, args.target_width, args.target_height)
+    video = video.filter("ass", args.captions_file)
+    audio = (
+        ffmpeg
+        .input(args.audio_file, ss=args.start, t=clip_duration)
+        .audio
+    )
 
-    print(f"Clip duration: {clip_duration}s")
-    print(f"Random start: {trim_start}s of total {total_duration}s")
+    (
+        ffmpeg
+        .output(video, audio, args.output_file, acodec='aac',
+                audio_bitrate='320k', shortest=None)
+        .overwrite_output()
+        .run()
+    )
+    print("Done!")
 
-    target_aspect = args.target_width / args.target_height
+def cropped(stream, video_file, target_width, target_height):
+    """Crop stream to the target aspect ratio and scale it to the target size."""
+    target_aspect = target_width / target_height
 
-    # Crop video to target aspect ratio
-    probe = ffmpeg.probe(args.video_file)
+    probe = ffmpeg.probe(video_file)
     vinfo = next(s for s in probe["streams"] if s["codec_type"] == "video")
     in_w, in_h = int(vinfo["width"]), int(vinfo["height"])
     input_aspect = in_w / in_h
@@ -66,28 +83,58 @@ def main():
         crop_h = int(crop_w / target_aspect)
         crop_x, crop_y = 0, (in_h - crop_h)//2
 
-    video = (
-        ffmpeg
-        .input(args.video_file, ss=trim_start, t=clip_duration)
-        .video
+    return (
+        stream
         .filter("crop", crop_w, crop_h, crop_x, crop_y)
-        .filter("scale", args.target_width, args.target_height)
-        .filter("ass", args.captions_file)
-    )
-    audio = (
-        ffmpeg
-        .input(args.audio_file, ss=args.start, t=clip_duration)
-        .audio
+        .filter("scale", target_width, target_height)
     )
 
-    (
-        ffmpeg
-        .output(video, audio, args.output_file, acodec='aac',
-                audio_bitrate='320k', shortest=None)
-        .overwrite_output()
-        .run()
-    )
-    print("Done!")
+def background(video_file, video_start, clip_duration, target_width, target_height):
+    """One chunk of video_file starting at video_start, or a random point."""
+    # Probe duration and choose random start within bounds
+    meta = ffmpeg.probe(video_file)
+    total_duration = float(meta.get("format", {}).get("duration", 0.0))
+    if not total_duration:
+        vstream = next(s for s in meta["streams"] if s["codec_type"] == "video")
+        total_duration = float(vstream.get("duration", 0.0))
+    if total_duration and clip_duration > total_duration:
+        clip_duration = total_duration
+    if video_start is not None:
+        trim_start = video_start
+        print(f"Clip duration: {clip_duration}s")
+        print(f"Start: {trim_start}s of total {total_duration}s")
+    else:
+        max_start = max(0.0, total_duration - clip_duration)
+        trim_start = round(random.uniform(0.0, max_start), 3)
+
+        print(f"Clip duration: {clip_duration}s")
+        print(f"Random start: {trim_start}s of total {total_duration}s")
+
+    video = ffmpeg.input(video_file, ss=trim_start, t=clip_duration).video
+    return cropped(video, video_file, target_width, target_height), clip_duration
+
+def segmented_background(segments_file, target_width, target_height):
+    """The segments of a plan joined in order."""
+    with open(segments_file) as f:
+        plan = json.load(f)
+
+    segments = plan["segments"]
+    if not segments:
+        sys.exit(f"Error: {segments_file} has no segments.")
+
+    streams = []
+    for segment in segments:
+        if not os.path.isfile(segment["video"]):
+            sys.exit(f"Error: {segment['video']} does not exist.")
+        video = ffmpeg.input(segment["video"], ss=segment["start"], t=segment["duration"]).video
+        # Segments must share a size, frame rate and pixel aspect to concat
+        video = cropped(video, segment["video"], target_width, target_height)
+        streams.append(video.filter("setsar", 1).filter("fps", 30))
+
+    clip_duration = sum(segment["duration"] for segment in segments)
+    print(f"Clip duration: {clip_duration}s in {len(segments)} segments")
+
+    return ffmpeg.concat(*streams, v=1, a=0), clip_duration
 
 if __name__ == "__main__":
     main()
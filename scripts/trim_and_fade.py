@@ -3,6 +3,17 @@
 Trim video and add fade-out effects to both audio and video.
 Usage:
     python trim_and_fade.py input_file output_file start_time duration [--fade-duration 3]
+        [--fade-in 0.5] [--censor 1.20-1.65 ...] [--censor-mode bleep]
+        [--loudness-target -14 --true-peak -1 --loudness-range 11
+         --measured-i -9.8 --measured-tp 0.4 --measured-lra 5.1 --measured-thresh -20.1 --target-offset -0.3]
+
+--censor hides the audio between two times of the trimmed video, given in
+seconds, by muting it, bleeping over it or playing it backwards as
+--censor-mode says. It may be repeated; spans must be in order and apart.
+
+--loudness-target normalises the audio to that many LUFS as the second pass
+of ffmpeg's loudnorm filter, limiting peaks to --true-peak dBTP. The --measured
+values are what the first pass printed for the same audio.
 """
 
 import argparse
@@ -10,14 +21,23 @@ import os
 import sys
 import ffmpeg
 
-def trim_and_fade(input_file, output_file, start_time, duration, fade_duration=3):
-    """Trim video and add fade-out effects."""
+# Pitch of the tone bleeps are made of, in Hz
+BLEEP_FREQUENCY = 1000
+
+# loudnorm works at 192kHz, so its output is resampled back to this
+SAMPLE_RATE = 48000
+
+def trim_and_fade(input_file, output_file, start_time, duration, fade_duration=3,
+                  censor_spans=None, censor_mode="bleep", fade_in=0, loudnorm=None):
+    """Trim video and add fade-in and fade-out effects."""
 
     # Calculate end time
     end_time = start_time + duration
 
     print(f"Trimming video to {duration} from {start_time} to {end_time}")
     print(f"Adding {fade_duration}s fade-out effect")
+    if fade_in:
+        print(f"Adding {fade_in}s fade-in effect")
 
     # Get video info
     probe = ffmpeg.probe(input_file)
@@ -37,15 +57,25 @@ def trim_and_fade(input_file, output_file, start_time, duration, fade_duration=3
     video = (
         ffmpeg.input(input_file, ss=start_time, t=duration)
         .filter('scale', input_width, input_height)  # Ensure consistent scaling
-        .filter('fade', t='out', st=fade_start_time, d=fade_duration)
     )
+    if fade_in:
+        video = video.filter('fade', t='in', st=0, d=fade_in)
+    video = video.filter('fade', t='out', st=fade_start_time, d=fade_duration)
 
     # Process audio if present
     if audio_stream:
-        audio = (
-            ffmpeg.input(input_file, ss=start_time, t=duration)
-            .filter('afade', t='out', st=fade_start_time, d=fade_duration)
-        )
+        audio = ffmpeg.input(input_file, ss=start_time, t=duration).audio
+        # Normalised before censoring, as the measurements are of the audio
+        # as it was burned
+        if loudnorm:
+            print(f"Normalising audio from {loudnorm['measured_I']} to {loudnorm['I']} LUFS")
+            audio = audio.filter('loudnorm', linear='true', **loudnorm).filter('aresample', SAMPLE_RATE)
+        if censor_spans:
+            print(f"Censoring {len(censor_spans)} span(s) of audio with {censor_mode}")
+            audio = censored(audio, censor_spans, censor_mode, duration)
+        if fade_in:
+            audio = audio.filter('afade', t='in', st=0, d=fade_in)
+        audio = audio.filter('afade', t='out', st=fade_start_time, d=fade_duration)
 
         # Combine video and audio
         out = ffmpeg.output(
@@ -66,6 +96,54 @@ def trim_and_fade(input_file, output_file, start_time, duration, fade_duration=3
     # Run ffmpeg
     out.overwrite_output().run(quiet=False)
 
+def censored(audio, spans, mode, duration):
+    """Mute, bleep or reverse the audio during each (start, end) span."""
+    if mode == "reverse":
+        return reversed_spans(audio, spans, duration)
+
+    during = "+".join(f"between(t,{start},{end})" for start, end in spans)
+    audio = audio.filter("volume", volume=0, enable=during)
+    if mode == "mute":
+        return audio
+
+    # The tone only sounds during the spans, over the silenced words
+    bleep = (
+        ffmpeg.input(f"sine=frequency={BLEEP_FREQUENCY}:duration={duration}", f="lavfi")
+        .filter("volume", volume=0, enable=f"not({during})")
+    )
+    return ffmpeg.filter([audio, bleep], "amix", inputs=2, duration="first", normalize=0)
+
+def reversed_spans(audio, spans, duration):
+    """Play the audio of each span backwards, leaving the rest as it was."""
+    cuts = []
+    at = 0
+    for start, end in spans:
+        if start > at:
+            cuts.append((at, start, False))
+        cuts.append((start, end, True))
+        at = end
+    if at < duration:
+        cuts.append((at, duration, False))
+
+    parts = audio.filter_multi_output("asplit", len(cuts))
+    pieces = []
+    for i, (start, end, reverse) in enumerate(cuts):
+        piece = parts.stream(i).filter("atrim", start=start, end=end).filter("asetpts", "PTS-STARTPTS")
+        if reverse:
+            piece = piece.filter("areverse")
+        pieces.append(piece)
+    return ffmpeg.concat(*pieces, v=0, a=1)
+
+def parse_span(value):
+    """Parse a START-END span of seconds."""
+    try:
+        start, end = (float(t) for t in value.split("-", 1))
+    except ValueError:
+        raise argparse.ArgumentTypeError(f"expected START-END in seconds, got {value}")
+    if end <= start:
+        raise argparse.ArgumentTypeError(f"span {value} ends before it starts")
+    return start, end
+
 def main():
     parser = argparse.ArgumentParser(description="Trim video and add fade-out effects")
     parser.add_argument("input_file", help="Path to input video file")
@@ -74,8 +152,39 @@ def main():
     parser.add_argument("duration", type=int, help="Duration in seconds (Default: 60)", default=60)
     parser.add_argument("--fade-duration", type=int, default=3,
                        help="Fade-out duration in seconds (default: 3)")
+    parser.add_argument("--censor", type=parse_span, action="append", default=[], metavar="START-END",
+                       help="Span of seconds whose audio is censored, may be repeated")
+    parser.add_argument("--censor-mode", choices=["mute", "bleep", "reverse"], default="bleep",
+                       help="How censored audio is hidden (default: bleep)")
+    parser.add_argument("--fade-in", type=float, default=0,
+                       help="Fade-in duration in seconds (default: 0, no fade-in)")
+    parser.add_argument("--loudness-target", type=float,
+                       help="Integrated loudness to normalise the audio to in LUFS")
+    parser.add_argument("--true-peak", type=float, default=-1,
+                       help="Ceiling peaks are limited to in dBTP (default: -1)")
+    parser.add_argument("--loudness-range", type=float, default=11,
+                       help="Loudness range allowed in LU (default: 11)")
+    for name in ["measured-i", "measured-tp", "measured-lra", "measured-thresh", "target-offset"]:
+        parser.add_argument(f"--{name}", type=float,
+                           help="Printed by the first loudnorm pass, needed with --loudness-target")
     args = parser.parse_args()
 
+    loudnorm = None
+    if args.loudness_target is not None:
+        measured = [args.measured_i, args.measured_tp, args.measured_lra, args.measured_thresh, args.target_offset]
+        if None in measured:
+            sys.exit("Error: --loudness-target needs every --measured value and --target-offset")
+        loudnorm = {
+            "I": args.loudness_target,
+            "TP": args.true_peak,
+            "LRA": args.loudness_range,
+            "measured_I": args.measured_i,
+            "measured_TP": args.measured_tp,
+            "measured_LRA": args.measured_lra,
+            "measured_thresh": args.measured_thresh,
+            "offset": args.target_offset,
+        }
+
     # Validate input file
     if not os.path.isfile(args.input_file):
         sys.exit(f"Error: {args.input_file} does not exist.")
@@ -93,9 +202,12 @@ def main():
         # Validate fade duration
         if args.fade_duration >= args.duration:
             sys.exit(f"Error: Fade duration ({args.fade_duration}s) must be less than video duration ({args.duration}s)")
+        if args.fade_in < 0 or args.fade_in + args.fade_duration > args.duration:
+            sys.exit(f"Error: Fade-in ({args.fade_in}s) and fade-out ({args.fade_duration}s) must fit in the video ({args.duration}s)")
 
         # Process the video
-        trim_and_fade(args.input_file, args.output_file, args.start_time, args.duration, args.fade_duration)
+        trim_and_fade(args.input_file, args.output_file, args.start_time, args.duration, args.fade_duration,
+                      args.censor, args.censor_mode, args.fade_in, loudnorm)
 
         print("Done!")
 